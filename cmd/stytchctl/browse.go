@@ -0,0 +1,736 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/diff"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/environments"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/rbacpolicy"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/redirecturls"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/sdk"
+	"gopkg.in/yaml.v3"
+)
+
+var browseCommand = &command{
+	name:    "browse",
+	summary: "Browse projects, environments and their resources interactively",
+	run:     browse,
+}
+
+// ANSI escape codes, used when stdout is a terminal.
+const (
+	ansiClear = "\x1b[H\x1b[2J"
+	ansiBold  = "\x1b[1m"
+	ansiDim   = "\x1b[2m"
+	ansiRed   = "\x1b[31m"
+	ansiReset = "\x1b[0m"
+)
+
+// browser is an interactive, line-based view of a workspace. It shows one screen at a time; the
+// user opens the numbered entries of a screen, goes back, and edits the simple fields of the
+// resource on display with set.
+type browser struct {
+	c      *cli
+	client api.Interface
+	in     *bufio.Scanner
+	color  bool
+	// stack holds the screens that were opened, the current one last.
+	stack []*screen
+	// entries are the entries of the current screen, as last shown.
+	entries []entry
+}
+
+// screen is a page of the browser. show fetches what the screen displays, prints it and returns
+// the entries that can be opened by number. edit, if set, prepares a change of a field of the
+// resource on display, which the browser confirms before saving it.
+type screen struct {
+	title string
+	show  func(ctx context.Context, w io.Writer) ([]entry, error)
+	edit  func(field, value string) (*change, error)
+	// fields describes the fields that edit accepts, for the help line.
+	fields string
+}
+
+// entry is a screen that can be opened from another.
+type entry struct {
+	label string
+	open  func() *screen
+}
+
+// change is an edit of a field that has not been saved yet.
+type change struct {
+	field         string
+	before, after any
+	save          func(ctx context.Context) error
+}
+
+func browse(ctx context.Context, c *cli, args []string) error {
+	if _, err := c.parse(c.flags(), args, 0, 0); err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	b := &browser{
+		c:      c,
+		client: client,
+		in:     bufio.NewScanner(c.stdin),
+		color:  isTerminal(c.stdout) && c.getenv("NO_COLOR") == "",
+	}
+	// Start at the project and environment given with --project and --env, if any.
+	b.stack = []*screen{b.projectsScreen()}
+	if c.project != "" {
+		b.stack = append(b.stack, b.projectScreen(c.project))
+		if c.env != "" {
+			b.stack = append(b.stack, b.environmentScreen(c.project, c.env))
+		}
+	}
+	return b.run(ctx)
+}
+
+// isTerminal reports whether w is a terminal, so that the browser can clear the screen and use
+// colors.
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+func (b *browser) run(ctx context.Context) error {
+	b.render(ctx)
+	for {
+		line, ok := b.ask("> ")
+		if !ok || ctx.Err() != nil {
+			return ctx.Err()
+		}
+		cmd, rest, _ := strings.Cut(line, " ")
+		switch cmd {
+		case "":
+		case "q", "quit":
+			return nil
+		case "b", "back":
+			if len(b.stack) > 1 {
+				b.stack = b.stack[:len(b.stack)-1]
+			}
+			b.render(ctx)
+		case "r", "reload":
+			b.render(ctx)
+		case "?", "help":
+			b.help()
+		case "set":
+			field, value, _ := strings.Cut(strings.TrimSpace(rest), " ")
+			if err := b.set(ctx, field, value); err != nil {
+				b.errorf("%v", err)
+			}
+		default:
+			n, err := strconv.Atoi(cmd)
+			if err != nil || n < 1 || n > len(b.entries) {
+				b.errorf("unknown command %q; type ? for help", line)
+				continue
+			}
+			b.stack = append(b.stack, b.entries[n-1].open())
+			b.render(ctx)
+		}
+	}
+}
+
+// ask prints a prompt and reads a line. It returns false at the end of the input.
+func (b *browser) ask(prompt string) (string, bool) {
+	fmt.Fprint(b.c.stdout, prompt)
+	if !b.in.Scan() {
+		fmt.Fprintln(b.c.stdout)
+		return "", false
+	}
+	return strings.TrimSpace(b.in.Text()), true
+}
+
+func (b *browser) paint(code, s string) string {
+	if !b.color {
+		return s
+	}
+	return code + s + ansiReset
+}
+
+func (b *browser) errorf(format string, args ...any) {
+	fmt.Fprintln(b.c.stdout, b.paint(ansiRed, fmt.Sprintf(format, args...)))
+}
+
+// render shows the current screen.
+func (b *browser) render(ctx context.Context) {
+	w := b.c.stdout
+	if b.color {
+		fmt.Fprint(w, ansiClear)
+	} else {
+		fmt.Fprintln(w)
+	}
+	titles := make([]string, len(b.stack))
+	for i, s := range b.stack {
+		titles[i] = s.title
+	}
+	fmt.Fprintf(w, "%s\n\n", b.paint(ansiBold, strings.Join(titles, " > ")))
+
+	var buf bytes.Buffer
+	entries, err := b.current().show(ctx, &buf)
+	b.entries = entries
+	if err != nil {
+		b.errorf("%v", err)
+		return
+	}
+	w.Write(buf.Bytes())
+	if len(entries) > 0 {
+		tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+		for i, e := range entries {
+			fmt.Fprintf(tw, "  %d)\t%s\n", i+1, e.label)
+		}
+		tw.Flush()
+	}
+	fmt.Fprintln(w)
+	b.hint()
+}
+
+func (b *browser) current() *screen {
+	return b.stack[len(b.stack)-1]
+}
+
+func (b *browser) hint() {
+	var keys []string
+	if len(b.entries) > 0 {
+		keys = append(keys, "<number> open")
+	}
+	if b.current().edit != nil {
+		keys = append(keys, "set <field> <value> edit")
+	}
+	keys = append(keys, "b back", "r reload", "? help", "q quit")
+	fmt.Fprintln(b.c.stdout, b.paint(ansiDim, strings.Join(keys, "  ")))
+}
+
+func (b *browser) help() {
+	w := b.c.stdout
+	fmt.Fprintln(w, "<number>             open an entry of the list")
+	if s := b.current(); s.edit != nil {
+		fmt.Fprintln(w, "set <field> <value>  change a field, after confirmation; fields are "+s.fields)
+	}
+	fmt.Fprintln(w, "b, back              go back to the previous screen")
+	fmt.Fprintln(w, "r, reload            fetch the current screen again")
+	fmt.Fprintln(w, "q, quit              leave the browser")
+}
+
+// set edits a field of the current screen, showing the change and asking for confirmation before
+// saving it.
+func (b *browser) set(ctx context.Context, field, value string) error {
+	s := b.current()
+	if s.edit == nil {
+		return fmt.Errorf("nothing to edit on this screen")
+	}
+	if field == "" {
+		return fmt.Errorf("usage: set <field> <value>")
+	}
+	ch, err := s.edit(field, value)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(b.c.stdout, "%s: %s -> %s\n", ch.field, diff.FormatValue(ch.before), diff.FormatValue(ch.after))
+	answer, _ := b.ask("Save? [y/N] ")
+	switch strings.ToLower(answer) {
+	case "y", "yes":
+	default:
+		fmt.Fprintln(b.c.stdout, "not saved")
+		return nil
+	}
+	if err := ch.save(ctx); err != nil {
+		return err
+	}
+	fmt.Fprintf(b.c.stdout, "saved %s\n", ch.field)
+	b.render(ctx)
+	return nil
+}
+
+func (b *browser) projectsScreen() *screen {
+	return &screen{
+		title: "projects",
+		show: func(ctx context.Context, w io.Writer) ([]entry, error) {
+			resp, err := b.client.ProjectsAPI().GetAll(ctx, projects.GetAllRequest{})
+			if err != nil {
+				return nil, err
+			}
+			var entries []entry
+			for _, p := range resp.Projects {
+				slug := p.ProjectSlug
+				entries = append(entries, entry{
+					label: fmt.Sprintf("%s\t%s\t%s", p.ProjectSlug, p.Name, p.Vertical),
+					open:  func() *screen { return b.projectScreen(slug) },
+				})
+			}
+			return entries, nil
+		},
+	}
+}
+
+func (b *browser) projectScreen(projectSlug string) *screen {
+	var project projects.Project
+	return &screen{
+		title: projectSlug,
+		show: func(ctx context.Context, w io.Writer) ([]entry, error) {
+			resp, err := b.client.ProjectsAPI().Get(ctx, projects.GetRequest{ProjectSlug: projectSlug})
+			if err != nil {
+				return nil, err
+			}
+			project = resp.Project
+			if err := printFields(w, project, projects.UpdateRequest{}); err != nil {
+				return nil, err
+			}
+			envs, err := b.client.EnvironmentsAPI().GetAll(ctx, environments.GetAllRequest{ProjectSlug: projectSlug})
+			if err != nil {
+				return nil, err
+			}
+			fmt.Fprintln(w, "\nenvironments")
+			var entries []entry
+			for _, e := range envs.Environments {
+				envSlug := e.EnvironmentSlug
+				entries = append(entries, entry{
+					label: fmt.Sprintf("%s\t%s\t%s", e.EnvironmentSlug, e.Type, e.Name),
+					open:  func() *screen { return b.environmentScreen(projectSlug, envSlug) },
+				})
+			}
+			return entries, nil
+		},
+		edit: func(field, value string) (*change, error) {
+			req := projects.UpdateRequest{ProjectSlug: projectSlug}
+			return editRequest(project, &req, field, value, func(ctx context.Context) error {
+				_, err := b.client.ProjectsAPI().Update(ctx, req)
+				return err
+			})
+		},
+		fields: strings.Join(requestFields(projects.UpdateRequest{}), ", "),
+	}
+}
+
+func (b *browser) environmentScreen(projectSlug, envSlug string) *screen {
+	var env environments.Environment
+	return &screen{
+		title: envSlug,
+		show: func(ctx context.Context, w io.Writer) ([]entry, error) {
+			resp, err := b.client.EnvironmentsAPI().Get(ctx, environments.GetRequest{
+				ProjectSlug:     projectSlug,
+				EnvironmentSlug: envSlug,
+			})
+			if err != nil {
+				return nil, err
+			}
+			env = resp.Environment
+			metrics, err := b.client.EnvironmentsAPI().GetMetrics(ctx, environments.GetMetricsRequest{
+				ProjectSlug:     projectSlug,
+				EnvironmentSlug: envSlug,
+			})
+			if err != nil {
+				return nil, err
+			}
+			m := metrics.Metrics
+			fmt.Fprintf(w, "metrics\n  users: %d\n  organizations: %d\n  members: %d\n  m2m clients: %d\n\nsettings\n",
+				m.UserCount, m.OrganizationCount, m.MemberCount, m.M2MClientCount)
+			if err := printFields(w, env, environments.UpdateRequest{}); err != nil {
+				return nil, err
+			}
+			fmt.Fprintln(w)
+			return []entry{
+				{label: "redirect URLs", open: func() *screen { return b.redirectURLsScreen(projectSlug, envSlug) }},
+				{label: "RBAC policy", open: func() *screen { return b.rbacScreen(projectSlug, envSlug) }},
+				{label: "SDK configuration", open: func() *screen { return b.sdkScreen(projectSlug, envSlug) }},
+			}, nil
+		},
+		edit: func(field, value string) (*change, error) {
+			req := environments.UpdateRequest{ProjectSlug: projectSlug, EnvironmentSlug: envSlug}
+			return editRequest(env, &req, field, value, func(ctx context.Context) error {
+				_, err := b.client.EnvironmentsAPI().Update(ctx, req)
+				return err
+			})
+		},
+		fields: strings.Join(requestFields(environments.UpdateRequest{}), ", "),
+	}
+}
+
+func (b *browser) redirectURLsScreen(projectSlug, envSlug string) *screen {
+	return &screen{
+		title: "redirect URLs",
+		show: func(ctx context.Context, w io.Writer) ([]entry, error) {
+			resp, err := b.client.RedirectURLsAPI().GetAll(ctx, redirecturls.GetAllRequest{
+				ProjectSlug:     projectSlug,
+				EnvironmentSlug: envSlug,
+			})
+			if err != nil {
+				return nil, err
+			}
+			tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(tw, "  URL\tTYPES")
+			defaults := map[redirecturls.RedirectURLType]string{}
+			for _, u := range resp.RedirectURLs {
+				fmt.Fprintf(tw, "  %s\t%s\n", u.URL, formatURLTypes(u))
+				for _, t := range u.ValidTypes {
+					if t.IsDefault {
+						defaults[t.Type] = u.URL
+					}
+				}
+			}
+			tw.Flush()
+			fmt.Fprintln(w, "\ndefaults")
+			tw = tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+			for _, t := range redirecturls.RedirectURLTypes() {
+				url := defaults[t]
+				if url == "" {
+					url = none
+				}
+				fmt.Fprintf(tw, "  %s\t%s\n", t, url)
+			}
+			return nil, tw.Flush()
+		},
+	}
+}
+
+func (b *browser) rbacScreen(projectSlug, envSlug string) *screen {
+	return &screen{
+		title: "RBAC policy",
+		show: func(ctx context.Context, w io.Writer) ([]entry, error) {
+			resp, err := b.client.RBACPolicyAPI().Get(ctx, rbacpolicy.GetRequest{
+				ProjectSlug:     projectSlug,
+				EnvironmentSlug: envSlug,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return nil, printRBACMatrix(w, resp.Policy)
+		},
+	}
+}
+
+// printRBACMatrix prints, for every resource of a policy, which roles and scopes may perform each
+// of its actions.
+func printRBACMatrix(w io.Writer, policy rbacpolicy.Policy) error {
+	type grantee struct {
+		name        string
+		permissions []rbacpolicy.Permission
+	}
+	var grantees []grantee
+	for _, r := range []struct {
+		name string
+		role *rbacpolicy.DefaultRole
+	}{
+		{"stytch_admin", policy.StytchAdmin},
+		{"stytch_member", policy.StytchMember},
+		{"stytch_user", policy.StytchUser},
+	} {
+		if r.role != nil {
+			grantees = append(grantees, grantee{r.name, r.role.Permissions})
+		}
+	}
+	for _, r := range policy.CustomRoles {
+		grantees = append(grantees, grantee{r.RoleID, r.Permissions})
+	}
+	for _, s := range policy.CustomScopes {
+		grantees = append(grantees, grantee{"scope " + s.Scope, s.Permissions})
+	}
+
+	resources := append(append([]rbacpolicy.Resource{}, policy.StytchResources...), policy.CustomResources...)
+	if len(resources) == 0 {
+		fmt.Fprintln(w, "no resources")
+		return nil
+	}
+	for i, res := range resources {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintln(w, res.ResourceID)
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintf(tw, "  ROLE\t%s\n", strings.Join(res.AvailableActions, "\t"))
+		for _, g := range grantees {
+			allowed := map[string]bool{}
+			for _, p := range g.permissions {
+				if p.ResourceID == res.ResourceID {
+					for _, a := range p.Actions {
+						allowed[a] = true
+					}
+				}
+			}
+			cells := make([]string, len(res.AvailableActions))
+			for j, a := range res.AvailableActions {
+				cells[j] = none
+				if allowed[a] || allowed["*"] {
+					cells[j] = "x"
+				}
+			}
+			fmt.Fprintf(tw, "  %s\t%s\n", g.name, strings.Join(cells, "\t"))
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *browser) sdkScreen(projectSlug, envSlug string) *screen {
+	var (
+		isConsumer bool
+		config     any
+	)
+	return &screen{
+		title: "SDK configuration",
+		show: func(ctx context.Context, w io.Writer) ([]entry, error) {
+			v, err := vertical(ctx, b.client, projectSlug)
+			if err != nil {
+				return nil, err
+			}
+			isConsumer = v == projects.VerticalConsumer
+			if isConsumer {
+				resp, err := b.client.SDKAPI().GetConsumerConfig(ctx, sdk.GetConsumerConfigRequest{
+					ProjectSlug:     projectSlug,
+					EnvironmentSlug: envSlug,
+				})
+				if err != nil {
+					return nil, err
+				}
+				config = resp.Config
+			} else {
+				resp, err := b.client.SDKAPI().GetB2BConfig(ctx, sdk.GetB2BConfigRequest{
+					ProjectSlug:     projectSlug,
+					EnvironmentSlug: envSlug,
+				})
+				if err != nil {
+					return nil, err
+				}
+				config = resp.Config
+			}
+			doc, err := document(config)
+			if err != nil {
+				return nil, err
+			}
+			printTree(w, doc, "  ")
+			return nil, nil
+		},
+		edit: func(field, value string) (*change, error) {
+			path, err := parsePath("." + strings.TrimPrefix(field, "."))
+			if err != nil {
+				return nil, err
+			}
+			doc, err := document(config)
+			if err != nil {
+				return nil, err
+			}
+			var before any
+			if values := lookup(doc, path); len(values) == 1 {
+				before = values[0]
+			}
+			var (
+				consumer sdk.ConsumerConfig
+				b2b      sdk.B2BConfig
+			)
+			after, err := parseValue(value, func(v any) error {
+				doc, err := document(config)
+				if err != nil {
+					return err
+				}
+				if doc, err = setPath(doc, path, v); err != nil {
+					return err
+				}
+				if isConsumer {
+					consumer = sdk.ConsumerConfig{}
+					return decodeDocument(doc, &consumer)
+				}
+				b2b = sdk.B2BConfig{}
+				return decodeDocument(doc, &b2b)
+			})
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", field, err)
+			}
+			return &change{field: field, before: before, after: after, save: func(ctx context.Context) error {
+				if isConsumer {
+					_, err := b.client.SDKAPI().SetConsumerConfig(ctx, sdk.SetConsumerConfigRequest{
+						ProjectSlug:     projectSlug,
+						EnvironmentSlug: envSlug,
+						Config:          &consumer,
+					})
+					return err
+				}
+				_, err := b.client.SDKAPI().SetB2BConfig(ctx, sdk.SetB2BConfigRequest{
+					ProjectSlug:     projectSlug,
+					EnvironmentSlug: envSlug,
+					Config:          &b2b,
+				})
+				return err
+			}}, nil
+		},
+		fields: "paths such as basic.enabled or basic.domains[0].domain",
+	}
+}
+
+// printTree prints a document as an indented tree of its fields, with list elements numbered.
+func printTree(w io.Writer, v any, indent string) {
+	switch v := v.(type) {
+	case map[string]any:
+		for _, k := range sortedKeys(v) {
+			printNode(w, k, v[k], indent)
+		}
+	case []any:
+		for i, e := range v {
+			printNode(w, fmt.Sprintf("[%d]", i), e, indent)
+		}
+	}
+}
+
+func printNode(w io.Writer, name string, v any, indent string) {
+	switch v.(type) {
+	case map[string]any, []any:
+		fmt.Fprintf(w, "%s%s\n", indent, name)
+		printTree(w, v, indent+"  ")
+	default:
+		fmt.Fprintf(w, "%s%s: %s\n", indent, name, diff.FormatValue(v))
+	}
+}
+
+// printFields prints the fields of a resource, including the zero values of the fields of req, its
+// update request type, which the JSON encoding of the resource omits.
+func printFields(w io.Writer, resource, req any) error {
+	doc, err := document(resource)
+	if err != nil {
+		return err
+	}
+	fields, _ := doc.(map[string]any)
+	if fields == nil {
+		fields = map[string]any{}
+	}
+	typ := reflect.TypeOf(req)
+	for _, name := range requestFields(req) {
+		if _, ok := fields[name]; !ok {
+			f, _ := fieldByJSONName(typ, name)
+			fields[name] = reflect.Zero(f.Type.Elem()).Interface()
+		}
+	}
+	for _, k := range sortedKeys(fields) {
+		fmt.Fprintf(w, "  %s: %s\n", k, diff.FormatValue(fields[k]))
+	}
+	return nil
+}
+
+// requestFields returns the JSON names of the fields of an update request, which are the fields
+// of a resource that can be edited.
+func requestFields(req any) []string {
+	var names []string
+	typ := reflect.TypeOf(req)
+	for i := 0; i < typ.NumField(); i++ {
+		name, _, _ := strings.Cut(typ.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func fieldByJSONName(typ reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < typ.NumField(); i++ {
+		if n, _, _ := strings.Cut(typ.Field(i).Tag.Get("json"), ","); n == name {
+			return typ.Field(i), true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// editRequest sets a field of req, an update request, and returns the change from the field's
+// value in resource.
+func editRequest(resource, req any, field, value string, save func(ctx context.Context) error) (*change, error) {
+	if _, ok := fieldByJSONName(reflect.TypeOf(req).Elem(), field); !ok {
+		return nil, fmt.Errorf("unknown field %q: must be one of %s", field,
+			strings.Join(requestFields(reflect.ValueOf(req).Elem().Interface()), ", "))
+	}
+	doc, err := document(resource)
+	if err != nil {
+		return nil, err
+	}
+	before := doc.(map[string]any)[field]
+	after, err := parseValue(value, func(v any) error {
+		return decodeDocument(map[string]any{field: v}, req)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", field, err)
+	}
+	return &change{field: field, before: before, after: after, save: save}, nil
+}
+
+// parseValue parses a value typed by the user as a YAML scalar, so that true and 10 are a boolean
+// and a number, and passes it to try. If try rejects it, the value is tried again as a string, for
+// string fields that hold values such as "10".
+func parseValue(s string, try func(v any) error) (any, error) {
+	var v any
+	if err := yaml.Unmarshal([]byte(s), &v); err != nil || v == nil {
+		v = s
+	}
+	switch v.(type) {
+	case map[string]any, []any:
+		v = s
+	}
+	err := try(v)
+	if err == nil {
+		return v, nil
+	}
+	if _, ok := v.(string); !ok && try(s) == nil {
+		return s, nil
+	}
+	return nil, err
+}
+
+// setPath sets the value at a path of a document, adding the objects on the way that do not
+// exist. An index may be one past the end of a list, to append to it.
+func setPath(doc any, path []pathSegment, value any) (any, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	seg := path[0]
+	switch {
+	case seg.all:
+		return nil, fmt.Errorf("cannot set a wildcard path")
+	case seg.index != nil:
+		list, ok := doc.([]any)
+		if !ok && doc != nil {
+			return nil, fmt.Errorf("[%d] is not a list", *seg.index)
+		}
+		i := *seg.index
+		if i < 0 || i > len(list) {
+			return nil, fmt.Errorf("index %d is out of range", i)
+		}
+		if i == len(list) {
+			list = append(list, nil)
+		}
+		e, err := setPath(list[i], path[1:], value)
+		if err != nil {
+			return nil, err
+		}
+		list[i] = e
+		return list, nil
+	default:
+		obj, ok := doc.(map[string]any)
+		if !ok {
+			if doc != nil {
+				return nil, fmt.Errorf("%s is not an object", seg.field)
+			}
+			obj = map[string]any{}
+		}
+		e, err := setPath(obj[seg.field], path[1:], value)
+		if err != nil {
+			return nil, err
+		}
+		obj[seg.field] = e
+		return obj, nil
+	}
+}
@@ -0,0 +1,180 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
+	"gopkg.in/yaml.v3"
+)
+
+// Environment variables read by stytchctl.
+const (
+	configEnvVar    = "STYTCHCTL_CONFIG"
+	profileEnvVar   = "STYTCHCTL_PROFILE"
+	keyIDEnvVar     = "STYTCH_WORKSPACE_KEY_ID"
+	keySecretEnvVar = "STYTCH_WORKSPACE_KEY_SECRET"
+	baseURIEnvVar   = "STYTCH_WORKSPACE_BASE_URI"
+)
+
+// config is the configuration file, by default stytchctl/config.yaml in the user configuration
+// directory.
+type config struct {
+	// CurrentProfile is the profile used when none is given with --profile or STYTCHCTL_PROFILE.
+	// It defaults to "default".
+	CurrentProfile string             `yaml:"current_profile,omitempty"`
+	Profiles       map[string]profile `yaml:"profiles,omitempty"`
+}
+
+// profile holds the credentials for a workspace and the project and environment that commands
+// act on by default.
+type profile struct {
+	// Either a workspace key ID and secret or an access token.
+	WorkspaceKeyID     string `yaml:"workspace_key_id,omitempty"`
+	WorkspaceKeySecret string `yaml:"workspace_key_secret,omitempty"`
+	AccessToken        string `yaml:"access_token,omitempty"`
+	BaseURI            string `yaml:"base_uri,omitempty"`
+	Project            string `yaml:"project,omitempty"`
+	Environment        string `yaml:"environment,omitempty"`
+}
+
+// configFile returns the path of the configuration file.
+func (c *cli) configFile() (string, error) {
+	if c.configPath != "" {
+		return c.configPath, nil
+	}
+	if path := c.getenv(configEnvVar); path != "" {
+		return path, nil
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("finding the configuration file: %w; use --config", err)
+	}
+	return filepath.Join(dir, "stytchctl", "config.yaml"), nil
+}
+
+// readConfig reads the configuration file. A missing file is an empty configuration.
+func (c *cli) readConfig() (*config, string, error) {
+	path, err := c.configFile()
+	if err != nil {
+		return nil, "", err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return &config{}, path, nil
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	var cfg config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, "", fmt.Errorf("%s: %w", path, err)
+	}
+	return &cfg, path, nil
+}
+
+// loadProfile resolves the profile to use. A profile named with --profile or STYTCHCTL_PROFILE
+// must exist. Otherwise the STYTCH_WORKSPACE_* environment variables are used if they are set, and
+// the current profile of the configuration file if they are not.
+func (c *cli) loadProfile() (*profile, error) {
+	if c.profile != nil {
+		return c.profile, nil
+	}
+	cfg, path, err := c.readConfig()
+	if err != nil {
+		return nil, err
+	}
+	name := c.profileName
+	if name == "" {
+		name = c.getenv(profileEnvVar)
+	}
+	if name == "" && (c.getenv(keyIDEnvVar) != "" || len(cfg.Profiles) == 0) {
+		c.profile = &profile{
+			WorkspaceKeyID:     c.getenv(keyIDEnvVar),
+			WorkspaceKeySecret: c.getenv(keySecretEnvVar),
+			BaseURI:            c.getenv(baseURIEnvVar),
+		}
+		return c.profile, nil
+	}
+	if name == "" {
+		name = cfg.CurrentProfile
+	}
+	if name == "" {
+		name = "default"
+	}
+	p, ok := cfg.Profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("profile %q not found in %s", name, path)
+	}
+	c.profile = &p
+	return c.profile, nil
+}
+
+// newClient returns a client authenticated with the credentials of a profile, with further
+// options such as the HTTP client added by --debug-curl.
+func newClient(p profile, extra ...api.APIOption) (api.Interface, error) {
+	opts := []api.APIOption{api.WithUserAgentSuffix("stytchctl")}
+	if p.BaseURI != "" {
+		opts = append(opts, api.WithBaseURI(p.BaseURI))
+	}
+	opts = append(opts, extra...)
+	switch {
+	case p.WorkspaceKeyID != "" && p.WorkspaceKeySecret != "":
+		return api.NewClient(p.WorkspaceKeyID, p.WorkspaceKeySecret, opts...), nil
+	case p.AccessToken != "":
+		return api.NewAccessTokenClient(p.AccessToken, opts...), nil
+	}
+	return nil, fmt.Errorf("no credentials: set %s and %s, or configure a profile with --config",
+		keyIDEnvVar, keySecretEnvVar)
+}
+
+var profilesCommand = &command{
+	name:    "profiles",
+	summary: "List the profiles of the configuration file",
+	commands: []*command{
+		{name: "list", summary: "List profiles, marking the current one", run: profilesList},
+	},
+}
+
+func profilesList(_ context.Context, c *cli, args []string) error {
+	fs := c.flags()
+	if _, err := c.parse(fs, args, 0, 0); err != nil {
+		return err
+	}
+	cfg, _, err := c.readConfig()
+	if err != nil {
+		return err
+	}
+	current := c.profileName
+	if current == "" {
+		current = c.getenv(profileEnvVar)
+	}
+	if current == "" {
+		current = cfg.CurrentProfile
+	}
+	if current == "" {
+		current = "default"
+	}
+	names := make([]string, 0, len(cfg.Profiles))
+	for name := range cfg.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	w := tabwriter.NewWriter(c.stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "CURRENT\tNAME\tPROJECT\tENVIRONMENT")
+	for _, name := range names {
+		marker := ""
+		if name == current {
+			marker = "*"
+		}
+		p := cfg.Profiles[name]
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", marker, name, p.Project, p.Environment)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,200 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/reconcile"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/snapshot"
+)
+
+// Exit codes. Like diff(1) and `terraform plan -detailed-exitcode`, diff and apply exit with
+// exitChanges when the project did not match the configuration file, so that a CI job can tell
+// without parsing the output. Usage errors exit with exitError for those commands, so that
+// exitChanges is unambiguous.
+const (
+	exitOK      = 0
+	exitError   = 1
+	exitUsage   = 2
+	exitChanges = 2
+)
+
+// errChanges is returned by diff and apply when the project did not match the configuration file.
+// It is not reported as an error.
+var errChanges = errors.New("changes present")
+
+var (
+	exportCommand = &command{
+		name:    "export",
+		summary: "Print the configuration of a project as a snapshot document",
+		run:     exportSnapshot,
+	}
+	diffCommand = &command{
+		name:         "diff",
+		summary:      "Show the changes that apply would make; exits 2 if there are any",
+		run:          diffPlan,
+		detailedExit: true,
+	}
+	applyCommand = &command{
+		name:         "apply",
+		summary:      "Change a project to match a snapshot document; exits 2 if anything changed",
+		run:          applyPlan,
+		detailedExit: true,
+	}
+)
+
+func exportSnapshot(ctx context.Context, c *cli, args []string) error {
+	if _, err := c.parse(c.flags(), args, 0, 0); err != nil {
+		return err
+	}
+	projectSlug, err := c.projectSlug()
+	if err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	// Only --env limits the export: the profile's environment is a default for commands that
+	// need one, not a filter.
+	var opts []snapshot.Option
+	if c.env != "" {
+		opts = append(opts, snapshot.WithEnvironments(c.env))
+	}
+	snap, err := snapshot.Take(ctx, client, projectSlug, opts...)
+	if err != nil {
+		return err
+	}
+	// The table formats print the document in the same form as history and drift baselines, so
+	// that the output can be committed and passed to diff and apply as it is.
+	var out []byte
+	switch c.format.name {
+	case formatTable, formatWide, formatYAML:
+		out, err = snap.YAML()
+	case formatJSON:
+		out, err = snap.JSON()
+	default:
+		return c.print(snap)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = c.stdout.Write(out)
+	return err
+}
+
+func diffPlan(ctx context.Context, c *cli, args []string) error {
+	fs := c.flags()
+	file := fs.String("f", "", "YAML or JSON snapshot document with the desired configuration, or - for stdin")
+	markdown := fs.Bool("markdown", false, "print the plan as Markdown, for pull request comments")
+	if _, err := c.parse(fs, args, 0, 0); err != nil {
+		return err
+	}
+	plan, err := c.plan(ctx, *file)
+	if err != nil {
+		return err
+	}
+	if err := c.printPlan(plan, *markdown); err != nil {
+		return err
+	}
+	if !plan.Empty() {
+		return errChanges
+	}
+	return nil
+}
+
+func applyPlan(ctx context.Context, c *cli, args []string) error {
+	fs := c.flags()
+	file := fs.String("f", "", "YAML or JSON snapshot document with the desired configuration, or - for stdin")
+	var autoApprove bool
+	fs.BoolVar(&autoApprove, "auto-approve", false, "apply the plan without asking for confirmation")
+	fs.BoolVar(&autoApprove, "yes", false, "same as --auto-approve")
+	if _, err := c.parse(fs, args, 0, 0); err != nil {
+		return err
+	}
+	if *file == "-" && !autoApprove {
+		return usagef("-f - needs --auto-approve, since the confirmation is read from stdin")
+	}
+	plan, err := c.plan(ctx, *file)
+	if err != nil {
+		return err
+	}
+	if err := c.printPlan(plan, false); err != nil {
+		return err
+	}
+	if plan.Empty() {
+		return nil
+	}
+	if err := c.confirm(autoApprove, "Apply %d changes to project %s?", len(plan.Steps), plan.ProjectSlug); err != nil {
+		return err
+	}
+
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	result := plan.Apply(ctx, client)
+	for _, s := range result.Steps {
+		if s.Err != nil {
+			c.done("%s %s: %v", s.Status, s.Step, s.Err)
+		} else {
+			c.done("%s %s", s.Status, s.Step)
+		}
+	}
+	if err := result.Err(); err != nil {
+		return err
+	}
+	c.done("Applied %d changes.", result.Count(reconcile.StatusApplied))
+	return errChanges
+}
+
+// plan reads the desired configuration and computes the plan that reaches it.
+func (c *cli) plan(ctx context.Context, file string) (*reconcile.Plan, error) {
+	desired, err := c.readSnapshot(file)
+	if err != nil {
+		return nil, err
+	}
+	if c.project != "" && c.project != desired.Project.ProjectSlug {
+		return nil, usagef("%s is the configuration of project %s, not %s", file, desired.Project.ProjectSlug, c.project)
+	}
+	client, err := c.api()
+	if err != nil {
+		return nil, err
+	}
+	return reconcile.NewPlan(ctx, client, desired)
+}
+
+// readSnapshot reads a snapshot document from a file, or stdin if path is "-".
+func (c *cli) readSnapshot(path string) (*snapshot.Snapshot, error) {
+	switch path {
+	case "":
+		return nil, usagef("missing -f")
+	case "-":
+		data, err := io.ReadAll(c.stdin)
+		if err != nil {
+			return nil, err
+		}
+		return snapshot.Parse(data)
+	}
+	return snapshot.ReadFile(path)
+}
+
+// printPlan prints a plan as text or Markdown with the table formats, and otherwise prints the
+// JSON rendering of the plan in the selected format.
+func (c *cli) printPlan(plan *reconcile.Plan, markdown bool) error {
+	if !c.structured() {
+		format := reconcile.FormatText
+		if markdown {
+			format = reconcile.FormatMarkdown
+		}
+		return plan.Render(c.stdout, format)
+	}
+	var buf bytes.Buffer
+	if err := plan.Render(&buf, reconcile.FormatJSON); err != nil {
+		return err
+	}
+	return c.print(json.RawMessage(buf.Bytes()))
+}
@@ -0,0 +1,236 @@
+package main
+
+import (
+	"context"
+	"slices"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/emailtemplates"
+)
+
+var emailTemplatesCommand = &command{
+	name:    "email-templates",
+	summary: "Manage the email templates of a project",
+	commands: []*command{
+		{name: "list", summary: "List email templates", run: emailTemplatesList},
+		{name: "get", args: "<template-id>", summary: "Show an email template", run: emailTemplatesGet},
+		{name: "create", args: "<template-id>", summary: "Create an email template from a file", run: emailTemplatesCreate},
+		{name: "update", args: "<template-id>", summary: "Update an email template from a file", run: emailTemplatesUpdate},
+		{name: "delete", args: "<template-id>", summary: "Delete an email template", run: emailTemplatesDelete},
+		{name: "get-default", args: "<template-type>", summary: "Show the default template for a type of email", run: emailTemplatesGetDefault},
+		{name: "set-default", args: "<template-type> <template-id>", summary: "Set the default template for a type of email", run: emailTemplatesSetDefault},
+		{name: "unset-default", args: "<template-type>", summary: "Remove the default template for a type of email", run: emailTemplatesUnsetDefault},
+	},
+}
+
+func templateType(s string) (emailtemplates.TemplateType, error) {
+	t := emailtemplates.TemplateType(s)
+	if !slices.Contains(emailtemplates.TemplateTypes(), t) {
+		return "", usagef("unknown template type %q: must be one of %v", s, emailtemplates.TemplateTypes())
+	}
+	return t, nil
+}
+
+func emailTemplatesList(ctx context.Context, c *cli, args []string) error {
+	if _, err := c.parse(c.flags(), args, 0, 0); err != nil {
+		return err
+	}
+	projectSlug, err := c.projectSlug()
+	if err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	resp, err := client.EmailTemplatesAPI().GetAll(ctx, emailtemplates.GetAllRequest{ProjectSlug: projectSlug})
+	if err != nil {
+		return err
+	}
+	return c.print(resp.EmailTemplates)
+}
+
+func emailTemplatesGet(ctx context.Context, c *cli, args []string) error {
+	args, err := c.parse(c.flags(), args, 1, 1)
+	if err != nil {
+		return err
+	}
+	projectSlug, err := c.projectSlug()
+	if err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	resp, err := client.EmailTemplatesAPI().Get(ctx, emailtemplates.GetRequest{
+		ProjectSlug: projectSlug,
+		TemplateID:  args[0],
+	})
+	if err != nil {
+		return err
+	}
+	return c.print(resp.EmailTemplate)
+}
+
+func emailTemplatesCreate(ctx context.Context, c *cli, args []string) error {
+	fs := c.flags()
+	file := fs.String("f", "", "YAML or JSON file with the template, or - for stdin")
+	args, err := c.parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	projectSlug, err := c.projectSlug()
+	if err != nil {
+		return err
+	}
+	var req emailtemplates.CreateRequest
+	if err := c.readInput(*file, &req); err != nil {
+		return err
+	}
+	req.ProjectSlug = projectSlug
+	req.TemplateID = args[0]
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	resp, err := client.EmailTemplatesAPI().Create(ctx, req)
+	if err != nil {
+		return err
+	}
+	return c.print(resp.EmailTemplate)
+}
+
+func emailTemplatesUpdate(ctx context.Context, c *cli, args []string) error {
+	fs := c.flags()
+	file := fs.String("f", "", "YAML or JSON file with the fields to change, or - for stdin")
+	args, err := c.parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	projectSlug, err := c.projectSlug()
+	if err != nil {
+		return err
+	}
+	var req emailtemplates.UpdateRequest
+	if err := c.readInput(*file, &req); err != nil {
+		return err
+	}
+	req.ProjectSlug = projectSlug
+	req.TemplateID = args[0]
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	resp, err := client.EmailTemplatesAPI().Update(ctx, req)
+	if err != nil {
+		return err
+	}
+	return c.print(resp.EmailTemplate)
+}
+
+func emailTemplatesDelete(ctx context.Context, c *cli, args []string) error {
+	fs := c.flags()
+	yes := fs.Bool("yes", false, "do not ask for confirmation")
+	args, err := c.parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	projectSlug, err := c.projectSlug()
+	if err != nil {
+		return err
+	}
+	if err := c.confirm(*yes, "Delete email template %s of project %s?", args[0], projectSlug); err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	if _, err := client.EmailTemplatesAPI().Delete(ctx, emailtemplates.DeleteRequest{
+		ProjectSlug: projectSlug,
+		TemplateID:  args[0],
+	}); err != nil {
+		return err
+	}
+	return c.done("deleted email template %s", args[0])
+}
+
+func emailTemplatesGetDefault(ctx context.Context, c *cli, args []string) error {
+	args, err := c.parse(c.flags(), args, 1, 1)
+	if err != nil {
+		return err
+	}
+	typ, err := templateType(args[0])
+	if err != nil {
+		return err
+	}
+	projectSlug, err := c.projectSlug()
+	if err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	resp, err := client.EmailTemplatesAPI().GetDefault(ctx, emailtemplates.GetDefaultRequest{
+		ProjectSlug:       projectSlug,
+		EmailTemplateType: typ,
+	})
+	if err != nil {
+		return err
+	}
+	return c.print(resp.TemplateID)
+}
+
+func emailTemplatesSetDefault(ctx context.Context, c *cli, args []string) error {
+	args, err := c.parse(c.flags(), args, 2, 2)
+	if err != nil {
+		return err
+	}
+	typ, err := templateType(args[0])
+	if err != nil {
+		return err
+	}
+	projectSlug, err := c.projectSlug()
+	if err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	if _, err := client.EmailTemplatesAPI().SetDefault(ctx, emailtemplates.SetDefaultRequest{
+		ProjectSlug:       projectSlug,
+		EmailTemplateType: typ,
+		TemplateID:        args[1],
+	}); err != nil {
+		return err
+	}
+	return c.done("set the default %s template to %s", typ, args[1])
+}
+
+func emailTemplatesUnsetDefault(ctx context.Context, c *cli, args []string) error {
+	args, err := c.parse(c.flags(), args, 1, 1)
+	if err != nil {
+		return err
+	}
+	typ, err := templateType(args[0])
+	if err != nil {
+		return err
+	}
+	projectSlug, err := c.projectSlug()
+	if err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	if _, err := client.EmailTemplatesAPI().UnsetDefault(ctx, emailtemplates.UnsetDefaultRequest{
+		ProjectSlug:       projectSlug,
+		EmailTemplateType: typ,
+	}); err != nil {
+		return err
+	}
+	return c.done("removed the default %s template", typ)
+}
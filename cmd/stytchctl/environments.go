@@ -0,0 +1,194 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/environments"
+)
+
+var environmentsCommand = &command{
+	name:    "envs",
+	summary: "Manage the environments of a project",
+	commands: []*command{
+		{name: "list", summary: "List the environments of the project", run: environmentsList},
+		{name: "get", args: "[<env-slug>]", summary: "Show an environment", run: environmentsGet},
+		{name: "create", args: "<name>", summary: "Create an environment", run: environmentsCreate},
+		{name: "update", args: "[<env-slug>]", summary: "Update the settings of an environment", run: environmentsUpdate},
+		{name: "delete", args: "[<env-slug>]", summary: "Delete an environment", run: environmentsDelete},
+		{name: "metrics", args: "[<env-slug>]", summary: "Show the user, organization, member and M2M client counts of an environment", run: environmentsMetrics},
+	},
+}
+
+// envArg returns the project slug and the environment slug given as an argument or with --env.
+func (c *cli) envArg(args []string) (projectSlug, envSlug string, err error) {
+	if len(args) == 0 {
+		return c.envSlugs()
+	}
+	if projectSlug, err = c.projectSlug(); err != nil {
+		return "", "", err
+	}
+	return projectSlug, args[0], nil
+}
+
+func environmentsList(ctx context.Context, c *cli, args []string) error {
+	if _, err := c.parse(c.flags(), args, 0, 0); err != nil {
+		return err
+	}
+	projectSlug, err := c.projectSlug()
+	if err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	resp, err := client.EnvironmentsAPI().GetAll(ctx, environments.GetAllRequest{ProjectSlug: projectSlug})
+	if err != nil {
+		return err
+	}
+	return c.print(resp.Environments)
+}
+
+func environmentsGet(ctx context.Context, c *cli, args []string) error {
+	args, err := c.parse(c.flags(), args, 0, 1)
+	if err != nil {
+		return err
+	}
+	projectSlug, envSlug, err := c.envArg(args)
+	if err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	resp, err := client.EnvironmentsAPI().Get(ctx, environments.GetRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+	})
+	if err != nil {
+		return err
+	}
+	return c.print(resp.Environment)
+}
+
+func environmentsCreate(ctx context.Context, c *cli, args []string) error {
+	fs := c.flags()
+	typ := fs.String("type", string(environments.EnvironmentTypeTest), fmt.Sprintf("type of the environment: one of %v", environments.EnvironmentTypes()))
+	slug := fs.String("slug", "", "slug of the environment (default generated from the name)")
+	file := fs.String("f", "", "YAML or JSON file with further settings, or - for stdin")
+	args, err := c.parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	projectSlug, err := c.projectSlug()
+	if err != nil {
+		return err
+	}
+	var req environments.CreateRequest
+	if *file != "" {
+		if err := c.readInput(*file, &req); err != nil {
+			return err
+		}
+	}
+	req.ProjectSlug = projectSlug
+	req.Name = args[0]
+	req.Type = environments.EnvironmentType(*typ)
+	if *slug != "" {
+		req.EnvironmentSlug = slug
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	resp, err := client.EnvironmentsAPI().Create(ctx, req)
+	if err != nil {
+		return err
+	}
+	return c.print(resp.Environment)
+}
+
+func environmentsUpdate(ctx context.Context, c *cli, args []string) error {
+	fs := c.flags()
+	name := fs.String("name", "", "new name of the environment")
+	file := fs.String("f", "", "YAML or JSON file with the settings to change, or - for stdin")
+	args, err := c.parse(fs, args, 0, 1)
+	if err != nil {
+		return err
+	}
+	projectSlug, envSlug, err := c.envArg(args)
+	if err != nil {
+		return err
+	}
+	var req environments.UpdateRequest
+	if *file != "" {
+		if err := c.readInput(*file, &req); err != nil {
+			return err
+		}
+	}
+	req.ProjectSlug = projectSlug
+	req.EnvironmentSlug = envSlug
+	if *name != "" {
+		req.Name = name
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	resp, err := client.EnvironmentsAPI().Update(ctx, req)
+	if err != nil {
+		return err
+	}
+	return c.print(resp.Environment)
+}
+
+func environmentsDelete(ctx context.Context, c *cli, args []string) error {
+	fs := c.flags()
+	yes := fs.Bool("yes", false, "do not ask for confirmation")
+	args, err := c.parse(fs, args, 0, 1)
+	if err != nil {
+		return err
+	}
+	projectSlug, envSlug, err := c.envArg(args)
+	if err != nil {
+		return err
+	}
+	if err := c.confirm(*yes, "Delete environment %s of project %s?", envSlug, projectSlug); err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	if _, err := client.EnvironmentsAPI().Delete(ctx, environments.DeleteRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+	}); err != nil {
+		return err
+	}
+	return c.done("deleted environment %s", envSlug)
+}
+
+func environmentsMetrics(ctx context.Context, c *cli, args []string) error {
+	args, err := c.parse(c.flags(), args, 0, 1)
+	if err != nil {
+		return err
+	}
+	projectSlug, envSlug, err := c.envArg(args)
+	if err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	resp, err := client.EnvironmentsAPI().GetMetrics(ctx, environments.GetMetricsRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+	})
+	if err != nil {
+		return err
+	}
+	return c.print(resp.Metrics)
+}
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"context"
+	"slices"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/eventlogstreaming"
+)
+
+var eventLogStreamingCommand = &command{
+	name:    "event-log-streaming",
+	summary: "Manage event log streaming destinations of an environment",
+	commands: []*command{
+		{name: "get", args: "<destination-type>", summary: "Show the configuration of a destination, with credentials masked", run: eventLogStreamingGet},
+		{name: "create", args: "<destination-type>", summary: "Configure a destination from a file", run: eventLogStreamingCreate},
+		{name: "update", args: "<destination-type>", summary: "Update the configuration of a destination from a file", run: eventLogStreamingUpdate},
+		{name: "delete", args: "<destination-type>", summary: "Delete the configuration of a destination", run: eventLogStreamingDelete},
+		{name: "enable", args: "<destination-type>", summary: "Start streaming event logs to a destination", run: eventLogStreamingEnable},
+		{name: "disable", args: "<destination-type>", summary: "Stop streaming event logs to a destination", run: eventLogStreamingDisable},
+	},
+}
+
+func destinationType(s string) (eventlogstreaming.DestinationType, error) {
+	t := eventlogstreaming.DestinationType(s)
+	if !slices.Contains(eventlogstreaming.DestinationTypes(), t) {
+		return "", usagef("unknown destination type %q: must be one of %v", s, eventlogstreaming.DestinationTypes())
+	}
+	return t, nil
+}
+
+// destinationArgs parses the arguments of a command that takes a destination type.
+func (c *cli) destinationArgs(args []string) (projectSlug, envSlug string, typ eventlogstreaming.DestinationType, err error) {
+	if typ, err = destinationType(args[0]); err != nil {
+		return "", "", "", err
+	}
+	projectSlug, envSlug, err = c.envSlugs()
+	return projectSlug, envSlug, typ, err
+}
+
+func eventLogStreamingGet(ctx context.Context, c *cli, args []string) error {
+	args, err := c.parse(c.flags(), args, 1, 1)
+	if err != nil {
+		return err
+	}
+	projectSlug, envSlug, typ, err := c.destinationArgs(args)
+	if err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	resp, err := client.EventLogStreamingAPI().Get(ctx, eventlogstreaming.GetRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+		DestinationType: typ,
+	})
+	if err != nil {
+		return err
+	}
+	return c.print(resp.EventLogStreamingConfig)
+}
+
+func eventLogStreamingCreate(ctx context.Context, c *cli, args []string) error {
+	fs := c.flags()
+	file := fs.String("f", "", "YAML or JSON file with the destination config, or - for stdin")
+	args, err := c.parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	projectSlug, envSlug, typ, err := c.destinationArgs(args)
+	if err != nil {
+		return err
+	}
+	var config eventlogstreaming.DestinationConfig
+	if err := c.readInput(*file, &config); err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	resp, err := client.EventLogStreamingAPI().Create(ctx, eventlogstreaming.CreateRequest{
+		ProjectSlug:       projectSlug,
+		EnvironmentSlug:   envSlug,
+		DestinationType:   typ,
+		DestinationConfig: &config,
+	})
+	if err != nil {
+		return err
+	}
+	return c.print(resp.EventLogStreamingConfig)
+}
+
+func eventLogStreamingUpdate(ctx context.Context, c *cli, args []string) error {
+	fs := c.flags()
+	file := fs.String("f", "", "YAML or JSON file with the destination config, or - for stdin")
+	args, err := c.parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	projectSlug, envSlug, typ, err := c.destinationArgs(args)
+	if err != nil {
+		return err
+	}
+	var config eventlogstreaming.DestinationConfig
+	if err := c.readInput(*file, &config); err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	resp, err := client.EventLogStreamingAPI().Update(ctx, eventlogstreaming.UpdateRequest{
+		ProjectSlug:       projectSlug,
+		EnvironmentSlug:   envSlug,
+		DestinationType:   typ,
+		DestinationConfig: &config,
+	})
+	if err != nil {
+		return err
+	}
+	return c.print(resp.EventLogStreamingConfig)
+}
+
+func eventLogStreamingDelete(ctx context.Context, c *cli, args []string) error {
+	fs := c.flags()
+	yes := fs.Bool("yes", false, "do not ask for confirmation")
+	args, err := c.parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	projectSlug, envSlug, typ, err := c.destinationArgs(args)
+	if err != nil {
+		return err
+	}
+	if err := c.confirm(*yes, "Delete the %s event log streaming config of %s/%s?", typ, projectSlug, envSlug); err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	if _, err := client.EventLogStreamingAPI().Delete(ctx, eventlogstreaming.DeleteRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+		DestinationType: typ,
+	}); err != nil {
+		return err
+	}
+	return c.done("deleted the %s event log streaming config", typ)
+}
+
+func eventLogStreamingEnable(ctx context.Context, c *cli, args []string) error {
+	args, err := c.parse(c.flags(), args, 1, 1)
+	if err != nil {
+		return err
+	}
+	projectSlug, envSlug, typ, err := c.destinationArgs(args)
+	if err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	if _, err := client.EventLogStreamingAPI().Enable(ctx, eventlogstreaming.EnableRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+		DestinationType: typ,
+	}); err != nil {
+		return err
+	}
+	return c.done("enabled event log streaming to %s", typ)
+}
+
+func eventLogStreamingDisable(ctx context.Context, c *cli, args []string) error {
+	args, err := c.parse(c.flags(), args, 1, 1)
+	if err != nil {
+		return err
+	}
+	projectSlug, envSlug, typ, err := c.destinationArgs(args)
+	if err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	if _, err := client.EventLogStreamingAPI().Disable(ctx, eventlogstreaming.DisableRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+		DestinationType: typ,
+	}); err != nil {
+		return err
+	}
+	return c.done("disabled event log streaming to %s", typ)
+}
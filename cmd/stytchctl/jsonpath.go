@@ -0,0 +1,237 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// jsonPath is a template in the JSONPath dialect of kubectl: text with expressions in braces.
+//
+//	{.environment_slug}                   a field
+//	{[0].valid_types[*].type}             list elements, all of them with *
+//	{range .[*]}{.url}{"\n"}{end}         a loop over a list, with a string literal
+//
+// Expressions that match several values print them separated by spaces. Strings are printed as
+// they are and other values as JSON. Fields that are missing, such as false booleans, which the
+// models omit, match nothing.
+type jsonPath struct {
+	nodes []jsonPathNode
+}
+
+// jsonPathNode is literal text, a path to print, or a range over a path with a body.
+type jsonPathNode struct {
+	text  string
+	path  []pathSegment
+	isRef bool
+	body  []jsonPathNode
+	loop  bool
+}
+
+// pathSegment is a field name, a list index, or a wildcard if all is set.
+type pathSegment struct {
+	field string
+	index *int
+	all   bool
+}
+
+func parseJSONPath(s string) (*jsonPath, error) {
+	var (
+		stack = [][]jsonPathNode{nil}
+		paths [][]pathSegment
+	)
+	add := func(n jsonPathNode) { stack[len(stack)-1] = append(stack[len(stack)-1], n) }
+	for s != "" {
+		open := strings.IndexByte(s, '{')
+		if open < 0 {
+			add(jsonPathNode{text: s})
+			break
+		}
+		if open > 0 {
+			add(jsonPathNode{text: s[:open]})
+		}
+		end, err := closingBrace(s, open)
+		if err != nil {
+			return nil, err
+		}
+		expr := strings.TrimSpace(s[open+1 : end])
+		s = s[end+1:]
+		switch {
+		case expr == "end":
+			if len(stack) == 1 {
+				return nil, errors.New("{end} without {range}")
+			}
+			body := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			add(jsonPathNode{loop: true, path: paths[len(paths)-1], body: body})
+			paths = paths[:len(paths)-1]
+		case strings.HasPrefix(expr, "range "):
+			path, err := parsePath(strings.TrimSpace(strings.TrimPrefix(expr, "range ")))
+			if err != nil {
+				return nil, err
+			}
+			stack = append(stack, nil)
+			paths = append(paths, path)
+		case strings.HasPrefix(expr, `"`):
+			text, err := strconv.Unquote(expr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid string %s", expr)
+			}
+			add(jsonPathNode{text: text})
+		default:
+			path, err := parsePath(expr)
+			if err != nil {
+				return nil, err
+			}
+			add(jsonPathNode{isRef: true, path: path})
+		}
+	}
+	if len(stack) > 1 {
+		return nil, errors.New("{range} without {end}")
+	}
+	return &jsonPath{nodes: stack[0]}, nil
+}
+
+// closingBrace returns the index of the brace that closes the one at open, skipping quoted strings.
+func closingBrace(s string, open int) (int, error) {
+	inString := false
+	for i := open + 1; i < len(s); i++ {
+		switch {
+		case inString && s[i] == '\\':
+			i++
+		case s[i] == '"':
+			inString = !inString
+		case !inString && s[i] == '}':
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("unclosed { at offset %d", open)
+}
+
+// parsePath parses a path such as ".redirect_urls[0].url", "[*]" or "$".
+func parsePath(s string) ([]pathSegment, error) {
+	orig := s
+	s = strings.TrimPrefix(s, "$")
+	var path []pathSegment
+	for s != "" {
+		switch s[0] {
+		case '.':
+			s = s[1:]
+			n := strings.IndexAny(s, ".[")
+			if n < 0 {
+				n = len(s)
+			}
+			switch name := s[:n]; name {
+			case "":
+				if n < len(s) && s[n] == '.' {
+					return nil, fmt.Errorf("invalid path %q: recursive descent is not supported", orig)
+				}
+			case "*":
+				path = append(path, pathSegment{all: true})
+			default:
+				path = append(path, pathSegment{field: name})
+			}
+			s = s[n:]
+		case '[':
+			end := strings.IndexByte(s, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("invalid path %q: unclosed [", orig)
+			}
+			inner := s[1:end]
+			s = s[end+1:]
+			switch {
+			case inner == "*":
+				path = append(path, pathSegment{all: true})
+			case len(inner) >= 2 && (inner[0] == '\'' || inner[0] == '"') && inner[len(inner)-1] == inner[0]:
+				path = append(path, pathSegment{field: inner[1 : len(inner)-1]})
+			default:
+				i, err := strconv.Atoi(inner)
+				if err != nil {
+					return nil, fmt.Errorf("invalid path %q: %q is not an index", orig, inner)
+				}
+				path = append(path, pathSegment{index: &i})
+			}
+		default:
+			return nil, fmt.Errorf("invalid path %q: expected . or [", orig)
+		}
+	}
+	return path, nil
+}
+
+func (p *jsonPath) execute(w io.Writer, doc any) error {
+	var b strings.Builder
+	if err := executeNodes(&b, p.nodes, doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func executeNodes(b *strings.Builder, nodes []jsonPathNode, v any) error {
+	for _, n := range nodes {
+		switch {
+		case n.loop:
+			for _, e := range lookup(v, n.path) {
+				if err := executeNodes(b, n.body, e); err != nil {
+					return err
+				}
+			}
+		case n.isRef:
+			for i, e := range lookup(v, n.path) {
+				if i > 0 {
+					b.WriteByte(' ')
+				}
+				if s, ok := e.(string); ok {
+					b.WriteString(s)
+					continue
+				}
+				out, err := json.Marshal(e)
+				if err != nil {
+					return err
+				}
+				b.Write(out)
+			}
+		default:
+			b.WriteString(n.text)
+		}
+	}
+	return nil
+}
+
+// lookup returns the values a path matches in v.
+func lookup(v any, path []pathSegment) []any {
+	values := []any{v}
+	for _, seg := range path {
+		var next []any
+		for _, v := range values {
+			switch v := v.(type) {
+			case map[string]any:
+				if seg.all {
+					for _, k := range sortedKeys(v) {
+						next = append(next, v[k])
+					}
+				} else if e, ok := v[seg.field]; ok && seg.index == nil {
+					next = append(next, e)
+				}
+			case []any:
+				switch {
+				case seg.all:
+					next = append(next, v...)
+				case seg.index != nil:
+					i := *seg.index
+					if i < 0 {
+						i += len(v)
+					}
+					if i >= 0 && i < len(v) {
+						next = append(next, v[i])
+					}
+				}
+			}
+		}
+		values = next
+	}
+	return values
+}
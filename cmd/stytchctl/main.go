@@ -0,0 +1,407 @@
+// Command stytchctl manages Stytch projects, environments and their resources from the command
+// line, using the clients in pkg/api.
+//
+// Commands are grouped by resource and mirror the methods of the resource clients:
+//
+//	stytchctl projects list
+//	stytchctl envs metrics --project my-project --env production
+//	stytchctl secrets create --project my-project --env test
+//	stytchctl redirect-urls create https://example.com/callback --type LOGIN --default LOGIN
+//	stytchctl rbac set -f policy.yaml
+//	stytchctl trusted-token-profiles pem add <profile-id> key.pem
+//
+// Run stytchctl -h for the list of commands and stytchctl <command> -h for the flags of a command.
+//
+// Results are printed as tables unless -o selects another format: wide, for more columns, or json,
+// yaml, jsonpath=<expression> or go-template=<template>, which work on the JSON encoding of the
+// response. --no-headers omits the header row of tables. --debug-curl prints every API request as
+// a curl command, followed by the raw response, on stderr, with the secrets and passwords in their
+// bodies masked unless --debug-curl-credentials is also given.
+//
+// export, diff and apply manage a project declaratively, as a snapshot document that can be kept
+// in version control. diff and apply exit with 0 if the project matches the document, 2 if it did
+// not and 1 on errors, so that they can be used as CI checks.
+//
+// browse is an interactive, line-based view of projects, environments and their resources, with
+// editing of simple fields.
+//
+// Credentials, a base URI and a default project and environment are read from a profile in the
+// configuration file, stytchctl/config.yaml in the directory returned by os.UserConfigDir unless
+// --config or STYTCHCTL_CONFIG names another:
+//
+//	current_profile: staging
+//	profiles:
+//	  staging:
+//	    workspace_key_id: workspace-key-prod-...
+//	    workspace_key_secret: ...
+//	    project: my-project
+//	    environment: test
+//	  ci:
+//	    access_token: ...
+//	    base_uri: https://management.stytch.com
+//
+// --profile or STYTCHCTL_PROFILE selects another profile, and --project and --env override the
+// profile's project and environment. Without a profile, the STYTCH_WORKSPACE_KEY_ID,
+// STYTCH_WORKSPACE_KEY_SECRET and STYTCH_WORKSPACE_BASE_URI environment variables are used.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"strings"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/curl"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	os.Exit(newCLI().run(ctx, os.Args[1:]))
+}
+
+// cli holds the state of one invocation of stytchctl.
+type cli struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+	getenv func(string) string
+	// newClient returns the client for a profile. Tests replace it to use a fake.
+	newClient func(profile, ...api.APIOption) (api.Interface, error)
+
+	// Global flags.
+	configPath           string
+	profileName          string
+	project              string
+	env                  string
+	output               string
+	noHeaders            bool
+	debugCurl            bool
+	debugCurlCredentials bool
+
+	// format is the parsed output flag, set once the command's flags are parsed.
+	format *outputFormat
+	// cmd is the command being run and path its full name, such as "stytchctl secrets list".
+	cmd  *command
+	path string
+	// profile is the resolved profile, once loaded.
+	profile *profile
+	client  api.Interface
+}
+
+func newCLI() *cli {
+	return &cli{
+		stdin:     os.Stdin,
+		stdout:    os.Stdout,
+		stderr:    os.Stderr,
+		getenv:    os.Getenv,
+		newClient: newClient,
+		output:    formatTable,
+	}
+}
+
+// command is a group of commands or, if run is set, a command that can be run.
+type command struct {
+	name string
+	// args describes the positional arguments of the command, such as "<secret-id>".
+	args     string
+	summary  string
+	commands []*command
+	run      func(ctx context.Context, c *cli, args []string) error
+	// detailedExit is set for commands that exit with exitChanges when they find differences.
+	detailedExit bool
+}
+
+func (cmd *command) find(name string) *command {
+	for _, sub := range cmd.commands {
+		if sub.name == name {
+			return sub
+		}
+	}
+	return nil
+}
+
+var root = &command{
+	name:    "stytchctl",
+	summary: "stytchctl manages Stytch projects, environments and their resources.",
+	commands: []*command{
+		projectsCommand,
+		environmentsCommand,
+		secretsCommand,
+		publicTokensCommand,
+		redirectURLsCommand,
+		rbacCommand,
+		sdkCommand,
+		emailTemplatesCommand,
+		eventLogStreamingCommand,
+		trustedTokenProfilesCommand,
+		jwtTemplatesCommand,
+		passwordStrengthCommand,
+		countryCodesCommand,
+		migrationCommand,
+		exportCommand,
+		diffCommand,
+		applyCommand,
+		browseCommand,
+		profilesCommand,
+	},
+}
+
+// usageError is an error in the way a command was invoked. An empty message means that the usage
+// has already been printed.
+type usageError struct {
+	msg string
+}
+
+func (e *usageError) Error() string { return e.msg }
+
+func usagef(format string, args ...any) error {
+	return &usageError{msg: fmt.Sprintf(format, args...)}
+}
+
+// run runs the command named by args and returns the exit code: 0 on success, 1 if the command
+// failed and 2 if it was invoked incorrectly. Commands with detailedExit set instead exit with 2
+// when they find changes, and with 1 if they were invoked incorrectly.
+func (c *cli) run(ctx context.Context, args []string) int {
+	err := c.dispatch(ctx, args)
+	usageCode := exitUsage
+	if c.cmd.detailedExit {
+		usageCode = exitError
+	}
+	var uerr *usageError
+	switch {
+	case err == nil || errors.Is(err, flag.ErrHelp):
+		return exitOK
+	case errors.Is(err, errChanges):
+		return exitChanges
+	case errors.As(err, &uerr) && uerr.msg == "":
+		return usageCode
+	case errors.As(err, &uerr):
+		fmt.Fprintf(c.stderr, "%s: %v\nRun '%s -h' for usage.\n", root.name, err, c.path)
+		return usageCode
+	default:
+		fmt.Fprintf(c.stderr, "%s: %v\n", root.name, err)
+		return exitError
+	}
+}
+
+// dispatch finds the command named by args and runs it. Global flags may be given before any
+// command name as well as after it.
+func (c *cli) dispatch(ctx context.Context, args []string) error {
+	cmd := root
+	path := []string{root.name}
+	for {
+		c.cmd, c.path = cmd, strings.Join(path, " ")
+		if cmd.run != nil {
+			return cmd.run(ctx, c, args)
+		}
+		fs := c.flagSet(cmd)
+		if err := parseFlags(fs, args); err != nil {
+			return err
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			fs.Usage()
+			return &usageError{}
+		}
+		sub := cmd.find(args[0])
+		if sub == nil {
+			return usagef("unknown command %q", strings.Join(append(path[1:], args[0]), " "))
+		}
+		cmd, path, args = sub, append(path, sub.name), args[1:]
+	}
+}
+
+// flagSet returns a flag set for a command, with the global flags already defined.
+func (c *cli) flagSet(cmd *command) *flag.FlagSet {
+	fs := flag.NewFlagSet(c.path, flag.ContinueOnError)
+	fs.SetOutput(c.stderr)
+	fs.StringVar(&c.configPath, "config", c.configPath, "path of the configuration file")
+	fs.StringVar(&c.profileName, "profile", c.profileName, "configuration profile to use")
+	fs.StringVar(&c.project, "project", c.project, "project slug (default from the profile)")
+	fs.StringVar(&c.env, "env", c.env, "environment slug (default from the profile)")
+	for _, name := range []string{"o", "output"} {
+		fs.StringVar(&c.output, name, c.output, "output format: table, wide, json, yaml, jsonpath=<expression> or go-template=<template>")
+	}
+	fs.BoolVar(&c.noHeaders, "no-headers", c.noHeaders, "omit the header row of table output")
+	fs.BoolVar(&c.debugCurl, "debug-curl", c.debugCurl, "print every API request as a curl command, and its response, to stderr")
+	fs.BoolVar(&c.debugCurlCredentials, "debug-curl-credentials", c.debugCurlCredentials,
+		"with --debug-curl, print secrets and passwords in request and response bodies instead of masking them")
+	fs.Usage = func() { c.usage(fs, cmd) }
+	return fs
+}
+
+func (c *cli) usage(fs *flag.FlagSet, cmd *command) {
+	w := c.stderr
+	switch {
+	case cmd.run != nil && cmd.args != "":
+		fmt.Fprintf(w, "Usage: %s %s [flags]\n\n", c.path, cmd.args)
+	case cmd.run != nil:
+		fmt.Fprintf(w, "Usage: %s [flags]\n\n", c.path)
+	default:
+		fmt.Fprintf(w, "Usage: %s <command> [flags]\n\n", c.path)
+	}
+	fmt.Fprintf(w, "%s\n", cmd.summary)
+	if len(cmd.commands) > 0 {
+		width := 0
+		for _, sub := range cmd.commands {
+			width = max(width, len(sub.name))
+		}
+		fmt.Fprintf(w, "\nCommands:\n")
+		for _, sub := range cmd.commands {
+			fmt.Fprintf(w, "  %-*s  %s\n", width, sub.name, sub.summary)
+		}
+	}
+	fmt.Fprintf(w, "\nFlags:\n")
+	fs.PrintDefaults()
+}
+
+// flags returns the flag set of the command being run.
+func (c *cli) flags() *flag.FlagSet {
+	return c.flagSet(c.cmd)
+}
+
+// parseFlags parses flags. The flag package prints errors and the usage itself, so errors other
+// than a request for help are returned as an empty usageError.
+func parseFlags(fs *flag.FlagSet, args []string) error {
+	err := fs.Parse(args)
+	if err != nil && !errors.Is(err, flag.ErrHelp) {
+		return &usageError{}
+	}
+	return err
+}
+
+// parse parses the flags and positional arguments of a command, which may be interleaved, and
+// checks that there are between minArgs and maxArgs positional arguments. A maxArgs of -1 means no
+// limit. Everything after "--" is a positional argument.
+func (c *cli) parse(fs *flag.FlagSet, args []string, minArgs, maxArgs int) ([]string, error) {
+	var positional []string
+	for len(args) > 0 {
+		if err := parseFlags(fs, args); err != nil {
+			return nil, err
+		}
+		rest := fs.Args()
+		if n := len(args) - len(rest); n > 0 && args[n-1] == "--" {
+			positional = append(positional, rest...)
+			break
+		}
+		if len(rest) > 0 {
+			positional = append(positional, rest[0])
+			rest = rest[1:]
+		}
+		args = rest
+	}
+	switch {
+	case len(positional) < minArgs:
+		return nil, usagef("too few arguments")
+	case maxArgs >= 0 && len(positional) > maxArgs:
+		return nil, usagef("unexpected argument %q", positional[maxArgs])
+	}
+	// Check the output format before the command calls the API.
+	format, err := parseOutput(c.output)
+	if err != nil {
+		return nil, err
+	}
+	c.format = format
+	return positional, nil
+}
+
+// api returns the client for the selected profile.
+func (c *cli) api() (api.Interface, error) {
+	if c.client != nil {
+		return c.client, nil
+	}
+	p, err := c.loadProfile()
+	if err != nil {
+		return nil, err
+	}
+	var opts []api.APIOption
+	if c.debugCurl {
+		transport := &curl.Transport{Out: c.stderr, ShowCredentials: c.debugCurlCredentials}
+		if u, err := url.Parse(p.BaseURI); err == nil {
+			transport.BasePath = u.Path
+		}
+		opts = append(opts, api.WithHTTPClient(&http.Client{Transport: transport}))
+	}
+	if c.client, err = c.newClient(*p, opts...); err != nil {
+		return nil, err
+	}
+	return c.client, nil
+}
+
+// projectSlug returns the project given with --project or set in the profile.
+func (c *cli) projectSlug() (string, error) {
+	if c.project != "" {
+		return c.project, nil
+	}
+	p, err := c.loadProfile()
+	if err != nil {
+		return "", err
+	}
+	if p.Project == "" {
+		return "", usagef("no project: use --project or set project in the profile")
+	}
+	return p.Project, nil
+}
+
+// envSlugs returns the project and environment given with --project and --env or set in the
+// profile.
+func (c *cli) envSlugs() (projectSlug, envSlug string, err error) {
+	if projectSlug, err = c.projectSlug(); err != nil {
+		return "", "", err
+	}
+	if c.env != "" {
+		return projectSlug, c.env, nil
+	}
+	p, err := c.loadProfile()
+	if err != nil {
+		return "", "", err
+	}
+	if p.Environment == "" {
+		return "", "", usagef("no environment: use --env or set environment in the profile")
+	}
+	return projectSlug, p.Environment, nil
+}
+
+// confirm asks the user to confirm a destructive action unless yes is set.
+func (c *cli) confirm(yes bool, format string, args ...any) error {
+	if yes {
+		return nil
+	}
+	fmt.Fprintf(c.stderr, format+" [y/N] ", args...)
+	var answer string
+	_, _ = fmt.Fscanln(c.stdin, &answer)
+	switch strings.ToLower(answer) {
+	case "y", "yes":
+		return nil
+	}
+	return errors.New("aborted; pass --yes to skip the confirmation")
+}
+
+// done reports the result of a command that has nothing else to print. The report goes to stderr
+// when the output format is for programs, so that stdout stays parseable.
+func (c *cli) done(format string, args ...any) error {
+	w := c.stdout
+	if c.structured() {
+		w = c.stderr
+	}
+	_, err := fmt.Fprintf(w, format+"\n", args...)
+	return err
+}
+
+// listFlag is a flag that may be repeated, and whose values may also be separated by commas.
+type listFlag []string
+
+func (l *listFlag) String() string { return strings.Join(*l, ",") }
+
+func (l *listFlag) Set(v string) error {
+	*l = append(*l, strings.Split(v, ",")...)
+	return nil
+}
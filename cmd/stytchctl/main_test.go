@@ -0,0 +1,949 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/apifake"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/emailtemplates"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/environments"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/eventlogstreaming"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/rbacpolicy"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/redirecturls"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/sdk"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/secrets"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/trustedtokenprofiles"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/snapshot"
+)
+
+type result struct {
+	code   int
+	stdout string
+	stderr string
+}
+
+// testCLI returns a cli that uses fake, reads the given stdin and has no configuration file or
+// environment variables unless the test sets them.
+func testCLI(t *testing.T, fake *apifake.API, stdin string) (*cli, *[]profile) {
+	t.Helper()
+	var used []profile
+	c := newCLI()
+	c.stdin = strings.NewReader(stdin)
+	c.getenv = func(string) string { return "" }
+	c.configPath = filepath.Join(t.TempDir(), "config.yaml")
+	c.newClient = func(p profile, _ ...api.APIOption) (api.Interface, error) {
+		used = append(used, p)
+		return fake, nil
+	}
+	return c, &used
+}
+
+func run(c *cli, args ...string) result {
+	var stdout, stderr bytes.Buffer
+	c.stdout, c.stderr = &stdout, &stderr
+	code := c.run(context.Background(), args)
+	return result{code: code, stdout: stdout.String(), stderr: stderr.String()}
+}
+
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestProjects(t *testing.T) {
+	t.Run("list", func(t *testing.T) {
+		// Arrange
+		fake := apifake.New()
+		fake.Projects.GetAllReturns(&projects.GetAllResponse{Projects: []projects.Project{{
+			ProjectSlug: "my-project",
+			Name:        "My project",
+			Vertical:    projects.VerticalB2B,
+			CreatedAt:   time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC),
+		}}}, nil)
+		c, _ := testCLI(t, fake, "")
+
+		// Act
+		res := run(c, "projects", "list")
+
+		// Assert
+		require.Equal(t, 0, res.code, res.stderr)
+		assert.Equal(t, ""+
+			"SLUG         NAME         VERTICAL   CREATED\n"+
+			"my-project   My project   B2B        2026-10-19T12:00:00Z\n", res.stdout)
+	})
+
+	t.Run("create", func(t *testing.T) {
+		// Arrange
+		fake := apifake.New()
+		c, _ := testCLI(t, fake, "")
+
+		// Act
+		res := run(c, "projects", "create", "My project", "--vertical", "CONSUMER", "--slug", "my-project")
+
+		// Assert
+		require.Equal(t, 0, res.code, res.stderr)
+		slug := "my-project"
+		assert.Equal(t, []projects.CreateRequest{{
+			Name:        "My project",
+			Vertical:    projects.VerticalConsumer,
+			ProjectSlug: &slug,
+		}}, fake.Projects.CreateCalls())
+	})
+
+	t.Run("delete asks for confirmation", func(t *testing.T) {
+		// Arrange
+		fake := apifake.New()
+		c, _ := testCLI(t, fake, "n\n")
+
+		// Act
+		res := run(c, "projects", "delete", "my-project")
+
+		// Assert
+		assert.Equal(t, 1, res.code)
+		assert.Contains(t, res.stderr, "Delete project my-project and all of its environments? [y/N]")
+		assert.Contains(t, res.stderr, "aborted")
+		assert.Empty(t, fake.Projects.DeleteCalls())
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		// Arrange
+		fake := apifake.New()
+		c, _ := testCLI(t, fake, "y\n")
+
+		// Act
+		res := run(c, "projects", "delete", "--project", "my-project")
+
+		// Assert
+		require.Equal(t, 0, res.code, res.stderr)
+		assert.Equal(t, "deleted project my-project\n", res.stdout)
+		assert.Equal(t, []projects.DeleteRequest{{ProjectSlug: "my-project"}}, fake.Projects.DeleteCalls())
+	})
+}
+
+func TestEnvironmentCommands(t *testing.T) {
+	t.Run("metrics", func(t *testing.T) {
+		// Arrange
+		fake := apifake.New()
+		fake.Environments.GetMetricsReturns(&environments.GetMetricsResponse{
+			Metrics: environments.Metrics{UserCount: 12, M2MClientCount: 3},
+		}, nil)
+		c, _ := testCLI(t, fake, "")
+
+		// Act
+		res := run(c, "--project", "my-project", "envs", "metrics", "production", "-o", "json")
+
+		// Assert
+		require.Equal(t, 0, res.code, res.stderr)
+		assert.JSONEq(t, `{"user_count": 12, "m2m_client_count": 3}`, res.stdout)
+		assert.Equal(t, []environments.GetMetricsRequest{{
+			ProjectSlug:     "my-project",
+			EnvironmentSlug: "production",
+		}}, fake.Environments.GetMetricsCalls())
+	})
+
+	t.Run("update from a file", func(t *testing.T) {
+		// Arrange
+		fake := apifake.New()
+		c, _ := testCLI(t, fake, "user_lock_threshold: 5\n")
+
+		// Act
+		res := run(c, "envs", "update", "-f", "-", "--name", "Production", "--project", "my-project", "--env", "production")
+
+		// Assert
+		require.Equal(t, 0, res.code, res.stderr)
+		calls := fake.Environments.UpdateCalls()
+		require.Len(t, calls, 1)
+		assert.Equal(t, "production", calls[0].EnvironmentSlug)
+		assert.Equal(t, "Production", *calls[0].Name)
+		assert.Equal(t, 5, *calls[0].UserLockThreshold)
+	})
+
+	t.Run("unknown fields", func(t *testing.T) {
+		// Arrange
+		c, _ := testCLI(t, apifake.New(), "user_lock_treshold: 5\n")
+
+		// Act
+		res := run(c, "envs", "update", "-f", "-", "--project", "my-project", "--env", "production")
+
+		// Assert
+		assert.Equal(t, 1, res.code)
+		assert.Contains(t, res.stderr, `unknown field "user_lock_treshold"`)
+	})
+}
+
+func TestProfiles(t *testing.T) {
+	config := `
+current_profile: staging
+profiles:
+  staging:
+    workspace_key_id: key-staging
+    workspace_key_secret: secret-staging
+    project: my-project
+    environment: test
+  production:
+    access_token: token
+    project: my-project
+    environment: production
+`
+
+	t.Run("current profile", func(t *testing.T) {
+		// Arrange
+		fake := apifake.New()
+		c, used := testCLI(t, fake, "")
+		c.configPath = writeFile(t, "config.yaml", config)
+
+		// Act
+		res := run(c, "secrets", "list")
+
+		// Assert
+		require.Equal(t, 0, res.code, res.stderr)
+		assert.Equal(t, []profile{{
+			WorkspaceKeyID:     "key-staging",
+			WorkspaceKeySecret: "secret-staging",
+			Project:            "my-project",
+			Environment:        "test",
+		}}, *used)
+		assert.Equal(t, []secrets.GetAllRequest{{ProjectSlug: "my-project", EnvironmentSlug: "test"}},
+			fake.Secrets.GetAllCalls())
+	})
+
+	t.Run("flags override the profile", func(t *testing.T) {
+		// Arrange
+		fake := apifake.New()
+		c, used := testCLI(t, fake, "")
+		c.configPath = writeFile(t, "config.yaml", config)
+
+		// Act
+		res := run(c, "secrets", "list", "--profile", "production", "--env", "staging")
+
+		// Assert
+		require.Equal(t, 0, res.code, res.stderr)
+		require.Len(t, *used, 1)
+		assert.Equal(t, "token", (*used)[0].AccessToken)
+		assert.Equal(t, []secrets.GetAllRequest{{ProjectSlug: "my-project", EnvironmentSlug: "staging"}},
+			fake.Secrets.GetAllCalls())
+	})
+
+	t.Run("environment variables", func(t *testing.T) {
+		// Arrange
+		c, used := testCLI(t, apifake.New(), "")
+		c.configPath = writeFile(t, "config.yaml", config)
+		env := map[string]string{
+			"STYTCH_WORKSPACE_KEY_ID":     "key-env",
+			"STYTCH_WORKSPACE_KEY_SECRET": "secret-env",
+		}
+		c.getenv = func(name string) string { return env[name] }
+
+		// Act
+		res := run(c, "projects", "list")
+
+		// Assert
+		require.Equal(t, 0, res.code, res.stderr)
+		assert.Equal(t, []profile{{WorkspaceKeyID: "key-env", WorkspaceKeySecret: "secret-env"}}, *used)
+	})
+
+	t.Run("unknown profile", func(t *testing.T) {
+		// Arrange
+		c, _ := testCLI(t, apifake.New(), "")
+		c.configPath = writeFile(t, "config.yaml", config)
+
+		// Act
+		res := run(c, "--profile", "qa", "projects", "list")
+
+		// Assert
+		assert.Equal(t, 1, res.code)
+		assert.Contains(t, res.stderr, `profile "qa" not found`)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		// Arrange
+		c, _ := testCLI(t, apifake.New(), "")
+		c.configPath = writeFile(t, "config.yaml", config)
+
+		// Act
+		res := run(c, "profiles", "list")
+
+		// Assert
+		require.Equal(t, 0, res.code, res.stderr)
+		assert.Equal(t, ""+
+			"CURRENT  NAME        PROJECT     ENVIRONMENT\n"+
+			"         production  my-project  production\n"+
+			"*        staging     my-project  test\n", res.stdout)
+	})
+}
+
+func TestRedirectURLs(t *testing.T) {
+	// Arrange
+	fake := apifake.New()
+	c, _ := testCLI(t, fake, "")
+
+	// Act
+	res := run(c, "redirect-urls", "create", "https://example.com/callback",
+		"--type", "LOGIN,SIGNUP", "--default", "LOGIN", "--project", "my-project", "--env", "test")
+
+	// Assert
+	require.Equal(t, 0, res.code, res.stderr)
+	assert.Equal(t, []redirecturls.CreateRequest{{
+		ProjectSlug:     "my-project",
+		EnvironmentSlug: "test",
+		URL:             "https://example.com/callback",
+		ValidTypes: []redirecturls.URLType{
+			{Type: redirecturls.RedirectURLTypeLogin, IsDefault: true},
+			{Type: redirecturls.RedirectURLTypeSignup},
+		},
+	}}, fake.RedirectURLs.CreateCalls())
+
+	t.Run("unknown types", func(t *testing.T) {
+		// Act
+		res := run(c, "redirect-urls", "create", "https://example.com/callback", "--type", "LOGN")
+
+		// Assert
+		assert.Equal(t, 2, res.code)
+		assert.Contains(t, res.stderr, `unknown redirect URL type "LOGN"`)
+	})
+}
+
+func TestRBACSet(t *testing.T) {
+	// Arrange
+	fake := apifake.New()
+	c, _ := testCLI(t, fake, "")
+	path := writeFile(t, "policy.yaml", `
+stytch_resources:
+  - resource_id: stytch.member
+custom_roles:
+  - role_id: editor
+    description: Edits things
+`)
+
+	// Act
+	res := run(c, "rbac", "set", "-f", path, "--project", "my-project", "--env", "test")
+
+	// Assert
+	require.Equal(t, 0, res.code, res.stderr)
+	assert.Equal(t, []rbacpolicy.SetRequest{{
+		ProjectSlug:     "my-project",
+		EnvironmentSlug: "test",
+		CustomRoles:     []rbacpolicy.Role{{RoleID: "editor", Description: "Edits things"}},
+	}}, fake.RBACPolicy.SetCalls())
+}
+
+func TestSDK(t *testing.T) {
+	// Arrange
+	fake := apifake.New()
+	fake.Projects.GetReturns(&projects.GetResponse{Project: projects.Project{
+		ProjectSlug: "my-project",
+		Vertical:    projects.VerticalConsumer,
+	}}, nil)
+	c, _ := testCLI(t, fake, "basic: {enabled: true}\n")
+
+	// Act
+	res := run(c, "sdk", "set", "-f", "-", "--project", "my-project", "--env", "test")
+
+	// Assert
+	require.Equal(t, 0, res.code, res.stderr)
+	calls := fake.SDK.SetConsumerConfigCalls()
+	require.Len(t, calls, 1)
+	assert.True(t, calls[0].Config.Basic.Enabled)
+	assert.Empty(t, fake.SDK.SetB2BConfigCalls())
+}
+
+func TestEmailTemplatesSetDefault(t *testing.T) {
+	// Arrange
+	fake := apifake.New()
+	c, _ := testCLI(t, fake, "")
+
+	// Act
+	res := run(c, "email-templates", "set-default", "LOGIN", "welcome", "--project", "my-project")
+
+	// Assert
+	require.Equal(t, 0, res.code, res.stderr)
+	assert.Equal(t, []emailtemplates.SetDefaultRequest{{
+		ProjectSlug:       "my-project",
+		EmailTemplateType: emailtemplates.TemplateTypeLogin,
+		TemplateID:        "welcome",
+	}}, fake.EmailTemplates.SetDefaultCalls())
+}
+
+func TestEventLogStreamingEnable(t *testing.T) {
+	// Arrange
+	fake := apifake.New()
+	c, _ := testCLI(t, fake, "")
+
+	// Act
+	res := run(c, "event-log-streaming", "enable", "DATADOG", "--project", "my-project", "--env", "test")
+
+	// Assert
+	require.Equal(t, 0, res.code, res.stderr)
+	assert.Equal(t, "enabled event log streaming to DATADOG\n", res.stdout)
+	assert.Equal(t, []eventlogstreaming.EnableRequest{{
+		ProjectSlug:     "my-project",
+		EnvironmentSlug: "test",
+		DestinationType: eventlogstreaming.DestinationTypeDatadog,
+	}}, fake.EventLogStreaming.EnableCalls())
+}
+
+func TestPEMFilesAdd(t *testing.T) {
+	// Arrange
+	fake := apifake.New()
+	c, _ := testCLI(t, fake, "")
+	path := writeFile(t, "key.pem", "-----BEGIN PUBLIC KEY-----\nMIIB\n-----END PUBLIC KEY-----\n")
+
+	// Act
+	res := run(c, "trusted-token-profiles", "pem", "add", "profile-1", path, "--project", "my-project", "--env", "test")
+
+	// Assert
+	require.Equal(t, 0, res.code, res.stderr)
+	assert.Equal(t, []trustedtokenprofiles.CreatePEMFileRequest{{
+		ProjectSlug:     "my-project",
+		EnvironmentSlug: "test",
+		ProfileID:       "profile-1",
+		PublicKey:       "-----BEGIN PUBLIC KEY-----\nMIIB\n-----END PUBLIC KEY-----\n",
+	}}, fake.TrustedTokenProfiles.CreatePEMFileCalls())
+}
+
+func TestOutput(t *testing.T) {
+	envs := &environments.GetAllResponse{Environments: []environments.Environment{
+		{
+			EnvironmentSlug: "production",
+			Name:            "Production",
+			Type:            environments.EnvironmentTypeLive,
+			OAuthCallbackID: "oauth-1",
+			CreatedAt:       time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			EnvironmentSlug: "test",
+			Name:            "Test",
+			Type:            environments.EnvironmentTypeTest,
+		},
+	}}
+	for name, tc := range map[string]struct {
+		args   []string
+		stdout string
+	}{
+		"table": {
+			args: nil,
+			stdout: "" +
+				"SLUG         TYPE   CREATED\n" +
+				"production   LIVE   2026-10-19T12:00:00Z\n" +
+				"test         TEST   -\n",
+		},
+		"wide": {
+			args: []string{"-o", "wide"},
+			stdout: "" +
+				"SLUG         TYPE   CREATED                NAME         OAUTH CALLBACK ID   USER LOCK THRESHOLD\n" +
+				"production   LIVE   2026-10-19T12:00:00Z   Production   oauth-1             0\n" +
+				"test         TEST   -                      Test         -                   0\n",
+		},
+		"no headers": {
+			args: []string{"--no-headers"},
+			stdout: "" +
+				"production   LIVE   2026-10-19T12:00:00Z\n" +
+				"test         TEST   -\n",
+		},
+		"yaml": {
+			args: []string{"-o", "yaml"},
+			stdout: "" +
+				"- created_at: \"2026-10-19T12:00:00Z\"\n" +
+				"  environment_slug: production\n" +
+				"  name: Production\n" +
+				"  oauth_callback_id: oauth-1\n" +
+				"  type: LIVE\n" +
+				"- created_at: \"0001-01-01T00:00:00Z\"\n" +
+				"  environment_slug: test\n" +
+				"  name: Test\n" +
+				"  type: TEST\n",
+		},
+		"jsonpath": {
+			args:   []string{"--output", `jsonpath={range .[*]}{.environment_slug}={.type}{"\n"}{end}`},
+			stdout: "production=LIVE\ntest=TEST\n",
+		},
+		"go-template": {
+			args:   []string{"-o", `go-template={{range .}}{{.environment_slug}} {{json .name}}{{"\n"}}{{end}}`},
+			stdout: "production \"Production\"\ntest \"Test\"\n",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			fake := apifake.New()
+			fake.Environments.GetAllReturns(envs, nil)
+			c, _ := testCLI(t, fake, "")
+
+			// Act
+			res := run(c, append([]string{"envs", "list", "--project", "my-project"}, tc.args...)...)
+
+			// Assert
+			require.Equal(t, 0, res.code, res.stderr)
+			assert.Equal(t, tc.stdout, res.stdout)
+		})
+	}
+
+	t.Run("redirect URL defaults", func(t *testing.T) {
+		// Arrange
+		fake := apifake.New()
+		fake.RedirectURLs.GetAllReturns(&redirecturls.GetAllResponse{RedirectURLs: []redirecturls.RedirectURL{{
+			URL: "https://example.com/authenticate",
+			ValidTypes: []redirecturls.URLType{
+				{Type: redirecturls.RedirectURLTypeLogin, IsDefault: true},
+				{Type: redirecturls.RedirectURLTypeSignup},
+			},
+		}}}, nil)
+		c, _ := testCLI(t, fake, "")
+
+		// Act
+		res := run(c, "redirect-urls", "list", "--project", "my-project", "--env", "test")
+
+		// Assert
+		require.Equal(t, 0, res.code, res.stderr)
+		assert.Equal(t, ""+
+			"URL                                TYPES\n"+
+			"https://example.com/authenticate   LOGIN*,SIGNUP\n", res.stdout)
+	})
+
+	t.Run("masked secrets", func(t *testing.T) {
+		// Arrange
+		fake := apifake.New()
+		fake.Secrets.GetAllReturns(&secrets.GetAllResponse{Secrets: []secrets.MaskedSecret{{
+			SecretID: "secret-test-1",
+			LastFour: "abcd",
+			UsedAt:   time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC),
+		}}}, nil)
+		c, _ := testCLI(t, fake, "")
+
+		// Act
+		res := run(c, "secrets", "list", "--project", "my-project", "--env", "test")
+
+		// Assert
+		require.Equal(t, 0, res.code, res.stderr)
+		assert.Equal(t, ""+
+			"ID              LAST FOUR   USED AT\n"+
+			"secret-test-1   abcd        2026-10-18T09:30:00Z\n", res.stdout)
+	})
+
+	t.Run("messages go to stderr with structured output", func(t *testing.T) {
+		// Arrange
+		fake := apifake.New()
+		c, _ := testCLI(t, fake, "")
+
+		// Act
+		res := run(c, "projects", "delete", "my-project", "--yes", "-o", "json")
+
+		// Assert
+		require.Equal(t, 0, res.code, res.stderr)
+		assert.Empty(t, res.stdout)
+		assert.Equal(t, "deleted project my-project\n", res.stderr)
+	})
+}
+
+// liveProject returns a fake with a project that has a single test environment and no resources.
+func liveProject() *apifake.API {
+	fake := apifake.New()
+	fake.Projects.GetReturns(&projects.GetResponse{Project: projects.Project{
+		ProjectSlug: "my-project",
+		Name:        "My project",
+		Vertical:    projects.VerticalConsumer,
+	}}, nil)
+	fake.Environments.GetAllReturns(&environments.GetAllResponse{Environments: []environments.Environment{{
+		EnvironmentSlug: "test",
+		Name:            "Test",
+		Type:            environments.EnvironmentTypeTest,
+	}}}, nil)
+	return fake
+}
+
+// desiredConfig returns the exported configuration of liveProject, with a redirect URL added if
+// withURL is set.
+func desiredConfig(t *testing.T, withURL bool) string {
+	t.Helper()
+	c, _ := testCLI(t, liveProject(), "")
+	res := run(c, "export", "--project", "my-project")
+	require.Equal(t, 0, res.code, res.stderr)
+	if !withURL {
+		return writeFile(t, "config.yaml", res.stdout)
+	}
+	snap, err := snapshot.Parse([]byte(res.stdout))
+	require.NoError(t, err)
+	snap.Environments[0].RedirectURLs = []redirecturls.RedirectURL{{
+		URL:        "https://example.com/authenticate",
+		ValidTypes: []redirecturls.URLType{{Type: redirecturls.RedirectURLTypeLogin, IsDefault: true}},
+	}}
+	config, err := snap.YAML()
+	require.NoError(t, err)
+	return writeFile(t, "config.yaml", string(config))
+}
+
+func TestExport(t *testing.T) {
+	// Arrange
+	fake := liveProject()
+	c, _ := testCLI(t, fake, "")
+
+	// Act
+	res := run(c, "export", "--project", "my-project")
+
+	// Assert
+	require.Equal(t, 0, res.code, res.stderr)
+	assert.Contains(t, res.stdout, "version: 1\n")
+	assert.Contains(t, res.stdout, "project_slug: my-project\n")
+	assert.Contains(t, res.stdout, "environment_slug: test\n")
+}
+
+func TestDiff(t *testing.T) {
+	t.Run("no changes", func(t *testing.T) {
+		// Arrange
+		config := desiredConfig(t, false)
+		c, _ := testCLI(t, liveProject(), "")
+
+		// Act
+		res := run(c, "diff", "-f", config)
+
+		// Assert
+		assert.Equal(t, exitOK, res.code, res.stderr)
+		assert.Equal(t, "No changes. The project matches the desired state.\n", res.stdout)
+	})
+
+	t.Run("changes", func(t *testing.T) {
+		// Arrange
+		config := desiredConfig(t, true)
+		fake := liveProject()
+		c, _ := testCLI(t, fake, "")
+
+		// Act
+		res := run(c, "diff", "-f", config)
+
+		// Assert
+		assert.Equal(t, exitChanges, res.code, res.stderr)
+		assert.Contains(t, res.stdout, "+ environment[test].redirect_url[https://example.com/authenticate]")
+		assert.Contains(t, res.stdout, "Plan: 1 to create, 0 to update, 0 to delete.")
+		assert.Empty(t, fake.RedirectURLs.CreateCalls())
+	})
+
+	t.Run("json", func(t *testing.T) {
+		// Arrange
+		config := desiredConfig(t, true)
+		c, _ := testCLI(t, liveProject(), "")
+
+		// Act
+		res := run(c, "diff", "-f", config, "-o", "jsonpath={.summary.create}")
+
+		// Assert
+		assert.Equal(t, exitChanges, res.code, res.stderr)
+		assert.Equal(t, "1", res.stdout)
+	})
+
+	t.Run("usage errors are errors", func(t *testing.T) {
+		// Arrange
+		c, _ := testCLI(t, liveProject(), "")
+
+		// Act
+		res := run(c, "diff")
+
+		// Assert
+		assert.Equal(t, exitError, res.code)
+		assert.Contains(t, res.stderr, "missing -f")
+	})
+}
+
+func TestApply(t *testing.T) {
+	t.Run("applies after confirmation", func(t *testing.T) {
+		// Arrange
+		config := desiredConfig(t, true)
+		fake := liveProject()
+		c, _ := testCLI(t, fake, "y\n")
+
+		// Act
+		res := run(c, "apply", "-f", config)
+
+		// Assert
+		assert.Equal(t, exitChanges, res.code, res.stderr)
+		assert.Contains(t, res.stderr, "Apply 1 changes to project my-project? [y/N]")
+		assert.Contains(t, res.stdout, "applied create environment[test].redirect_url[https://example.com/authenticate]\n")
+		assert.Contains(t, res.stdout, "Applied 1 changes.\n")
+		require.Len(t, fake.RedirectURLs.CreateCalls(), 1)
+		assert.Equal(t, "https://example.com/authenticate", fake.RedirectURLs.CreateCalls()[0].URL)
+	})
+
+	t.Run("aborted", func(t *testing.T) {
+		// Arrange
+		config := desiredConfig(t, true)
+		fake := liveProject()
+		c, _ := testCLI(t, fake, "n\n")
+
+		// Act
+		res := run(c, "apply", "-f", config)
+
+		// Assert
+		assert.Equal(t, exitError, res.code)
+		assert.Empty(t, fake.RedirectURLs.CreateCalls())
+	})
+
+	t.Run("no changes", func(t *testing.T) {
+		// Arrange
+		config := desiredConfig(t, false)
+		fake := liveProject()
+		c, _ := testCLI(t, fake, "")
+
+		// Act
+		res := run(c, "apply", "-f", config, "--auto-approve")
+
+		// Assert
+		assert.Equal(t, exitOK, res.code, res.stderr)
+		assert.Empty(t, fake.RedirectURLs.CreateCalls())
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		// Arrange
+		config := desiredConfig(t, true)
+		fake := liveProject()
+		fake.RedirectURLs.CreateReturns(nil, errors.New("boom"))
+		c, _ := testCLI(t, fake, "")
+
+		// Act
+		res := run(c, "apply", "-f", config, "--auto-approve")
+
+		// Assert
+		assert.Equal(t, exitError, res.code)
+		assert.Contains(t, res.stdout, "failed create environment[test].redirect_url[https://example.com/authenticate]: boom\n")
+		assert.Contains(t, res.stderr, "boom")
+	})
+}
+
+// browseProject returns liveProject with an environment that has metrics, settings, redirect URLs,
+// an RBAC policy and an SDK configuration.
+func browseProject() *apifake.API {
+	fake := liveProject()
+	fake.Projects.GetAllReturns(&projects.GetAllResponse{Projects: []projects.Project{{
+		ProjectSlug: "my-project",
+		Name:        "My project",
+		Vertical:    projects.VerticalConsumer,
+	}}}, nil)
+	fake.Environments.GetReturns(&environments.GetResponse{Environment: environments.Environment{
+		EnvironmentSlug:   "test",
+		Name:              "Test",
+		Type:              environments.EnvironmentTypeTest,
+		UserLockThreshold: 10,
+	}}, nil)
+	fake.Environments.GetMetricsReturns(&environments.GetMetricsResponse{
+		Metrics: environments.Metrics{UserCount: 12, M2MClientCount: 3},
+	}, nil)
+	fake.RedirectURLs.GetAllReturns(&redirecturls.GetAllResponse{RedirectURLs: []redirecturls.RedirectURL{{
+		URL: "https://example.com/authenticate",
+		ValidTypes: []redirecturls.URLType{
+			{Type: redirecturls.RedirectURLTypeLogin, IsDefault: true},
+			{Type: redirecturls.RedirectURLTypeSignup},
+		},
+	}}}, nil)
+	fake.RBACPolicy.GetReturns(&rbacpolicy.GetResponse{Policy: rbacpolicy.Policy{
+		StytchUser: &rbacpolicy.DefaultRole{},
+		CustomRoles: []rbacpolicy.Role{{
+			RoleID:      "editor",
+			Permissions: []rbacpolicy.Permission{{ResourceID: "documents", Actions: []string{"read", "write"}}},
+		}},
+		CustomResources: []rbacpolicy.Resource{{ResourceID: "documents", AvailableActions: []string{"read", "write", "delete"}}},
+	}}, nil)
+	fake.SDK.GetConsumerConfigReturns(&sdk.GetConsumerConfigResponse{Config: sdk.ConsumerConfig{
+		Basic: &sdk.ConsumerBasicConfig{Enabled: true, Domains: []string{"https://example.com"}},
+	}}, nil)
+	return fake
+}
+
+func TestBrowse(t *testing.T) {
+	t.Run("navigates to an environment", func(t *testing.T) {
+		// Arrange
+		fake := browseProject()
+		c, _ := testCLI(t, fake, "1\n1\nq\n")
+
+		// Act
+		res := run(c, "browse")
+
+		// Assert
+		require.Equal(t, 0, res.code, res.stderr)
+		assert.Contains(t, res.stdout, "projects > my-project > test\n")
+		assert.Contains(t, res.stdout, "  users: 12\n  organizations: 0\n  members: 0\n  m2m clients: 3\n")
+		assert.Contains(t, res.stdout, "  user_lock_threshold: 10\n")
+		assert.Contains(t, res.stdout, "  user_lock_ttl: 0\n")
+		assert.Contains(t, res.stdout, "  3)   SDK configuration\n")
+		assert.NotContains(t, res.stdout, "\x1b[")
+	})
+
+	t.Run("redirect URLs", func(t *testing.T) {
+		// Arrange
+		c, _ := testCLI(t, browseProject(), "1\n")
+
+		// Act
+		res := run(c, "browse", "--project", "my-project", "--env", "test")
+
+		// Assert
+		require.Equal(t, 0, res.code, res.stderr)
+		assert.Contains(t, res.stdout, ""+
+			"  URL                                TYPES\n"+
+			"  https://example.com/authenticate   LOGIN*,SIGNUP\n")
+		assert.Contains(t, res.stdout, "defaults\n  LOGIN            https://example.com/authenticate\n")
+		assert.Contains(t, res.stdout, "  SIGNUP           -\n")
+	})
+
+	t.Run("RBAC matrix", func(t *testing.T) {
+		// Arrange
+		c, _ := testCLI(t, browseProject(), "2\n")
+
+		// Act
+		res := run(c, "browse", "--project", "my-project", "--env", "test")
+
+		// Assert
+		require.Equal(t, 0, res.code, res.stderr)
+		assert.Contains(t, res.stdout, ""+
+			"documents\n"+
+			"  ROLE         read  write  delete\n"+
+			"  stytch_user  -     -      -\n"+
+			"  editor       x     x      -\n")
+	})
+
+	t.Run("edits an environment setting", func(t *testing.T) {
+		// Arrange
+		fake := browseProject()
+		c, _ := testCLI(t, fake, "set user_lock_threshold 5\ny\nset name 10\nn\nset bogus 1\n")
+
+		// Act
+		res := run(c, "browse", "--project", "my-project", "--env", "test")
+
+		// Assert
+		require.Equal(t, 0, res.code, res.stderr)
+		assert.Contains(t, res.stdout, "user_lock_threshold: 10 -> 5\nSave? [y/N] saved user_lock_threshold\n")
+		assert.Contains(t, res.stdout, `name: "Test" -> "10"`+"\nSave? [y/N] not saved\n")
+		assert.Contains(t, res.stdout, `unknown field "bogus"`)
+		threshold := 5
+		assert.Equal(t, []environments.UpdateRequest{{
+			ProjectSlug:       "my-project",
+			EnvironmentSlug:   "test",
+			UserLockThreshold: &threshold,
+		}}, fake.Environments.UpdateCalls())
+	})
+
+	t.Run("edits the SDK configuration", func(t *testing.T) {
+		// Arrange
+		fake := browseProject()
+		c, _ := testCLI(t, fake, "3\nset basic.domains[1] https://app.example.com\ny\nset basic.enabled maybe\n")
+
+		// Act
+		res := run(c, "browse", "--project", "my-project", "--env", "test")
+
+		// Assert
+		require.Equal(t, 0, res.code, res.stderr)
+		assert.Contains(t, res.stdout, ""+
+			"  basic\n"+
+			"    domains\n"+
+			"      [0]: \"https://example.com\"\n"+
+			"    enabled: true\n")
+		assert.Contains(t, res.stdout, `basic.domains[1]: (none) -> "https://app.example.com"`)
+		assert.Contains(t, res.stdout, "basic.enabled: json: cannot unmarshal string")
+		require.Len(t, fake.SDK.SetConsumerConfigCalls(), 1)
+		assert.Equal(t, &sdk.ConsumerConfig{Basic: &sdk.ConsumerBasicConfig{
+			Enabled: true,
+			Domains: []string{"https://example.com", "https://app.example.com"},
+		}}, fake.SDK.SetConsumerConfigCalls()[0].Config)
+	})
+}
+
+func TestDebugCurl(t *testing.T) {
+	// Arrange
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"projects":[{"project_slug":"my-project","name":"My project"}]}`))
+	}))
+	t.Cleanup(server.Close)
+	env := map[string]string{
+		keyIDEnvVar:     "workspace-key-test",
+		keySecretEnvVar: "workspace-secret-test",
+		baseURIEnvVar:   server.URL,
+	}
+	c, _ := testCLI(t, apifake.New(), "")
+	c.getenv = func(name string) string { return env[name] }
+	c.newClient = newClient
+
+	// Act
+	res := run(c, "projects", "list", "--debug-curl", "--no-headers")
+
+	// Assert
+	require.Equal(t, 0, res.code, res.stderr)
+	assert.Contains(t, res.stdout, "my-project   My project")
+	assert.Contains(t, res.stderr, "# Projects.GetAll\ncurl '"+server.URL+"/pwa/v3/projects' \\\n"+
+		`  -u "$STYTCH_WORKSPACE_KEY_ID:$STYTCH_WORKSPACE_KEY_SECRET" \`)
+	assert.Contains(t, res.stderr, "HTTP/1.1 200 OK\n")
+	assert.Contains(t, res.stderr, `{"projects":[{"project_slug":"my-project","name":"My project"}]}`)
+	assert.NotContains(t, res.stderr, "workspace-secret-test")
+}
+
+func TestDebugCurlCredentials(t *testing.T) {
+	// Arrange
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"secret":{"secret_id":"secret-test-1","secret":"secret-value-abcd"}}`))
+	}))
+	t.Cleanup(server.Close)
+	env := map[string]string{
+		keyIDEnvVar:     "workspace-key-test",
+		keySecretEnvVar: "workspace-secret-test",
+		baseURIEnvVar:   server.URL,
+	}
+	newCLI := func() *cli {
+		c, _ := testCLI(t, apifake.New(), "")
+		c.getenv = func(name string) string { return env[name] }
+		c.newClient = newClient
+		return c
+	}
+
+	// Act
+	masked := run(newCLI(), "secrets", "create", "--project", "p", "--env", "test", "--debug-curl")
+	shown := run(newCLI(), "secrets", "create", "--project", "p", "--env", "test", "--debug-curl", "--debug-curl-credentials")
+
+	// Assert
+	require.Equal(t, 0, masked.code, masked.stderr)
+	assert.NotContains(t, masked.stderr, "secret-value-abcd")
+	assert.Contains(t, masked.stderr, `"secret":"...abcd"`)
+	require.Equal(t, 0, shown.code, shown.stderr)
+	assert.Contains(t, shown.stderr, `"secret":"secret-value-abcd"`)
+}
+
+func TestUsageErrors(t *testing.T) {
+	for name, tc := range map[string]struct {
+		args   []string
+		code   int
+		stderr string
+	}{
+		"no command":         {args: nil, code: 2, stderr: "Usage: stytchctl <command> [flags]"},
+		"help":               {args: []string{"secrets", "-h"}, code: 0, stderr: "Usage: stytchctl secrets <command> [flags]"},
+		"unknown command":    {args: []string{"secrets", "lst"}, code: 2, stderr: `unknown command "secrets lst"`},
+		"missing arguments":  {args: []string{"secrets", "get"}, code: 2, stderr: "too few arguments"},
+		"extra arguments":    {args: []string{"projects", "list", "extra"}, code: 2, stderr: `unexpected argument "extra"`},
+		"missing project":    {args: []string{"envs", "list"}, code: 2, stderr: "no project: use --project"},
+		"missing env":        {args: []string{"secrets", "list", "--project", "p"}, code: 2, stderr: "no environment: use --env"},
+		"unknown flag":       {args: []string{"secrets", "list", "--bogus"}, code: 2, stderr: "flag provided but not defined: -bogus"},
+		"unknown enum value": {args: []string{"email-templates", "get-default", "LOG_IN", "--project", "p"}, code: 2, stderr: `unknown template type "LOG_IN"`},
+		"unknown output":     {args: []string{"projects", "list", "-o", "xml"}, code: 2, stderr: `unknown output format "xml"`},
+		"invalid jsonpath":   {args: []string{"projects", "list", "-o", "jsonpath={range .[*]}"}, code: 2, stderr: "invalid jsonpath: {range} without {end}"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			fake := apifake.New()
+			c, _ := testCLI(t, fake, "")
+
+			// Act
+			res := run(c, tc.args...)
+
+			// Assert
+			assert.Equal(t, tc.code, res.code)
+			assert.Contains(t, res.stderr, tc.stderr)
+			assert.Empty(t, fake.Calls())
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+
+	migrationprojects "github.com/stytchauth/stytch-management-go/v3/pkg/models/migration/projects"
+)
+
+var migrationCommand = &command{
+	name:    "migration",
+	summary: "Map the project IDs of the v1 Management API to project and environment slugs",
+	commands: []*command{
+		{name: "list", summary: "List the v1 and v3 identifiers of every project", run: migrationList},
+		{name: "get", args: "<v1-project-id>", summary: "Show the v3 identifiers of a v1 project ID", run: migrationGet},
+	},
+}
+
+func migrationList(ctx context.Context, c *cli, args []string) error {
+	if _, err := c.parse(c.flags(), args, 0, 0); err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	resp, err := client.V1ToV3MigrationAPI().GetProjects(ctx, migrationprojects.GetProjectsRequest{})
+	if err != nil {
+		return err
+	}
+	return c.print(resp.Projects)
+}
+
+func migrationGet(ctx context.Context, c *cli, args []string) error {
+	args, err := c.parse(c.flags(), args, 1, 1)
+	if err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	resp, err := client.V1ToV3MigrationAPI().GetProject(ctx, migrationprojects.GetProjectRequest{ProjectID: args[0]})
+	if err != nil {
+		return err
+	}
+	return c.print(resp.Project)
+}
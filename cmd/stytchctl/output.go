@@ -0,0 +1,189 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/template"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Output formats, selected with -o. The jsonpath and go-template formats take their expression
+// after an equals sign, as in -o jsonpath='{.environment_slug}'.
+const (
+	formatTable      = "table"
+	formatWide       = "wide"
+	formatJSON       = "json"
+	formatYAML       = "yaml"
+	formatJSONPath   = "jsonpath"
+	formatGoTemplate = "go-template"
+)
+
+// outputFormat is a parsed -o flag.
+type outputFormat struct {
+	name     string
+	jsonPath *jsonPath
+	template *template.Template
+}
+
+// parseOutput parses the value of the -o flag.
+func parseOutput(s string) (*outputFormat, error) {
+	name, expr, hasExpr := strings.Cut(s, "=")
+	f := &outputFormat{name: name}
+	switch name {
+	case formatTable, formatWide, formatJSON, formatYAML:
+		if hasExpr {
+			return nil, usagef("output format %s does not take an expression", name)
+		}
+	case formatJSONPath:
+		p, err := parseJSONPath(expr)
+		if err != nil {
+			return nil, usagef("invalid jsonpath: %v", err)
+		}
+		f.jsonPath = p
+	case formatGoTemplate:
+		t, err := template.New("output").Funcs(template.FuncMap{"json": toJSON}).Parse(expr)
+		if err != nil {
+			return nil, usagef("invalid go-template: %v", err)
+		}
+		f.template = t
+	default:
+		return nil, usagef("unknown output format %q: must be one of %s, %s, %s, %s, %s=<expression> or %s=<template>",
+			s, formatTable, formatWide, formatJSON, formatYAML, formatJSONPath, formatGoTemplate)
+	}
+	if (name == formatJSONPath || name == formatGoTemplate) && expr == "" {
+		return nil, usagef("output format %s needs an expression: -o %s=...", name, name)
+	}
+	return f, nil
+}
+
+// print writes a value to stdout in the selected output format. Every format but table and wide
+// works on the JSON encoding of the value, so field names are the JSON names of the model types
+// and object keys are sorted.
+func (c *cli) print(v any) error {
+	f := c.format
+	if f == nil {
+		var err error
+		if f, err = parseOutput(c.output); err != nil {
+			return err
+		}
+	}
+	switch f.name {
+	case formatTable, formatWide:
+		return c.printTable(v, f.name == formatWide)
+	}
+	doc, err := document(v)
+	if err != nil {
+		return err
+	}
+	switch f.name {
+	case formatJSON:
+		enc := json.NewEncoder(c.stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(doc)
+	case formatYAML:
+		enc := yaml.NewEncoder(c.stdout)
+		enc.SetIndent(2)
+		if err := enc.Encode(doc); err != nil {
+			return err
+		}
+		return enc.Close()
+	case formatJSONPath:
+		return f.jsonPath.execute(c.stdout, doc)
+	default:
+		return f.template.Execute(c.stdout, doc)
+	}
+}
+
+// structured reports whether the output is meant for programs rather than people.
+func (c *cli) structured() bool {
+	return c.format != nil && c.format.name != formatTable && c.format.name != formatWide
+}
+
+// document returns the JSON encoding of v as generic maps, slices and scalars.
+func document(v any) (any, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var doc any
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+	return numbers(doc), nil
+}
+
+// numbers replaces json.Number values with int64 or float64, which the YAML encoder and templates
+// treat as numbers rather than strings.
+func numbers(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			v[k] = numbers(e)
+		}
+	case []any:
+		for i, e := range v {
+			v[i] = numbers(e)
+		}
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	}
+	return v
+}
+
+// toJSON is the json function of go-template output.
+func toJSON(v any) (string, error) {
+	b, err := json.Marshal(v)
+	return string(b), err
+}
+
+// readInput decodes a YAML or JSON file, or stdin if path is "-", into v. Fields that v does not
+// have are an error, so that misspelled settings are not silently ignored.
+func (c *cli) readInput(path string, v any) error {
+	if path == "" {
+		return usagef("missing -f")
+	}
+	var (
+		data []byte
+		err  error
+	)
+	if path == "-" {
+		data, err = io.ReadAll(c.stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return err
+	}
+	// YAML is a superset of JSON. Decode it generically and then into v, so that v is decoded
+	// with its JSON field names.
+	var doc any
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	if err := decodeDocument(doc, v); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+// decodeDocument decodes a generic document into v. Fields that v does not have are an error.
+func decodeDocument(doc, v any) error {
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	return dec.Decode(v)
+}
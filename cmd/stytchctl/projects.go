@@ -0,0 +1,136 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
+)
+
+var projectsCommand = &command{
+	name:    "projects",
+	summary: "Manage projects",
+	commands: []*command{
+		{name: "list", summary: "List the projects of the workspace", run: projectsList},
+		{name: "get", args: "[<project-slug>]", summary: "Show a project", run: projectsGet},
+		{name: "create", args: "<name>", summary: "Create a project", run: projectsCreate},
+		{name: "update", args: "[<project-slug>]", summary: "Update a project", run: projectsUpdate},
+		{name: "delete", args: "[<project-slug>]", summary: "Delete a project and all of its environments", run: projectsDelete},
+	},
+}
+
+// projectArg returns the project slug given as an argument or with --project.
+func (c *cli) projectArg(args []string) (string, error) {
+	if len(args) > 0 {
+		return args[0], nil
+	}
+	return c.projectSlug()
+}
+
+func projectsList(ctx context.Context, c *cli, args []string) error {
+	if _, err := c.parse(c.flags(), args, 0, 0); err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	resp, err := client.ProjectsAPI().GetAll(ctx, projects.GetAllRequest{})
+	if err != nil {
+		return err
+	}
+	return c.print(resp.Projects)
+}
+
+func projectsGet(ctx context.Context, c *cli, args []string) error {
+	args, err := c.parse(c.flags(), args, 0, 1)
+	if err != nil {
+		return err
+	}
+	projectSlug, err := c.projectArg(args)
+	if err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	resp, err := client.ProjectsAPI().Get(ctx, projects.GetRequest{ProjectSlug: projectSlug})
+	if err != nil {
+		return err
+	}
+	return c.print(resp.Project)
+}
+
+func projectsCreate(ctx context.Context, c *cli, args []string) error {
+	fs := c.flags()
+	vertical := fs.String("vertical", string(projects.VerticalB2B), fmt.Sprintf("vertical of the project: one of %v", projects.Verticals()))
+	slug := fs.String("slug", "", "slug of the project (default generated from the name)")
+	args, err := c.parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	req := projects.CreateRequest{Name: args[0], Vertical: projects.Vertical(*vertical)}
+	if *slug != "" {
+		req.ProjectSlug = slug
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	resp, err := client.ProjectsAPI().Create(ctx, req)
+	if err != nil {
+		return err
+	}
+	return c.print(resp.Project)
+}
+
+func projectsUpdate(ctx context.Context, c *cli, args []string) error {
+	fs := c.flags()
+	name := fs.String("name", "", "new name of the project")
+	args, err := c.parse(fs, args, 0, 1)
+	if err != nil {
+		return err
+	}
+	projectSlug, err := c.projectArg(args)
+	if err != nil {
+		return err
+	}
+	req := projects.UpdateRequest{ProjectSlug: projectSlug}
+	if *name != "" {
+		req.Name = name
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	resp, err := client.ProjectsAPI().Update(ctx, req)
+	if err != nil {
+		return err
+	}
+	return c.print(resp.Project)
+}
+
+func projectsDelete(ctx context.Context, c *cli, args []string) error {
+	fs := c.flags()
+	yes := fs.Bool("yes", false, "do not ask for confirmation")
+	args, err := c.parse(fs, args, 0, 1)
+	if err != nil {
+		return err
+	}
+	projectSlug, err := c.projectArg(args)
+	if err != nil {
+		return err
+	}
+	if err := c.confirm(*yes, "Delete project %s and all of its environments?", projectSlug); err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	if _, err := client.ProjectsAPI().Delete(ctx, projects.DeleteRequest{ProjectSlug: projectSlug}); err != nil {
+		return err
+	}
+	return c.done("deleted project %s", projectSlug)
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/rbacpolicy"
+)
+
+var rbacCommand = &command{
+	name:    "rbac",
+	summary: "Manage the RBAC policy of an environment",
+	commands: []*command{
+		{name: "get", summary: "Show the RBAC policy", run: rbacGet},
+		{name: "set", summary: "Replace the RBAC policy with the one in a file, such as the output of rbac get", run: rbacSet},
+	},
+}
+
+func rbacGet(ctx context.Context, c *cli, args []string) error {
+	if _, err := c.parse(c.flags(), args, 0, 0); err != nil {
+		return err
+	}
+	projectSlug, envSlug, err := c.envSlugs()
+	if err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	resp, err := client.RBACPolicyAPI().Get(ctx, rbacpolicy.GetRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+	})
+	if err != nil {
+		return err
+	}
+	return c.print(resp.Policy)
+}
+
+func rbacSet(ctx context.Context, c *cli, args []string) error {
+	fs := c.flags()
+	file := fs.String("f", "", "YAML or JSON file with the policy, or - for stdin")
+	if _, err := c.parse(fs, args, 0, 0); err != nil {
+		return err
+	}
+	projectSlug, envSlug, err := c.envSlugs()
+	if err != nil {
+		return err
+	}
+	// The file is read as a Policy, so that the output of rbac get can be edited and set. Stytch
+	// resources are managed by Stytch and are not sent.
+	var policy rbacpolicy.Policy
+	if err := c.readInput(*file, &policy); err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	resp, err := client.RBACPolicyAPI().Set(ctx, rbacpolicy.SetRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+		StytchMember:    policy.StytchMember,
+		StytchAdmin:     policy.StytchAdmin,
+		StytchUser:      policy.StytchUser,
+		CustomRoles:     policy.CustomRoles,
+		CustomResources: policy.CustomResources,
+		CustomScopes:    policy.CustomScopes,
+	})
+	if err != nil {
+		return err
+	}
+	return c.print(resp.Policy)
+}
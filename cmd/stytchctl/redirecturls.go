@@ -0,0 +1,204 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"slices"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/redirecturls"
+)
+
+var redirectURLsCommand = &command{
+	name:    "redirect-urls",
+	summary: "Manage the redirect URLs of an environment",
+	commands: []*command{
+		{name: "list", summary: "List redirect URLs and their types", run: redirectURLsList},
+		{name: "get", args: "<url>", summary: "Show a redirect URL", run: redirectURLsGet},
+		{name: "create", args: "<url>", summary: "Create a redirect URL", run: redirectURLsCreate},
+		{name: "update", args: "<url>", summary: "Replace the types of a redirect URL", run: redirectURLsUpdate},
+		{name: "delete", args: "<url>", summary: "Delete a redirect URL", run: redirectURLsDelete},
+	},
+}
+
+// urlTypeFlags defines the flags that set the types of a redirect URL.
+type urlTypeFlags struct {
+	types, defaults listFlag
+	noPromote       *bool
+}
+
+func newURLTypeFlags(fs *flag.FlagSet) *urlTypeFlags {
+	f := &urlTypeFlags{}
+	fs.Var(&f.types, "type", fmt.Sprintf("type the URL is valid for, repeatable: one of %v", redirecturls.RedirectURLTypes()))
+	fs.Var(&f.defaults, "default", "type the URL is the default for, repeatable; implies --type")
+	f.noPromote = fs.Bool("no-promote-defaults", false, "do not make another URL the default for types this URL stops being the default for")
+	return f
+}
+
+func (f *urlTypeFlags) validTypes() ([]redirecturls.URLType, error) {
+	var out []redirecturls.URLType
+	for _, t := range append(f.types, f.defaults...) {
+		typ := redirecturls.RedirectURLType(t)
+		if !slices.Contains(redirecturls.RedirectURLTypes(), typ) {
+			return nil, usagef("unknown redirect URL type %q", t)
+		}
+		if slices.ContainsFunc(out, func(u redirecturls.URLType) bool { return u.Type == typ }) {
+			continue
+		}
+		out = append(out, redirecturls.URLType{Type: typ, IsDefault: slices.Contains(f.defaults, t)})
+	}
+	if len(out) == 0 {
+		return nil, usagef("at least one --type is required")
+	}
+	return out, nil
+}
+
+func (f *urlTypeFlags) doNotPromoteDefaults() *bool {
+	if !*f.noPromote {
+		return nil
+	}
+	return f.noPromote
+}
+
+func redirectURLsList(ctx context.Context, c *cli, args []string) error {
+	if _, err := c.parse(c.flags(), args, 0, 0); err != nil {
+		return err
+	}
+	projectSlug, envSlug, err := c.envSlugs()
+	if err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	resp, err := client.RedirectURLsAPI().GetAll(ctx, redirecturls.GetAllRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+	})
+	if err != nil {
+		return err
+	}
+	return c.print(resp.RedirectURLs)
+}
+
+func redirectURLsGet(ctx context.Context, c *cli, args []string) error {
+	args, err := c.parse(c.flags(), args, 1, 1)
+	if err != nil {
+		return err
+	}
+	projectSlug, envSlug, err := c.envSlugs()
+	if err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	resp, err := client.RedirectURLsAPI().Get(ctx, redirecturls.GetRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+		URL:             args[0],
+	})
+	if err != nil {
+		return err
+	}
+	return c.print(resp.RedirectURL)
+}
+
+func redirectURLsCreate(ctx context.Context, c *cli, args []string) error {
+	fs := c.flags()
+	types := newURLTypeFlags(fs)
+	args, err := c.parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	validTypes, err := types.validTypes()
+	if err != nil {
+		return err
+	}
+	projectSlug, envSlug, err := c.envSlugs()
+	if err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	resp, err := client.RedirectURLsAPI().Create(ctx, redirecturls.CreateRequest{
+		ProjectSlug:          projectSlug,
+		EnvironmentSlug:      envSlug,
+		URL:                  args[0],
+		ValidTypes:           validTypes,
+		DoNotPromoteDefaults: types.doNotPromoteDefaults(),
+	})
+	if err != nil {
+		return err
+	}
+	return c.print(resp.RedirectURL)
+}
+
+func redirectURLsUpdate(ctx context.Context, c *cli, args []string) error {
+	fs := c.flags()
+	types := newURLTypeFlags(fs)
+	args, err := c.parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	validTypes, err := types.validTypes()
+	if err != nil {
+		return err
+	}
+	projectSlug, envSlug, err := c.envSlugs()
+	if err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	resp, err := client.RedirectURLsAPI().Update(ctx, redirecturls.UpdateRequest{
+		ProjectSlug:          projectSlug,
+		EnvironmentSlug:      envSlug,
+		URL:                  args[0],
+		ValidTypes:           validTypes,
+		DoNotPromoteDefaults: types.doNotPromoteDefaults(),
+	})
+	if err != nil {
+		return err
+	}
+	return c.print(resp.RedirectURL)
+}
+
+func redirectURLsDelete(ctx context.Context, c *cli, args []string) error {
+	fs := c.flags()
+	yes := fs.Bool("yes", false, "do not ask for confirmation")
+	noPromote := fs.Bool("no-promote-defaults", false, "do not make another URL the default for the types this URL is the default for")
+	args, err := c.parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	projectSlug, envSlug, err := c.envSlugs()
+	if err != nil {
+		return err
+	}
+	if err := c.confirm(*yes, "Delete redirect URL %s of %s/%s?", args[0], projectSlug, envSlug); err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	req := redirecturls.DeleteRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+		URL:             args[0],
+	}
+	if *noPromote {
+		req.DoNotPromoteDefaults = noPromote
+	}
+	if _, err := client.RedirectURLsAPI().Delete(ctx, req); err != nil {
+		return err
+	}
+	return c.done("deleted redirect URL %s", args[0])
+}
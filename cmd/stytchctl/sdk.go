@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/sdk"
+)
+
+var sdkCommand = &command{
+	name:    "sdk",
+	summary: "Manage the frontend SDK configuration of an environment",
+	commands: []*command{
+		{name: "get", summary: "Show the SDK configuration", run: sdkGet},
+		{name: "set", summary: "Set the SDK configuration from a file, such as the output of sdk get", run: sdkSet},
+	},
+}
+
+// vertical returns the vertical of a project, which decides which SDK configuration applies.
+func vertical(ctx context.Context, client api.Interface, projectSlug string) (projects.Vertical, error) {
+	resp, err := client.ProjectsAPI().Get(ctx, projects.GetRequest{ProjectSlug: projectSlug})
+	if err != nil {
+		return "", err
+	}
+	return resp.Project.Vertical, nil
+}
+
+func sdkGet(ctx context.Context, c *cli, args []string) error {
+	if _, err := c.parse(c.flags(), args, 0, 0); err != nil {
+		return err
+	}
+	projectSlug, envSlug, err := c.envSlugs()
+	if err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	v, err := vertical(ctx, client, projectSlug)
+	if err != nil {
+		return err
+	}
+	if v == projects.VerticalConsumer {
+		resp, err := client.SDKAPI().GetConsumerConfig(ctx, sdk.GetConsumerConfigRequest{
+			ProjectSlug:     projectSlug,
+			EnvironmentSlug: envSlug,
+		})
+		if err != nil {
+			return err
+		}
+		return c.print(resp.Config)
+	}
+	resp, err := client.SDKAPI().GetB2BConfig(ctx, sdk.GetB2BConfigRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+	})
+	if err != nil {
+		return err
+	}
+	return c.print(resp.Config)
+}
+
+func sdkSet(ctx context.Context, c *cli, args []string) error {
+	fs := c.flags()
+	file := fs.String("f", "", "YAML or JSON file with the configuration, or - for stdin")
+	if _, err := c.parse(fs, args, 0, 0); err != nil {
+		return err
+	}
+	projectSlug, envSlug, err := c.envSlugs()
+	if err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	v, err := vertical(ctx, client, projectSlug)
+	if err != nil {
+		return err
+	}
+	if v == projects.VerticalConsumer {
+		var config sdk.ConsumerConfig
+		if err := c.readInput(*file, &config); err != nil {
+			return err
+		}
+		resp, err := client.SDKAPI().SetConsumerConfig(ctx, sdk.SetConsumerConfigRequest{
+			ProjectSlug:     projectSlug,
+			EnvironmentSlug: envSlug,
+			Config:          &config,
+		})
+		if err != nil {
+			return err
+		}
+		return c.print(resp.Config)
+	}
+	var config sdk.B2BConfig
+	if err := c.readInput(*file, &config); err != nil {
+		return err
+	}
+	resp, err := client.SDKAPI().SetB2BConfig(ctx, sdk.SetB2BConfigRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+		Config:          &config,
+	})
+	if err != nil {
+		return err
+	}
+	return c.print(resp.Config)
+}
@@ -0,0 +1,222 @@
+package main
+
+import (
+	"context"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/publictokens"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/secrets"
+)
+
+var secretsCommand = &command{
+	name:    "secrets",
+	summary: "Manage the API secrets of an environment",
+	commands: []*command{
+		{name: "list", summary: "List secrets, showing their last four characters", run: secretsList},
+		{name: "get", args: "<secret-id>", summary: "Show a secret, showing its last four characters", run: secretsGet},
+		{name: "create", summary: "Create a secret and print its value, which cannot be retrieved again", run: secretsCreate},
+		{name: "delete", args: "<secret-id>", summary: "Delete a secret", run: secretsDelete},
+	},
+}
+
+func secretsList(ctx context.Context, c *cli, args []string) error {
+	if _, err := c.parse(c.flags(), args, 0, 0); err != nil {
+		return err
+	}
+	projectSlug, envSlug, err := c.envSlugs()
+	if err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	resp, err := client.SecretsAPI().GetAll(ctx, secrets.GetAllRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+	})
+	if err != nil {
+		return err
+	}
+	return c.print(resp.Secrets)
+}
+
+func secretsGet(ctx context.Context, c *cli, args []string) error {
+	args, err := c.parse(c.flags(), args, 1, 1)
+	if err != nil {
+		return err
+	}
+	projectSlug, envSlug, err := c.envSlugs()
+	if err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	resp, err := client.SecretsAPI().Get(ctx, secrets.GetRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+		SecretID:        args[0],
+	})
+	if err != nil {
+		return err
+	}
+	return c.print(resp.Secret)
+}
+
+func secretsCreate(ctx context.Context, c *cli, args []string) error {
+	if _, err := c.parse(c.flags(), args, 0, 0); err != nil {
+		return err
+	}
+	projectSlug, envSlug, err := c.envSlugs()
+	if err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	resp, err := client.SecretsAPI().Create(ctx, secrets.CreateRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+	})
+	if err != nil {
+		return err
+	}
+	return c.print(resp.Secret)
+}
+
+func secretsDelete(ctx context.Context, c *cli, args []string) error {
+	fs := c.flags()
+	yes := fs.Bool("yes", false, "do not ask for confirmation")
+	args, err := c.parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	projectSlug, envSlug, err := c.envSlugs()
+	if err != nil {
+		return err
+	}
+	if err := c.confirm(*yes, "Delete secret %s of %s/%s?", args[0], projectSlug, envSlug); err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	if _, err := client.SecretsAPI().Delete(ctx, secrets.DeleteRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+		SecretID:        args[0],
+	}); err != nil {
+		return err
+	}
+	return c.done("deleted secret %s", args[0])
+}
+
+var publicTokensCommand = &command{
+	name:    "public-tokens",
+	summary: "Manage the public tokens of an environment",
+	commands: []*command{
+		{name: "list", summary: "List public tokens", run: publicTokensList},
+		{name: "get", args: "<public-token>", summary: "Show a public token", run: publicTokensGet},
+		{name: "create", summary: "Create a public token", run: publicTokensCreate},
+		{name: "delete", args: "<public-token>", summary: "Delete a public token", run: publicTokensDelete},
+	},
+}
+
+func publicTokensList(ctx context.Context, c *cli, args []string) error {
+	if _, err := c.parse(c.flags(), args, 0, 0); err != nil {
+		return err
+	}
+	projectSlug, envSlug, err := c.envSlugs()
+	if err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	resp, err := client.PublicTokensAPI().GetAll(ctx, publictokens.GetAllRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+	})
+	if err != nil {
+		return err
+	}
+	return c.print(resp.PublicTokens)
+}
+
+func publicTokensGet(ctx context.Context, c *cli, args []string) error {
+	args, err := c.parse(c.flags(), args, 1, 1)
+	if err != nil {
+		return err
+	}
+	projectSlug, envSlug, err := c.envSlugs()
+	if err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	resp, err := client.PublicTokensAPI().Get(ctx, publictokens.GetRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+		PublicToken:     args[0],
+	})
+	if err != nil {
+		return err
+	}
+	return c.print(resp.PublicToken)
+}
+
+func publicTokensCreate(ctx context.Context, c *cli, args []string) error {
+	if _, err := c.parse(c.flags(), args, 0, 0); err != nil {
+		return err
+	}
+	projectSlug, envSlug, err := c.envSlugs()
+	if err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	resp, err := client.PublicTokensAPI().Create(ctx, publictokens.CreateRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+	})
+	if err != nil {
+		return err
+	}
+	return c.print(resp.PublicToken)
+}
+
+func publicTokensDelete(ctx context.Context, c *cli, args []string) error {
+	fs := c.flags()
+	yes := fs.Bool("yes", false, "do not ask for confirmation")
+	args, err := c.parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	projectSlug, envSlug, err := c.envSlugs()
+	if err != nil {
+		return err
+	}
+	if err := c.confirm(*yes, "Delete public token %s of %s/%s?", args[0], projectSlug, envSlug); err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	if _, err := client.PublicTokensAPI().Delete(ctx, publictokens.DeleteRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+		PublicToken:     args[0],
+	}); err != nil {
+		return err
+	}
+	return c.done("deleted public token %s", args[0])
+}
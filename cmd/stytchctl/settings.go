@@ -0,0 +1,243 @@
+package main
+
+import (
+	"context"
+	"slices"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/countrycodeallowlist"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/jwttemplates"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/passwordstrengthconfig"
+)
+
+var jwtTemplatesCommand = &command{
+	name:    "jwt-templates",
+	summary: "Manage the session and M2M JWT templates of an environment",
+	commands: []*command{
+		{name: "get", args: "<template-type>", summary: "Show a JWT template", run: jwtTemplatesGet},
+		{name: "set", args: "<template-type>", summary: "Set a JWT template from a file, such as the output of jwt-templates get", run: jwtTemplatesSet},
+	},
+}
+
+func jwtTemplateType(s string) (jwttemplates.JWTTemplateType, error) {
+	t := jwttemplates.JWTTemplateType(s)
+	if !slices.Contains(jwttemplates.JWTTemplateTypes(), t) {
+		return "", usagef("unknown JWT template type %q: must be one of %v", s, jwttemplates.JWTTemplateTypes())
+	}
+	return t, nil
+}
+
+func jwtTemplatesGet(ctx context.Context, c *cli, args []string) error {
+	args, err := c.parse(c.flags(), args, 1, 1)
+	if err != nil {
+		return err
+	}
+	typ, err := jwtTemplateType(args[0])
+	if err != nil {
+		return err
+	}
+	projectSlug, envSlug, err := c.envSlugs()
+	if err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	resp, err := client.JWTTemplatesAPI().Get(ctx, jwttemplates.GetRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+		JWTTemplateType: typ,
+	})
+	if err != nil {
+		return err
+	}
+	return c.print(resp.JWTTemplate)
+}
+
+func jwtTemplatesSet(ctx context.Context, c *cli, args []string) error {
+	fs := c.flags()
+	file := fs.String("f", "", "YAML or JSON file with the template, or - for stdin")
+	args, err := c.parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	typ, err := jwtTemplateType(args[0])
+	if err != nil {
+		return err
+	}
+	projectSlug, envSlug, err := c.envSlugs()
+	if err != nil {
+		return err
+	}
+	var template jwttemplates.JWTTemplate
+	if err := c.readInput(*file, &template); err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	resp, err := client.JWTTemplatesAPI().Set(ctx, jwttemplates.SetRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+		JWTTemplateType: typ,
+		TemplateContent: template.TemplateContent,
+		CustomAudience:  template.CustomAudience,
+	})
+	if err != nil {
+		return err
+	}
+	return c.print(resp.JWTTemplate)
+}
+
+var passwordStrengthCommand = &command{
+	name:    "password-strength",
+	summary: "Manage the password strength configuration of an environment",
+	commands: []*command{
+		{name: "get", summary: "Show the password strength configuration", run: passwordStrengthGet},
+		{name: "set", summary: "Set the password strength configuration from a file, such as the output of password-strength get", run: passwordStrengthSet},
+	},
+}
+
+func passwordStrengthGet(ctx context.Context, c *cli, args []string) error {
+	if _, err := c.parse(c.flags(), args, 0, 0); err != nil {
+		return err
+	}
+	projectSlug, envSlug, err := c.envSlugs()
+	if err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	resp, err := client.PasswordStrengthConfigAPI().Get(ctx, passwordstrengthconfig.GetRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+	})
+	if err != nil {
+		return err
+	}
+	return c.print(resp.PasswordStrengthConfig)
+}
+
+func passwordStrengthSet(ctx context.Context, c *cli, args []string) error {
+	fs := c.flags()
+	file := fs.String("f", "", "YAML or JSON file with the configuration, or - for stdin")
+	if _, err := c.parse(fs, args, 0, 0); err != nil {
+		return err
+	}
+	projectSlug, envSlug, err := c.envSlugs()
+	if err != nil {
+		return err
+	}
+	var req passwordstrengthconfig.SetRequest
+	if err := c.readInput(*file, &req); err != nil {
+		return err
+	}
+	req.ProjectSlug = projectSlug
+	req.EnvironmentSlug = envSlug
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	resp, err := client.PasswordStrengthConfigAPI().Set(ctx, req)
+	if err != nil {
+		return err
+	}
+	return c.print(resp.PasswordStrengthConfig)
+}
+
+var countryCodesCommand = &command{
+	name:    "country-codes",
+	summary: "Manage the country codes an environment may send SMS and WhatsApp messages to",
+	commands: []*command{
+		{name: "get", args: "sms|whatsapp", summary: "Show the allowed country codes", run: countryCodesGet},
+		{name: "set", args: "sms|whatsapp <country-code>...", summary: "Replace the allowed country codes", run: countryCodesSet},
+	},
+}
+
+func channelArg(s string) (string, error) {
+	switch s {
+	case "sms", "whatsapp":
+		return s, nil
+	}
+	return "", usagef("unknown channel %q: must be sms or whatsapp", s)
+}
+
+func countryCodesGet(ctx context.Context, c *cli, args []string) error {
+	args, err := c.parse(c.flags(), args, 1, 1)
+	if err != nil {
+		return err
+	}
+	channel, err := channelArg(args[0])
+	if err != nil {
+		return err
+	}
+	projectSlug, envSlug, err := c.envSlugs()
+	if err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	if channel == "sms" {
+		resp, err := client.CountryCodeAllowlistAPI().GetAllowedSMSCountryCodes(ctx, countrycodeallowlist.GetAllowedSMSCountryCodesRequest{
+			ProjectSlug:     projectSlug,
+			EnvironmentSlug: envSlug,
+		})
+		if err != nil {
+			return err
+		}
+		return c.print(resp.CountryCodes)
+	}
+	resp, err := client.CountryCodeAllowlistAPI().GetAllowedWhatsAppCountryCodes(ctx, countrycodeallowlist.GetAllowedWhatsAppCountryCodesRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+	})
+	if err != nil {
+		return err
+	}
+	return c.print(resp.CountryCodes)
+}
+
+func countryCodesSet(ctx context.Context, c *cli, args []string) error {
+	args, err := c.parse(c.flags(), args, 2, -1)
+	if err != nil {
+		return err
+	}
+	channel, err := channelArg(args[0])
+	if err != nil {
+		return err
+	}
+	projectSlug, envSlug, err := c.envSlugs()
+	if err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	codes := args[1:]
+	if channel == "sms" {
+		resp, err := client.CountryCodeAllowlistAPI().SetAllowedSMSCountryCodes(ctx, countrycodeallowlist.SetAllowedSMSCountryCodesRequest{
+			ProjectSlug:     projectSlug,
+			EnvironmentSlug: envSlug,
+			CountryCodes:    codes,
+		})
+		if err != nil {
+			return err
+		}
+		return c.print(resp.CountryCodes)
+	}
+	resp, err := client.CountryCodeAllowlistAPI().SetAllowedWhatsAppCountryCodes(ctx, countrycodeallowlist.SetAllowedWhatsAppCountryCodesRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+		CountryCodes:    codes,
+	})
+	if err != nil {
+		return err
+	}
+	return c.print(resp.CountryCodes)
+}
@@ -0,0 +1,241 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/emailtemplates"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/environments"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/eventlogstreaming"
+	migrationprojects "github.com/stytchauth/stytch-management-go/v3/pkg/models/migration/projects"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/publictokens"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/redirecturls"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/secrets"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/trustedtokenprofiles"
+)
+
+// none is the value of an empty cell, so that every row has the same number of fields for tools
+// such as awk and cut.
+const none = "-"
+
+// column is a column of the table output of a model type.
+type column struct {
+	header string
+	// wide columns are only shown by -o wide.
+	wide  bool
+	value func(v any) string
+}
+
+// col returns a column for values of type T.
+func col[T any](header string, value func(T) string) column {
+	return column{header: header, value: func(v any) string { return value(v.(T)) }}
+}
+
+// wideCol returns a column for values of type T that is only shown by -o wide.
+func wideCol[T any](header string, value func(T) string) column {
+	c := col(header, value)
+	c.wide = true
+	return c
+}
+
+// tables maps model types to their table columns. Types that are not listed, such as the SDK
+// configuration and RBAC policy, are printed as YAML by the table formats.
+var tables = map[reflect.Type][]column{}
+
+func table[T any](columns ...column) {
+	tables[reflect.TypeOf((*T)(nil)).Elem()] = columns
+}
+
+func init() {
+	table[projects.Project](
+		col("SLUG", func(p projects.Project) string { return p.ProjectSlug }),
+		col("NAME", func(p projects.Project) string { return p.Name }),
+		col("VERTICAL", func(p projects.Project) string { return string(p.Vertical) }),
+		col("CREATED", func(p projects.Project) string { return formatTime(p.CreatedAt) }),
+	)
+	table[environments.Environment](
+		col("SLUG", func(e environments.Environment) string { return e.EnvironmentSlug }),
+		col("TYPE", func(e environments.Environment) string { return string(e.Type) }),
+		col("CREATED", func(e environments.Environment) string { return formatTime(e.CreatedAt) }),
+		wideCol("NAME", func(e environments.Environment) string { return e.Name }),
+		wideCol("OAUTH CALLBACK ID", func(e environments.Environment) string { return e.OAuthCallbackID }),
+		wideCol("USER LOCK THRESHOLD", func(e environments.Environment) string { return strconv.Itoa(e.UserLockThreshold) }),
+	)
+	table[environments.Metrics](
+		col("USERS", func(m environments.Metrics) string { return strconv.FormatUint(uint64(m.UserCount), 10) }),
+		col("ORGANIZATIONS", func(m environments.Metrics) string { return strconv.FormatUint(uint64(m.OrganizationCount), 10) }),
+		col("MEMBERS", func(m environments.Metrics) string { return strconv.FormatUint(uint64(m.MemberCount), 10) }),
+		col("M2M CLIENTS", func(m environments.Metrics) string { return strconv.FormatUint(uint64(m.M2MClientCount), 10) }),
+	)
+	table[secrets.MaskedSecret](
+		col("ID", func(s secrets.MaskedSecret) string { return s.SecretID }),
+		col("LAST FOUR", func(s secrets.MaskedSecret) string { return s.LastFour }),
+		col("USED AT", func(s secrets.MaskedSecret) string { return formatTime(s.UsedAt) }),
+		wideCol("CREATED", func(s secrets.MaskedSecret) string { return formatTime(s.CreatedAt) }),
+	)
+	table[secrets.Secret](
+		col("ID", func(s secrets.Secret) string { return s.SecretID }),
+		col("SECRET", func(s secrets.Secret) string { return s.Reveal() }),
+		col("CREATED", func(s secrets.Secret) string { return formatTime(s.CreatedAt) }),
+	)
+	table[publictokens.PublicToken](
+		col("PUBLIC TOKEN", func(t publictokens.PublicToken) string { return t.PublicToken }),
+		col("CREATED", func(t publictokens.PublicToken) string { return formatTime(t.CreatedAt) }),
+	)
+	table[redirecturls.RedirectURL](
+		col("URL", func(u redirecturls.RedirectURL) string { return u.URL }),
+		col("TYPES", formatURLTypes),
+	)
+	table[emailtemplates.EmailTemplate](
+		col("ID", func(t emailtemplates.EmailTemplate) string { return t.TemplateID }),
+		col("NAME", func(t emailtemplates.EmailTemplate) string { return deref(t.Name) }),
+		wideCol("KIND", func(t emailtemplates.EmailTemplate) string {
+			switch {
+			case t.CustomHTMLCustomization != nil:
+				return "custom-html"
+			case t.PrebuiltCustomization != nil:
+				return "prebuilt"
+			}
+			return ""
+		}),
+	)
+	table[eventlogstreaming.EventLogStreaming](
+		col("DESTINATION", func(e eventlogstreaming.EventLogStreaming) string { return string(e.DestinationType) }),
+		col("STATUS", func(e eventlogstreaming.EventLogStreaming) string { return string(e.StreamingStatus) }),
+	)
+	table[eventlogstreaming.EventLogStreamingMasked](
+		col("DESTINATION", func(e eventlogstreaming.EventLogStreamingMasked) string { return string(e.DestinationType) }),
+		col("STATUS", func(e eventlogstreaming.EventLogStreamingMasked) string { return string(e.StreamingStatus) }),
+	)
+	table[trustedtokenprofiles.TrustedTokenProfile](
+		col("ID", func(p trustedtokenprofiles.TrustedTokenProfile) string { return p.ProfileID }),
+		col("NAME", func(p trustedtokenprofiles.TrustedTokenProfile) string { return p.Name }),
+		col("ISSUER", func(p trustedtokenprofiles.TrustedTokenProfile) string { return p.Issuer }),
+		col("AUDIENCE", func(p trustedtokenprofiles.TrustedTokenProfile) string { return p.Audience }),
+		wideCol("KEY TYPE", func(p trustedtokenprofiles.TrustedTokenProfile) string { return string(p.PublicKeyType) }),
+		wideCol("PEM FILES", func(p trustedtokenprofiles.TrustedTokenProfile) string { return strconv.Itoa(len(p.PEMFiles)) }),
+		wideCol("JWKS URL", func(p trustedtokenprofiles.TrustedTokenProfile) string { return deref(p.JWKSURL) }),
+	)
+	table[migrationprojects.LegacyProject](
+		col("PROJECT", func(p migrationprojects.LegacyProject) string { return p.ProjectSlug }),
+		col("LIVE PROJECT ID", func(p migrationprojects.LegacyProject) string { return p.LiveProjectID }),
+		col("LIVE ENV", func(p migrationprojects.LegacyProject) string { return p.LiveEnvironmentSlug }),
+		col("TEST PROJECT ID", func(p migrationprojects.LegacyProject) string { return p.TestProjectID }),
+		col("TEST ENV", func(p migrationprojects.LegacyProject) string { return p.TestEnvironmentSlug }),
+		wideCol("NAME", func(p migrationprojects.LegacyProject) string { return p.Name }),
+	)
+}
+
+// formatURLTypes lists the types of a redirect URL, marking those it is the default for with *.
+func formatURLTypes(u redirecturls.RedirectURL) string {
+	types := make([]string, len(u.ValidTypes))
+	for i, t := range u.ValidTypes {
+		types[i] = string(t.Type)
+		if t.IsDefault {
+			types[i] += "*"
+		}
+	}
+	return strings.Join(types, ",")
+}
+
+// formatTime formats times in UTC, so that output does not depend on the local time zone.
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// printTable prints a value, or a slice of values, of a type with table columns. Strings and
+// lists of strings are printed one per line, and values of other types as YAML.
+func (c *cli) printTable(v any, wide bool) error {
+	rv := reflect.ValueOf(v)
+	typ := rv.Type()
+	isList := typ.Kind() == reflect.Slice
+	if isList {
+		typ = typ.Elem()
+	}
+	columns, ok := tables[typ]
+	if !ok {
+		switch typ.Kind() {
+		case reflect.String:
+			if !isList {
+				_, err := fmt.Fprintln(c.stdout, v)
+				return err
+			}
+			for i := 0; i < rv.Len(); i++ {
+				if _, err := fmt.Fprintln(c.stdout, rv.Index(i).Interface()); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		f := c.format
+		c.format = &outputFormat{name: formatYAML}
+		defer func() { c.format = f }()
+		return c.print(v)
+	}
+	if !wide {
+		var narrow []column
+		for _, col := range columns {
+			if !col.wide {
+				narrow = append(narrow, col)
+			}
+		}
+		columns = narrow
+	}
+
+	w := tabwriter.NewWriter(c.stdout, 0, 0, 3, ' ', 0)
+	row := func(cells []string) {
+		fmt.Fprintln(w, strings.Join(cells, "\t"))
+	}
+	if !c.noHeaders {
+		headers := make([]string, len(columns))
+		for i, col := range columns {
+			headers[i] = col.header
+		}
+		row(headers)
+	}
+	values := []reflect.Value{rv}
+	if isList {
+		values = make([]reflect.Value, rv.Len())
+		for i := range values {
+			values[i] = rv.Index(i)
+		}
+	}
+	for _, v := range values {
+		cells := make([]string, len(columns))
+		for i, col := range columns {
+			cells[i] = col.value(v.Interface())
+			// Cells must not break the row into several lines or fields.
+			cells[i] = strings.Join(strings.Fields(cells[i]), " ")
+			if cells[i] == "" {
+				cells[i] = none
+			}
+		}
+		row(cells)
+	}
+	return w.Flush()
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
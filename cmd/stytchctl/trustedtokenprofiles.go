@@ -0,0 +1,248 @@
+package main
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/trustedtokenprofiles"
+)
+
+var trustedTokenProfilesCommand = &command{
+	name:    "trusted-token-profiles",
+	summary: "Manage the trusted token profiles of an environment",
+	commands: []*command{
+		{name: "list", summary: "List trusted token profiles", run: trustedTokenProfilesList},
+		{name: "get", args: "<profile-id>", summary: "Show a trusted token profile", run: trustedTokenProfilesGet},
+		{name: "create", summary: "Create a trusted token profile from a file", run: trustedTokenProfilesCreate},
+		{name: "update", args: "<profile-id>", summary: "Update a trusted token profile from a file", run: trustedTokenProfilesUpdate},
+		{name: "delete", args: "<profile-id>", summary: "Delete a trusted token profile", run: trustedTokenProfilesDelete},
+		{
+			name:    "pem",
+			summary: "Manage the PEM files of a trusted token profile",
+			commands: []*command{
+				{name: "add", args: "<profile-id> <file>", summary: "Add a public key from a PEM file, or - for stdin", run: pemFilesAdd},
+				{name: "get", args: "<profile-id> <pem-file-id>", summary: "Show a PEM file", run: pemFilesGet},
+				{name: "delete", args: "<profile-id> <pem-file-id>", summary: "Delete a PEM file", run: pemFilesDelete},
+			},
+		},
+	},
+}
+
+func trustedTokenProfilesList(ctx context.Context, c *cli, args []string) error {
+	if _, err := c.parse(c.flags(), args, 0, 0); err != nil {
+		return err
+	}
+	projectSlug, envSlug, err := c.envSlugs()
+	if err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	resp, err := client.TrustedTokenProfilesAPI().GetAll(ctx, trustedtokenprofiles.GetAllRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+	})
+	if err != nil {
+		return err
+	}
+	return c.print(resp.Profiles)
+}
+
+func trustedTokenProfilesGet(ctx context.Context, c *cli, args []string) error {
+	args, err := c.parse(c.flags(), args, 1, 1)
+	if err != nil {
+		return err
+	}
+	projectSlug, envSlug, err := c.envSlugs()
+	if err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	resp, err := client.TrustedTokenProfilesAPI().Get(ctx, trustedtokenprofiles.GetRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+		ProfileID:       args[0],
+	})
+	if err != nil {
+		return err
+	}
+	return c.print(resp.Profile)
+}
+
+func trustedTokenProfilesCreate(ctx context.Context, c *cli, args []string) error {
+	fs := c.flags()
+	file := fs.String("f", "", "YAML or JSON file with the profile, or - for stdin")
+	if _, err := c.parse(fs, args, 0, 0); err != nil {
+		return err
+	}
+	projectSlug, envSlug, err := c.envSlugs()
+	if err != nil {
+		return err
+	}
+	var req trustedtokenprofiles.CreateRequest
+	if err := c.readInput(*file, &req); err != nil {
+		return err
+	}
+	req.ProjectSlug = projectSlug
+	req.EnvironmentSlug = envSlug
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	resp, err := client.TrustedTokenProfilesAPI().Create(ctx, req)
+	if err != nil {
+		return err
+	}
+	return c.print(resp.Profile)
+}
+
+func trustedTokenProfilesUpdate(ctx context.Context, c *cli, args []string) error {
+	fs := c.flags()
+	file := fs.String("f", "", "YAML or JSON file with the fields to change, or - for stdin")
+	args, err := c.parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	projectSlug, envSlug, err := c.envSlugs()
+	if err != nil {
+		return err
+	}
+	var req trustedtokenprofiles.UpdateRequest
+	if err := c.readInput(*file, &req); err != nil {
+		return err
+	}
+	req.ProjectSlug = projectSlug
+	req.EnvironmentSlug = envSlug
+	req.ProfileID = args[0]
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	resp, err := client.TrustedTokenProfilesAPI().Update(ctx, req)
+	if err != nil {
+		return err
+	}
+	return c.print(resp.Profile)
+}
+
+func trustedTokenProfilesDelete(ctx context.Context, c *cli, args []string) error {
+	fs := c.flags()
+	yes := fs.Bool("yes", false, "do not ask for confirmation")
+	args, err := c.parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	projectSlug, envSlug, err := c.envSlugs()
+	if err != nil {
+		return err
+	}
+	if err := c.confirm(*yes, "Delete trusted token profile %s of %s/%s?", args[0], projectSlug, envSlug); err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	if _, err := client.TrustedTokenProfilesAPI().Delete(ctx, trustedtokenprofiles.DeleteRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+		ProfileID:       args[0],
+	}); err != nil {
+		return err
+	}
+	return c.done("deleted trusted token profile %s", args[0])
+}
+
+func pemFilesAdd(ctx context.Context, c *cli, args []string) error {
+	args, err := c.parse(c.flags(), args, 2, 2)
+	if err != nil {
+		return err
+	}
+	projectSlug, envSlug, err := c.envSlugs()
+	if err != nil {
+		return err
+	}
+	var key []byte
+	if args[1] == "-" {
+		key, err = io.ReadAll(c.stdin)
+	} else {
+		key, err = os.ReadFile(args[1])
+	}
+	if err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	resp, err := client.TrustedTokenProfilesAPI().CreatePEMFile(ctx, trustedtokenprofiles.CreatePEMFileRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+		ProfileID:       args[0],
+		PublicKey:       string(key),
+	})
+	if err != nil {
+		return err
+	}
+	return c.print(resp.PEMFile)
+}
+
+func pemFilesGet(ctx context.Context, c *cli, args []string) error {
+	args, err := c.parse(c.flags(), args, 2, 2)
+	if err != nil {
+		return err
+	}
+	projectSlug, envSlug, err := c.envSlugs()
+	if err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	resp, err := client.TrustedTokenProfilesAPI().GetPEMFile(ctx, trustedtokenprofiles.GetPEMFileRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+		ProfileID:       args[0],
+		PEMFileID:       args[1],
+	})
+	if err != nil {
+		return err
+	}
+	return c.print(resp.PEMFile)
+}
+
+func pemFilesDelete(ctx context.Context, c *cli, args []string) error {
+	fs := c.flags()
+	yes := fs.Bool("yes", false, "do not ask for confirmation")
+	args, err := c.parse(fs, args, 2, 2)
+	if err != nil {
+		return err
+	}
+	projectSlug, envSlug, err := c.envSlugs()
+	if err != nil {
+		return err
+	}
+	if err := c.confirm(*yes, "Delete PEM file %s of trusted token profile %s?", args[1], args[0]); err != nil {
+		return err
+	}
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	if _, err := client.TrustedTokenProfilesAPI().DeletePEMFile(ctx, trustedtokenprofiles.DeletePEMFileRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+		ProfileID:       args[0],
+		PEMFileID:       args[1],
+	}); err != nil {
+		return err
+	}
+	return c.done("deleted PEM file %s", args[1])
+}
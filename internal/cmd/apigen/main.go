@@ -0,0 +1,439 @@
+// Command apigen generates code derived from the resource clients in pkg/api: an interface for
+// each client plus an aggregate interface, the table of HTTP operations the clients send, the
+// read-only clients, the in-memory fakes in pkg/apifake, the scoped clients in pkg/scope and the
+// approval queue clients in pkg/approval. It is run through go generate from the pkg/api
+// directory:
+//
+//	go generate ./pkg/api
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+const modulePath = "github.com/stytchauth/stytch-management-go/v3"
+
+// api describes the resource clients found in pkg/api.
+type api struct {
+	// Clients are listed in the order of the fields of the API struct.
+	Clients []*client
+	// Imports maps the package names used in client method signatures to their import paths.
+	Imports map[string]string
+}
+
+type client struct {
+	// Field is the name of the API struct field holding the client, such as "Projects".
+	Field string
+	// Type is the name of the client struct, such as "ProjectsClient".
+	Type string
+	// Methods are sorted by name.
+	Methods []*method
+}
+
+// Interface is the name of the interface generated for the client, such as "ProjectsAPI".
+func (c *client) Interface() string {
+	return strings.TrimSuffix(c.Type, "Client") + "API"
+}
+
+// ReadOnlyInterface is the name of the interface generated for the read-only methods of the client,
+// such as "ProjectsReadOnlyAPI".
+func (c *client) ReadOnlyInterface() string {
+	return strings.TrimSuffix(c.Type, "Client") + "ReadOnlyAPI"
+}
+
+// ReadOnlyType is the name of the read-only client struct, such as "ProjectsReadOnlyClient".
+func (c *client) ReadOnlyType() string {
+	return strings.TrimSuffix(c.Type, "Client") + "ReadOnlyClient"
+}
+
+// ReadOnlyMethods returns the methods that only read from the workspace.
+func (c *client) ReadOnlyMethods() []*method {
+	var out []*method
+	for _, m := range c.Methods {
+		if m.HTTPMethod == "GET" {
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
+type method struct {
+	Name string
+	Doc  []string
+	// Request and Response are the qualified request and response types, such as
+	// "projects.CreateRequest" and "projects.CreateResponse".
+	Request  string
+	Response string
+	// HTTPMethod and Path describe the HTTP request the method sends, such as "PATCH" and
+	// "/pwa/v3/projects/{ProjectSlug}". Path parameters are named after the request fields they
+	// are taken from.
+	HTTPMethod string
+	Path       string
+}
+
+// output is a generated file, relative to the module root.
+type output struct {
+	path     string
+	template *template.Template
+}
+
+var outputs = []output{
+	{path: "pkg/api/interfaces.go", template: interfacesTemplate},
+	{path: "pkg/api/operations.go", template: operationsTemplate},
+	{path: "pkg/api/readonlyclients.go", template: readOnlyTemplate},
+	{path: "pkg/apifake/zz_generated.go", template: fakesTemplate},
+	{path: "pkg/scope/zz_generated.go", template: scopeTemplate},
+	{path: "pkg/approval/zz_generated.go", template: approvalTemplate},
+}
+
+func main() {
+	root := flag.String("root", ".", "path to the module root")
+	flag.Parse()
+
+	a, err := parseAPI(filepath.Join(*root, "pkg", "api"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, out := range outputs {
+		src, err := render(out.template, a)
+		if err != nil {
+			log.Fatalf("%s: %v", out.path, err)
+		}
+		path := filepath.Join(*root, out.path)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			log.Fatal(err)
+		}
+		if err := os.WriteFile(path, src, 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+func render(t *template.Template, a *api) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, a); err != nil {
+		return nil, err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w\n%s", err, buf.Bytes())
+	}
+	return src, nil
+}
+
+// parseAPI reads the client structs and their methods from the non-test, non-generated files in
+// dir.
+func parseAPI(dir string) (*api, error) {
+	fset := token.NewFileSet()
+	matches, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	a := &api{Imports: map[string]string{}}
+	clients := map[string]*client{}
+	var fields []string
+	fieldTypes := map[string]string{}
+	var funcs []*ast.FuncDecl
+	fileImports := map[*ast.FuncDecl]map[string]string{}
+
+	for _, path := range matches {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		if ast.IsGenerated(f) {
+			continue
+		}
+		imports := map[string]string{}
+		for _, imp := range f.Imports {
+			p, _ := strconv.Unquote(imp.Path.Value)
+			name := filepath.Base(p)
+			if imp.Name != nil {
+				name = imp.Name.Name
+			}
+			imports[name] = p
+		}
+		for _, decl := range f.Decls {
+			switch d := decl.(type) {
+			case *ast.GenDecl:
+				for _, spec := range d.Specs {
+					ts, ok := spec.(*ast.TypeSpec)
+					if !ok {
+						continue
+					}
+					st, ok := ts.Type.(*ast.StructType)
+					if !ok {
+						continue
+					}
+					if strings.HasSuffix(ts.Name.Name, "Client") && ts.Name.IsExported() {
+						clients[ts.Name.Name] = &client{Type: ts.Name.Name}
+					}
+					if ts.Name.Name == "API" {
+						for _, fl := range st.Fields.List {
+							star, ok := fl.Type.(*ast.StarExpr)
+							if !ok || len(fl.Names) == 0 || !fl.Names[0].IsExported() {
+								continue
+							}
+							ident, ok := star.X.(*ast.Ident)
+							if !ok {
+								continue
+							}
+							fields = append(fields, fl.Names[0].Name)
+							fieldTypes[fl.Names[0].Name] = ident.Name
+						}
+					}
+				}
+			case *ast.FuncDecl:
+				if d.Recv != nil && d.Name.IsExported() {
+					funcs = append(funcs, d)
+					fileImports[d] = imports
+				}
+			}
+		}
+	}
+
+	for _, fn := range funcs {
+		star, ok := fn.Recv.List[0].Type.(*ast.StarExpr)
+		if !ok {
+			continue
+		}
+		recv, ok := star.X.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		c, ok := clients[recv.Name]
+		if !ok {
+			continue
+		}
+		m, err := parseMethod(fset, fn, fileImports[fn], a.Imports)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", recv.Name, fn.Name.Name, err)
+		}
+		c.Methods = append(c.Methods, m)
+	}
+
+	for _, field := range fields {
+		c, ok := clients[fieldTypes[field]]
+		if !ok {
+			return nil, fmt.Errorf("API field %s has unknown client type %s", field, fieldTypes[field])
+		}
+		c.Field = field
+		sort.Slice(c.Methods, func(i, j int) bool { return c.Methods[i].Name < c.Methods[j].Name })
+		a.Clients = append(a.Clients, c)
+	}
+	return a, nil
+}
+
+// parseMethod checks that fn has the signature shared by all client methods,
+//
+//	func (c *XClient) Name(ctx context.Context, body pkg.Request) (*pkg.Response, error)
+//
+// and records the packages it refers to.
+func parseMethod(fset *token.FileSet, fn *ast.FuncDecl, fileImports, imports map[string]string) (*method, error) {
+	params := fn.Type.Params.List
+	if len(params) != 2 || exprString(fset, params[0].Type) != "context.Context" {
+		return nil, fmt.Errorf("expected (context.Context, request) parameters")
+	}
+	results := fn.Type.Results
+	if results == nil || len(results.List) != 2 || exprString(fset, results.List[1].Type) != "error" {
+		return nil, fmt.Errorf("expected (*response, error) results")
+	}
+	resp, ok := results.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return nil, fmt.Errorf("expected a pointer response")
+	}
+
+	var err error
+	m := &method{
+		Name:     fn.Name.Name,
+		Request:  exprString(fset, params[1].Type),
+		Response: exprString(fset, resp.X),
+	}
+	for _, typ := range []string{m.Request, m.Response} {
+		pkg, _, ok := strings.Cut(typ, ".")
+		if !ok {
+			return nil, fmt.Errorf("expected a qualified type, got %s", typ)
+		}
+		path, ok := fileImports[pkg]
+		if !ok {
+			return nil, fmt.Errorf("unknown package %s", pkg)
+		}
+		if existing, ok := imports[pkg]; ok && existing != path {
+			return nil, fmt.Errorf("package name %s refers to both %s and %s", pkg, existing, path)
+		}
+		imports[pkg] = path
+	}
+	if m.HTTPMethod, m.Path, err = parseRequest(fn); err != nil {
+		return nil, err
+	}
+	if fn.Doc != nil {
+		for _, line := range strings.Split(strings.TrimSpace(fn.Doc.Text()), "\n") {
+			m.Doc = append(m.Doc, strings.TrimRight("// "+line, " "))
+		}
+	}
+	return m, nil
+}
+
+// parseRequest finds the c.client.NewRequest call in fn and returns its HTTP method and path
+// template. The path may be a string literal, a fmt.Sprintf of a literal with body fields as
+// arguments, or a concatenation of those.
+func parseRequest(fn *ast.FuncDecl) (string, string, error) {
+	var call *ast.CallExpr
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		c, ok := n.(*ast.CallExpr)
+		if !ok || call != nil {
+			return call == nil
+		}
+		if sel, ok := c.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "NewRequest" {
+			call = c
+			return false
+		}
+		return true
+	})
+	if call == nil || len(call.Args) < 3 {
+		return "", "", fmt.Errorf("expected a call to c.client.NewRequest")
+	}
+	sel, ok := call.Args[1].(*ast.SelectorExpr)
+	if !ok || !strings.HasPrefix(sel.Sel.Name, "Method") {
+		return "", "", fmt.Errorf("expected an http.Method constant")
+	}
+	path, err := pathTemplate(call.Args[2])
+	if err != nil {
+		return "", "", err
+	}
+	return strings.ToUpper(strings.TrimPrefix(sel.Sel.Name, "Method")), path, nil
+}
+
+func pathTemplate(expr ast.Expr) (string, error) {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		if e.Kind != token.STRING {
+			break
+		}
+		return strconv.Unquote(e.Value)
+	case *ast.SelectorExpr:
+		if x, ok := e.X.(*ast.Ident); ok && x.Name == "body" {
+			return "{" + e.Sel.Name + "}", nil
+		}
+	case *ast.BinaryExpr:
+		if e.Op != token.ADD {
+			break
+		}
+		x, err := pathTemplate(e.X)
+		if err != nil {
+			return "", err
+		}
+		y, err := pathTemplate(e.Y)
+		if err != nil {
+			return "", err
+		}
+		return x + y, nil
+	case *ast.CallExpr:
+		sel, ok := e.Fun.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "Sprintf" || len(e.Args) == 0 {
+			break
+		}
+		format, err := pathTemplate(e.Args[0])
+		if err != nil {
+			return "", err
+		}
+		parts := strings.Split(format, "%s")
+		if len(parts) != len(e.Args) {
+			return "", fmt.Errorf("path format %q does not match its arguments", format)
+		}
+		path := parts[0]
+		for i, arg := range e.Args[1:] {
+			param, err := pathTemplate(arg)
+			if err != nil {
+				return "", err
+			}
+			path += param + parts[i+1]
+		}
+		return path, nil
+	}
+	return "", fmt.Errorf("unsupported path expression %T", expr)
+}
+
+func exprString(fset *token.FileSet, expr ast.Expr) string {
+	var buf bytes.Buffer
+	_ = printer.Fprint(&buf, fset, expr)
+	return buf.String()
+}
+
+// SortedImports returns the import name and path pairs used by method signatures, sorted by path.
+func (a *api) SortedImports() [][2]string {
+	var out [][2]string
+	for name, path := range a.Imports {
+		out = append(out, [2]string{name, path})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i][1] < out[j][1] })
+	return out
+}
+
+// ReadOnlyClients returns the clients that have read-only methods.
+func (a *api) ReadOnlyClients() []*client {
+	var out []*client
+	for _, c := range a.Clients {
+		if len(c.ReadOnlyMethods()) > 0 {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// Module returns the module path, for use in templates.
+func (a *api) Module() string {
+	return modulePath
+}
+
+func lowerFirst(s string) string {
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+// unexported returns an unexported identifier for an exported one, lowering a leading initialism
+// as a whole: "JWTTemplates" becomes "jwtTemplates" and "SDK" becomes "sdk".
+func unexported(s string) string {
+	n := 0
+	for n < len(s) && s[n] >= 'A' && s[n] <= 'Z' {
+		n++
+	}
+	switch {
+	case n == len(s):
+		return strings.ToLower(s)
+	case n > 1:
+		n--
+	}
+	return strings.ToLower(s[:n]) + s[n:]
+}
+
+var funcs = template.FuncMap{
+	"lowerFirst": lowerFirst,
+	"unexported": unexported,
+	"methodConst": func(method string) string {
+		return method[:1] + strings.ToLower(method[1:])
+	},
+	"importSpec": func(imp [2]string) string {
+		if filepath.Base(imp[1]) == imp[0] {
+			return strconv.Quote(imp[1])
+		}
+		return imp[0] + " " + strconv.Quote(imp[1])
+	},
+}
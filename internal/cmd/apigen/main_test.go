@@ -0,0 +1,64 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneratedFilesAreUpToDate(t *testing.T) {
+	root := filepath.Join("..", "..", "..")
+	a, err := parseAPI(filepath.Join(root, "pkg", "api"))
+	require.NoError(t, err)
+
+	for _, out := range outputs {
+		t.Run(out.path, func(t *testing.T) {
+			want, err := render(out.template, a)
+			require.NoError(t, err)
+			got, err := os.ReadFile(filepath.Join(root, out.path))
+			require.NoError(t, err)
+			assert.Equal(t, string(want), string(got), "run go generate ./pkg/api")
+		})
+	}
+}
+
+func TestParseAPI(t *testing.T) {
+	a, err := parseAPI(filepath.Join("..", "..", "..", "pkg", "api"))
+	require.NoError(t, err)
+
+	var projects *client
+	for _, c := range a.Clients {
+		if c.Field == "Projects" {
+			projects = c
+		}
+	}
+	require.NotNil(t, projects)
+	assert.Equal(t, "ProjectsAPI", projects.Interface())
+
+	var names []string
+	for _, m := range projects.Methods {
+		names = append(names, m.Name)
+	}
+	assert.Equal(t, []string{"Create", "Delete", "Get", "GetAll", "Update"}, names)
+	assert.Equal(t, "projects.CreateRequest", projects.Methods[0].Request)
+	assert.Equal(t, "projects.CreateResponse", projects.Methods[0].Response)
+	assert.Equal(t, "POST", projects.Methods[0].HTTPMethod)
+	assert.Equal(t, "/pwa/v3/projects", projects.Methods[0].Path)
+	assert.Equal(t, "PATCH", projects.Methods[4].HTTPMethod)
+	assert.Equal(t, "/pwa/v3/projects/{ProjectSlug}", projects.Methods[4].Path)
+}
+
+func TestUnexported(t *testing.T) {
+	for in, want := range map[string]string{
+		"Projects":              "projects",
+		"JWTTemplates":          "jwtTemplates",
+		"SDK":                   "sdk",
+		"RBACPolicy":            "rbacPolicy",
+		"V1ToV3MigrationClient": "v1ToV3MigrationClient",
+	} {
+		assert.Equal(t, want, unexported(in), in)
+	}
+}
@@ -0,0 +1,334 @@
+package main
+
+import "text/template"
+
+var interfacesTemplate = template.Must(template.New("interfaces").Funcs(funcs).Parse(`// Code generated by apigen. DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+
+{{range .SortedImports}}	{{importSpec .}}
+{{end}})
+
+// Interface is the set of resource clients available on an API. *API implements it, as do the fakes
+// in pkg/apifake, so code written against Interface can be tested without a live workspace.
+type Interface interface {
+{{- range .Clients}}
+	{{.Interface}}() {{.Interface}}
+{{- end}}
+}
+
+var _ Interface = (*API)(nil)
+{{range .Clients}}
+// {{.Interface}} returns the {{.Field}} client.
+func (a *API) {{.Interface}}() {{.Interface}} {
+	return a.{{.Field}}
+}
+{{end}}
+{{- range .Clients}}
+// {{.Interface}} is the interface implemented by {{.Type}}.
+type {{.Interface}} interface {
+{{- range .Methods}}
+{{- range .Doc}}
+	{{.}}
+{{- end}}
+	{{.Name}}(ctx context.Context, body {{.Request}}) (*{{.Response}}, error)
+{{- end}}
+}
+
+var _ {{.Interface}} = (*{{.Type}})(nil)
+{{end}}`))
+
+var fakesTemplate = template.Must(template.New("fakes").Funcs(funcs).Parse(`// Code generated by apigen. DO NOT EDIT.
+
+package apifake
+
+import (
+	"context"
+	"sync"
+
+	"{{.Module}}/pkg/api"
+{{range .SortedImports}}	{{importSpec .}}
+{{end}})
+
+// API is a fake implementation of api.Interface. Create one with New.
+type API struct {
+	recorder *recorder
+{{range .Clients}}
+	{{.Field}} *{{.Interface}}
+{{- end}}
+}
+
+// New returns an API whose resource clients are all fakes. Calls to any of them are also recorded,
+// in order, in the log returned by Calls.
+func New() *API {
+	r := &recorder{}
+	return &API{
+		recorder: r,
+{{- range .Clients}}
+		{{.Field}}: &{{.Interface}}{recorder: r},
+{{- end}}
+	}
+}
+
+var _ api.Interface = (*API)(nil)
+{{range .Clients}}
+// {{.Interface}} implements api.Interface.
+func (a *API) {{.Interface}}() api.{{.Interface}} {
+	return a.{{.Field}}
+}
+{{end}}
+{{- range $c := .Clients}}
+// {{.Interface}} is a fake implementation of api.{{.Interface}}. Each method records its request and
+// then returns, in order of precedence, the result of the method's Func field, the response set
+// with the method's Returns function, or an empty response.
+type {{.Interface}} struct {
+	recorder *recorder
+	mu       sync.Mutex
+{{range .Methods}}
+	// {{.Name}}Func, if set, handles calls to {{.Name}}.
+	{{.Name}}Func func(ctx context.Context, body {{.Request}}) (*{{.Response}}, error)
+	{{lowerFirst .Name}}Calls   []{{.Request}}
+	{{lowerFirst .Name}}Returns *result[{{.Response}}]
+{{end -}}
+}
+
+var _ api.{{.Interface}} = (*{{.Interface}})(nil)
+{{range .Methods}}
+// {{.Name}} records the request and returns the programmed response.
+func (f *{{$c.Interface}}) {{.Name}}(ctx context.Context, body {{.Request}}) (*{{.Response}}, error) {
+	f.mu.Lock()
+	f.{{lowerFirst .Name}}Calls = append(f.{{lowerFirst .Name}}Calls, body)
+	fn, ret := f.{{.Name}}Func, f.{{lowerFirst .Name}}Returns
+	f.mu.Unlock()
+	f.recorder.record("{{$c.Field}}.{{.Name}}", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// {{.Name}}Returns programs {{.Name}} to return resp and err.
+func (f *{{$c.Interface}}) {{.Name}}Returns(resp *{{.Response}}, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.{{lowerFirst .Name}}Returns = &result[{{.Response}}]{resp: resp, err: err}
+}
+
+// {{.Name}}Calls returns the requests passed to {{.Name}}, in order.
+func (f *{{$c.Interface}}) {{.Name}}Calls() []{{.Request}} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]{{.Request}}(nil), f.{{lowerFirst .Name}}Calls...)
+}
+{{end}}
+{{- end}}`))
+
+var operationsTemplate = template.Must(template.New("operations").Funcs(funcs).Parse(`// Code generated by apigen. DO NOT EDIT.
+
+package api
+
+import "net/http"
+
+// operations lists the HTTP request sent by each resource client method, sorted by client field
+// and method name.
+var operations = []Operation{
+{{- range $c := .Clients}}
+{{- range .Methods}}
+	{Name: "{{$c.Field}}.{{.Name}}", Method: http.Method{{methodConst .HTTPMethod}}, Path: "{{.Path}}"},
+{{- end}}
+{{- end}}
+}
+`))
+
+var readOnlyTemplate = template.Must(template.New("readonly").Funcs(funcs).Parse(`// Code generated by apigen. DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+
+{{range .SortedImports}}	{{importSpec .}}
+{{end}})
+
+// ReadOnlyAPI is the set of read-only resource clients, which only have the methods that read from
+// the workspace, so that code given one cannot change anything. Create one with NewReadOnlyClient,
+// NewReadOnlyAccessTokenClient or ReadOnly.
+type ReadOnlyAPI struct {
+{{- range .ReadOnlyClients}}
+	{{.Field}} *{{.ReadOnlyType}}
+{{- end}}
+}
+
+// ReadOnly returns read-only resource clients that send their requests with client. The clients
+// restrict what code given them can call, but not what client can send; see NewReadOnlyClient.
+func ReadOnly(client Interface) *ReadOnlyAPI {
+	return &ReadOnlyAPI{
+{{- range .ReadOnlyClients}}
+		{{.Field}}: &{{.ReadOnlyType}}{client: client.{{.Interface}}()},
+{{- end}}
+	}
+}
+{{range $c := .ReadOnlyClients}}
+// {{.ReadOnlyInterface}} is the subset of {{.Interface}} that only reads from the workspace.
+type {{.ReadOnlyInterface}} interface {
+{{- range .ReadOnlyMethods}}
+{{- range .Doc}}
+	{{.}}
+{{- end}}
+	{{.Name}}(ctx context.Context, body {{.Request}}) (*{{.Response}}, error)
+{{- end}}
+}
+
+var (
+	_ {{.ReadOnlyInterface}} = (*{{.Type}})(nil)
+	_ {{.ReadOnlyInterface}} = (*{{.ReadOnlyType}})(nil)
+)
+
+// {{.ReadOnlyType}} has the methods of {{.Type}} that only read from the workspace.
+type {{.ReadOnlyType}} struct {
+	client {{.ReadOnlyInterface}}
+}
+{{range .ReadOnlyMethods}}
+{{- range .Doc}}
+{{.}}
+{{- end}}
+func (c *{{$c.ReadOnlyType}}) {{.Name}}(ctx context.Context, body {{.Request}}) (*{{.Response}}, error) {
+	return c.client.{{.Name}}(ctx, body)
+}
+{{end}}
+{{- end}}`))
+
+var scopeTemplate = template.Must(template.New("scope").Funcs(funcs).Parse(`// Code generated by apigen. DO NOT EDIT.
+
+package scope
+
+import (
+	"context"
+
+	"{{.Module}}/pkg/api"
+{{range .SortedImports}}	{{importSpec .}}
+{{end}})
+
+// clients holds the resource clients of an API, which check every call before passing it on.
+type clients struct {
+{{- range .Clients}}
+	{{unexported .Field}} *{{unexported .Type}}
+{{- end}}
+}
+
+func newClients(a *API, client api.Interface) clients {
+	return clients{
+{{- range .Clients}}
+		{{unexported .Field}}: &{{unexported .Type}}{api: a, client: client.{{.Interface}}()},
+{{- end}}
+	}
+}
+
+var _ api.Interface = (*API)(nil)
+{{range .Clients}}
+// {{.Interface}} implements api.Interface.
+func (a *API) {{.Interface}}() api.{{.Interface}} {
+	return a.{{unexported .Field}}
+}
+{{end}}
+{{- range $c := .Clients}}
+type {{unexported .Type}} struct {
+	api    *API
+	client api.{{.Interface}}
+}
+{{range .Methods}}
+func (c *{{unexported $c.Type}}) {{.Name}}(ctx context.Context, body {{.Request}}) (*{{.Response}}, error) {
+	if err := c.api.check(ctx, "{{$c.Field}}.{{.Name}}", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.{{.Name}}(ctx, body)
+	c.api.done("{{$c.Field}}.{{.Name}}", body, resp, err)
+	return resp, err
+}
+{{end}}
+{{- end}}`))
+
+var approvalTemplate = template.Must(template.New("approval").Funcs(funcs).Parse(`// Code generated by apigen. DO NOT EDIT.
+
+package approval
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"{{.Module}}/pkg/api"
+{{range .SortedImports}}	{{importSpec .}}
+{{end}})
+
+// clients holds the resource clients of an API, which submit every call that changes the workspace
+// to the queue before passing it on.
+type clients struct {
+{{- range .Clients}}
+	{{unexported .Field}} *{{unexported .Type}}
+{{- end}}
+}
+
+func newClients(a *API, client api.Interface) clients {
+	return clients{
+{{- range .Clients}}
+		{{unexported .Field}}: &{{unexported .Type}}{api: a, client: client.{{.Interface}}()},
+{{- end}}
+	}
+}
+
+var _ api.Interface = (*API)(nil)
+{{range .Clients}}
+// {{.Interface}} implements api.Interface.
+func (a *API) {{.Interface}}() api.{{.Interface}} {
+	return a.{{unexported .Field}}
+}
+{{end}}
+{{- range $c := .Clients}}
+type {{unexported .Type}} struct {
+	api    *API
+	client api.{{.Interface}}
+}
+{{range .Methods}}
+func (c *{{unexported $c.Type}}) {{.Name}}(ctx context.Context, body {{.Request}}) (*{{.Response}}, error) {
+{{- if ne .HTTPMethod "GET"}}
+	if err := c.api.submit(ctx, "{{$c.Field}}.{{.Name}}", body); err != nil {
+		return nil, err
+	}
+{{- end}}
+	return c.client.{{.Name}}(ctx, body)
+}
+{{end}}
+{{- end}}
+// decode decodes a request encoded by encodeRequest into the request type of operation.
+func decode(operation string, request json.RawMessage) (any, error) {
+	switch operation {
+{{- range $c := .Clients}}
+{{- range .Methods}}
+{{- if ne .HTTPMethod "GET"}}
+	case "{{$c.Field}}.{{.Name}}":
+		var body {{.Request}}
+		err := decodeRequest(request, &body)
+		return body, err
+{{- end}}
+{{- end}}
+{{- end}}
+	}
+	return nil, fmt.Errorf("unknown operation %s", operation)
+}
+
+// invoke passes a request returned by decode to the client method that sends operation.
+func invoke(ctx context.Context, client api.Interface, operation string, body any) (any, error) {
+	switch operation {
+{{- range $c := .Clients}}
+{{- range .Methods}}
+{{- if ne .HTTPMethod "GET"}}
+	case "{{$c.Field}}.{{.Name}}":
+		return client.{{$c.Interface}}().{{.Name}}(ctx, body.({{.Request}}))
+{{- end}}
+{{- end}}
+{{- end}}
+	}
+	return nil, fmt.Errorf("unknown operation %s", operation)
+}
+`))
@@ -0,0 +1,408 @@
+// Command modelgen generates the DeepCopy, Equal and Normalize methods for the resource types in
+// pkg/models. It is run through go generate from the pkg/models directory:
+//
+//	go generate ./pkg/models
+//
+// Each model package gets a zz_generated.go file covering every struct in its types.go that is not
+// a request or response type.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	outputFile      = "zz_generated.go"
+	modelutilImport = "github.com/stytchauth/stytch-management-go/v3/pkg/models/internal/modelutil"
+)
+
+// packages lists the model packages to generate helpers for, relative to pkg/models. The migration
+// package only holds legacy payloads and is deliberately left out.
+var packages = []string{
+	"countrycodeallowlist",
+	"emailtemplates",
+	"environments",
+	"eventlogstreaming",
+	"jwttemplates",
+	"passwordstrengthconfig",
+	"projects",
+	"publictokens",
+	"rbacpolicy",
+	"redirecturls",
+	"sdk",
+	"secrets",
+	"trustedtokenprofiles",
+}
+
+// sortKeys defines the canonical order for every struct type that appears in a slice. Normalize
+// sorts such slices by these fields, in order. Every struct used as a slice element must be listed
+// here so that adding a new list to the API forces a decision about its natural key.
+var sortKeys = map[string][]string{
+	"emailtemplates.EmailTemplate":             {"TemplateID"},
+	"environments.Environment":                 {"EnvironmentSlug"},
+	"projects.Project":                         {"ProjectSlug"},
+	"publictokens.PublicToken":                 {"PublicToken"},
+	"rbacpolicy.Permission":                    {"ResourceID"},
+	"rbacpolicy.Resource":                      {"ResourceID"},
+	"rbacpolicy.Role":                          {"RoleID"},
+	"rbacpolicy.Scope":                         {"Scope"},
+	"redirecturls.RedirectURL":                 {"URL"},
+	"redirecturls.URLType":                     {"Type"},
+	"sdk.AuthorizedB2BDomain":                  {"Domain", "SlugPattern"},
+	"sdk.SMSAutofillMetadata":                  {"MetadataType", "MetadataValue", "BundleID"},
+	"secrets.MaskedSecret":                     {"SecretID"},
+	"trustedtokenprofiles.PEMFile":             {"PEMFileID", "PublicKey"},
+	"trustedtokenprofiles.TrustedTokenProfile": {"ProfileID"},
+}
+
+func main() {
+	dir := flag.String("dir", ".", "path to the pkg/models directory")
+	flag.Parse()
+
+	for _, pkg := range packages {
+		if err := generate(filepath.Join(*dir, pkg), pkg); err != nil {
+			log.Fatalf("%s: %v", pkg, err)
+		}
+	}
+}
+
+type kind int
+
+const (
+	kindValue  kind = iota // comparable with == and copied by assignment
+	kindTime               // time.Time
+	kindStruct             // a struct type defined in the same package
+	kindPtr
+	kindSlice
+	kindAnyMap // map[string]any
+)
+
+type fieldType struct {
+	kind kind
+	name string // Go spelling of the type
+	elem *fieldType
+}
+
+type field struct {
+	name string
+	typ  *fieldType
+}
+
+type structType struct {
+	name   string
+	fields []field
+}
+
+type pkgInfo struct {
+	name    string
+	structs map[string]bool
+	types   []structType
+}
+
+func generate(dir, pkg string) error {
+	src, err := build(dir, pkg)
+	if err != nil {
+		return err
+	}
+	out := filepath.Join(dir, outputFile)
+	if src == nil {
+		// Nothing to generate; make sure a stale file from an earlier run does not linger.
+		if err := os.Remove(out); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+	return os.WriteFile(out, src, 0o644)
+}
+
+// build returns the generated source for the package in dir, or nil if the package has no resource
+// types.
+func build(dir, pkg string) ([]byte, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filepath.Join(dir, "types.go"), nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	info := pkgInfo{name: pkg, structs: map[string]bool{}}
+	var decls []*ast.TypeSpec
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			ts := spec.(*ast.TypeSpec)
+			if _, ok := ts.Type.(*ast.StructType); ok {
+				info.structs[ts.Name.Name] = true
+				decls = append(decls, ts)
+			}
+		}
+	}
+
+	for _, ts := range decls {
+		name := ts.Name.Name
+		if strings.HasSuffix(name, "Request") || strings.HasSuffix(name, "Response") {
+			continue
+		}
+		st := structType{name: name}
+		for _, fl := range ts.Type.(*ast.StructType).Fields.List {
+			typ, err := info.resolve(fl.Type)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+			for _, n := range fl.Names {
+				st.fields = append(st.fields, field{name: n.Name, typ: typ})
+			}
+		}
+		info.types = append(info.types, st)
+	}
+
+	if len(info.types) == 0 {
+		return nil, nil
+	}
+	return info.render()
+}
+
+func (p *pkgInfo) resolve(expr ast.Expr) (*fieldType, error) {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if p.structs[e.Name] {
+			return &fieldType{kind: kindStruct, name: e.Name}, nil
+		}
+		// Basic types and the string enums declared in the package.
+		return &fieldType{kind: kindValue, name: e.Name}, nil
+	case *ast.SelectorExpr:
+		if x, ok := e.X.(*ast.Ident); ok && x.Name == "time" && e.Sel.Name == "Time" {
+			return &fieldType{kind: kindTime, name: "time.Time"}, nil
+		}
+	case *ast.StarExpr:
+		elem, err := p.resolve(e.X)
+		if err != nil {
+			return nil, err
+		}
+		return &fieldType{kind: kindPtr, name: "*" + elem.name, elem: elem}, nil
+	case *ast.ArrayType:
+		if e.Len != nil {
+			break
+		}
+		elem, err := p.resolve(e.Elt)
+		if err != nil {
+			return nil, err
+		}
+		if elem.kind != kindValue && elem.kind != kindStruct {
+			break
+		}
+		if elem.kind == kindStruct {
+			if _, ok := sortKeys[p.name+"."+elem.name]; !ok {
+				return nil, fmt.Errorf("no sort key defined for %s.%s", p.name, elem.name)
+			}
+		}
+		return &fieldType{kind: kindSlice, name: "[]" + elem.name, elem: elem}, nil
+	case *ast.MapType:
+		k, kok := e.Key.(*ast.Ident)
+		v, vok := e.Value.(*ast.Ident)
+		if kok && vok && k.Name == "string" && v.Name == "any" {
+			return &fieldType{kind: kindAnyMap, name: "map[string]any"}, nil
+		}
+	}
+	return nil, fmt.Errorf("unsupported field type %T", expr)
+}
+
+type writer struct {
+	bytes.Buffer
+	imports map[string]bool
+	// compares records the struct types whose comparison function is used.
+	compares map[string]bool
+}
+
+func (w *writer) printf(format string, args ...any) {
+	fmt.Fprintf(&w.Buffer, format, args...)
+}
+
+func (p *pkgInfo) render() ([]byte, error) {
+	body := &writer{imports: map[string]bool{}, compares: map[string]bool{}}
+	for _, st := range p.types {
+		p.renderDeepCopy(body, st)
+		p.renderEqual(body, st)
+		p.renderNormalize(body, st)
+	}
+	p.renderCompare(body)
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by modelgen. DO NOT EDIT.\n\npackage %s\n\n", p.name)
+	if len(body.imports) > 0 {
+		// Standard library imports come first, then a separate group for everything else, as
+		// goimports would arrange them.
+		var std, other []string
+		for imp := range body.imports {
+			if first, _, _ := strings.Cut(imp, "/"); strings.Contains(first, ".") {
+				other = append(other, imp)
+			} else {
+				std = append(std, imp)
+			}
+		}
+		sort.Strings(std)
+		sort.Strings(other)
+		out.WriteString("import (\n")
+		for i, group := range [][]string{std, other} {
+			if i > 0 && len(std) > 0 && len(group) > 0 {
+				out.WriteString("\n")
+			}
+			for _, imp := range group {
+				fmt.Fprintf(&out, "\t%q\n", imp)
+			}
+		}
+		out.WriteString(")\n\n")
+	}
+	out.Write(body.Bytes())
+	return format.Source(out.Bytes())
+}
+
+func receiver(name string) string {
+	return strings.ToLower(name[:1])
+}
+
+func (p *pkgInfo) renderDeepCopy(w *writer, st structType) {
+	r := receiver(st.name)
+	w.printf("// DeepCopy returns a copy of %s that shares no memory with it.\n", r)
+	w.printf("func (%s %s) DeepCopy() %s {\n", r, st.name, st.name)
+	w.printf("out := %s\n", r)
+	for _, f := range st.fields {
+		in := r + "." + f.name
+		dst := "out." + f.name
+		switch f.typ.kind {
+		case kindStruct:
+			w.printf("%s = %s.DeepCopy()\n", dst, in)
+		case kindPtr:
+			if f.typ.elem.kind == kindAnyMap {
+				w.imports[modelutilImport] = true
+				w.printf("%s = modelutil.CopyMap(%s)\n", dst, in)
+				break
+			}
+			w.printf("if %s != nil {\n", in)
+			if f.typ.elem.kind == kindStruct {
+				w.printf("v := %s.DeepCopy()\n", in)
+			} else {
+				w.printf("v := *%s\n", in)
+			}
+			w.printf("%s = &v\n}\n", dst)
+		case kindSlice:
+			w.printf("if %s != nil {\n", in)
+			w.printf("%s = make(%s, len(%s))\n", dst, f.typ.name, in)
+			if f.typ.elem.kind == kindStruct {
+				w.printf("for i := range %s {\n%s[i] = %s[i].DeepCopy()\n}\n", in, dst, in)
+			} else {
+				w.printf("copy(%s, %s)\n", dst, in)
+			}
+			w.printf("}\n")
+		}
+	}
+	w.printf("return out\n}\n\n")
+}
+
+func (p *pkgInfo) renderEqual(w *writer, st structType) {
+	r := receiver(st.name)
+	w.printf("// Equal reports whether %s and other hold the same values. Nil and empty lists are considered\n", r)
+	w.printf("// equal. The order of lists is significant; call Normalize on both values first to compare lists\n")
+	w.printf("// regardless of order.\n")
+	w.printf("func (%s %s) Equal(other %s) bool {\n", r, st.name, st.name)
+	for _, f := range st.fields {
+		a := r + "." + f.name
+		b := "other." + f.name
+		switch f.typ.kind {
+		case kindValue:
+			w.printf("if %s != %s {\nreturn false\n}\n", a, b)
+		case kindTime:
+			w.printf("if !%s.Equal(%s) {\nreturn false\n}\n", a, b)
+		case kindStruct:
+			w.printf("if !%s.Equal(%s) {\nreturn false\n}\n", a, b)
+		case kindPtr:
+			switch f.typ.elem.kind {
+			case kindAnyMap:
+				w.imports[modelutilImport] = true
+				w.printf("if !modelutil.EqualMap(%s, %s) {\nreturn false\n}\n", a, b)
+			case kindStruct:
+				w.printf("if (%s == nil) != (%s == nil) || (%s != nil && !%s.Equal(*%s)) {\nreturn false\n}\n", a, b, a, a, b)
+			default:
+				w.printf("if (%s == nil) != (%s == nil) || (%s != nil && *%s != *%s) {\nreturn false\n}\n", a, b, a, a, b)
+			}
+		case kindSlice:
+			w.printf("if len(%s) != len(%s) {\nreturn false\n}\n", a, b)
+			w.printf("for i := range %s {\n", a)
+			if f.typ.elem.kind == kindStruct {
+				w.printf("if !%s[i].Equal(%s[i]) {\nreturn false\n}\n", a, b)
+			} else {
+				w.printf("if %s[i] != %s[i] {\nreturn false\n}\n", a, b)
+			}
+			w.printf("}\n")
+		}
+	}
+	w.printf("return true\n}\n\n")
+}
+
+func (p *pkgInfo) renderNormalize(w *writer, st structType) {
+	r := receiver(st.name)
+	w.printf("// Normalize puts %s into a canonical form in place: lists are sorted by their natural key and\n", r)
+	w.printf("// empty lists and maps are replaced with nil. Two values that differ only in list order or in\n")
+	w.printf("// nil versus empty lists are Equal after normalization.\n")
+	w.printf("func (%s *%s) Normalize() {\n", r, st.name)
+	for _, f := range st.fields {
+		v := r + "." + f.name
+		switch f.typ.kind {
+		case kindStruct:
+			w.printf("%s.Normalize()\n", v)
+		case kindPtr:
+			switch f.typ.elem.kind {
+			case kindStruct:
+				w.printf("if %s != nil {\n%s.Normalize()\n}\n", v, v)
+			case kindAnyMap:
+				w.imports[modelutilImport] = true
+				w.printf("%s = modelutil.NormalizeMap(%s)\n", v, v)
+			}
+		case kindSlice:
+			w.printf("if len(%s) == 0 {\n%s = nil\n}", v, v)
+			w.imports["slices"] = true
+			if f.typ.elem.kind == kindStruct {
+				w.printf(" else {\nfor i := range %s {\n%s[i].Normalize()\n}\n", v, v)
+				w.printf("slices.SortStableFunc(%s, compare%s)\n}\n", v, f.typ.elem.name)
+				w.compares[f.typ.elem.name] = true
+			} else {
+				w.printf(" else {\nslices.Sort(%s)\n}\n", v)
+			}
+		}
+	}
+	w.printf("}\n\n")
+}
+
+// renderCompare writes the comparison functions used to sort slices of structs in Normalize. It
+// must run after the Normalize methods are rendered.
+func (p *pkgInfo) renderCompare(w *writer) {
+	for _, st := range p.types {
+		if !w.compares[st.name] {
+			continue
+		}
+		keys := sortKeys[p.name+"."+st.name]
+		w.imports["cmp"] = true
+		w.printf("func compare%s(a, b %s) int {\n", st.name, st.name)
+		for i, k := range keys {
+			if i == len(keys)-1 {
+				w.printf("return cmp.Compare(a.%s, b.%s)\n", k, k)
+			} else {
+				w.printf("if c := cmp.Compare(a.%s, b.%s); c != 0 {\nreturn c\n}\n", k, k)
+			}
+		}
+		w.printf("}\n\n")
+	}
+}
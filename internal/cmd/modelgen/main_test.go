@@ -0,0 +1,54 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneratedFilesAreUpToDate(t *testing.T) {
+	for _, pkg := range packages {
+		t.Run(pkg, func(t *testing.T) {
+			dir := filepath.Join("..", "..", "..", "pkg", "models", pkg)
+			want, err := build(dir, pkg)
+			require.NoError(t, err)
+
+			got, err := os.ReadFile(filepath.Join(dir, outputFile))
+			if want == nil {
+				assert.True(t, os.IsNotExist(err), "%s should not have a generated file", pkg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, string(want), string(got), "run go generate ./pkg/models")
+		})
+	}
+}
+
+func TestBuildOmitsUnusedComparators(t *testing.T) {
+	// Arrange
+	dir := filepath.Join("..", "..", "..", "pkg", "models", "trustedtokenprofiles")
+
+	// Act
+	src, err := build(dir, "trustedtokenprofiles")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, string(src), "func comparePEMFile(")
+	assert.NotContains(t, string(src), "func compareTrustedTokenProfile(",
+		"TrustedTokenProfile lists are only held by response types, which get no Normalize method")
+}
+
+func TestBuildGroupsImports(t *testing.T) {
+	// Arrange
+	dir := filepath.Join("..", "..", "..", "pkg", "models", "trustedtokenprofiles")
+
+	// Act
+	src, err := build(dir, "trustedtokenprofiles")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, string(src), "import (\n\t\"cmp\"\n\t\"slices\"\n\n\t\""+modelutilImport+"\"\n)\n")
+}
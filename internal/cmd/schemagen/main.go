@@ -0,0 +1,274 @@
+// Command schemagen generates the JSON Schema of the snapshot document, pkg/schema/snapshot.schema.json,
+// from the snapshot and pkg/models types. It is run through go generate from the pkg/schema
+// directory:
+//
+//	go generate ./pkg/schema
+//
+// Field descriptions are taken from the doc comments of the Go fields, and enumerated string types
+// are constrained to the values returned by their list functions, such as
+// redirecturls.RedirectURLTypes.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/emailtemplates"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/environments"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/eventlogstreaming"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/jwttemplates"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/passwordstrengthconfig"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/redirecturls"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/sdk"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/trustedtokenprofiles"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/schema"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/snapshot"
+)
+
+const (
+	modulePath = "github.com/stytchauth/stytch-management-go/v3"
+	outputFile = "pkg/schema/snapshot.schema.json"
+)
+
+// enums maps each enumerated string type to its allowed values. Every list function in pkg/models
+// must be listed here, so that a new enum is constrained as soon as it is added to a model.
+var enums = map[reflect.Type][]any{}
+
+func init() {
+	enum(emailtemplates.FontFamilies())
+	enum(emailtemplates.TemplateTypes())
+	enum(emailtemplates.TextAlignments())
+	enum(environments.EnvironmentTypes())
+	enum(eventlogstreaming.DatadogSites())
+	enum(eventlogstreaming.DestinationTypes())
+	enum(eventlogstreaming.StreamingStatuss())
+	enum(jwttemplates.JWTTemplateTypes())
+	enum(passwordstrengthconfig.ValidationPolicys())
+	enum(projects.Verticals())
+	enum(redirecturls.RedirectURLTypes())
+	enum(sdk.B2BCookiesConfigHttpOnlys())
+	enum(sdk.ConsumerCookiesConfigHttpOnlys())
+	enum(sdk.DFPPAOnChallengeActions())
+	enum(sdk.DFPPASettings())
+	enum(sdk.SMSAutofillMetadataMetadataTypes())
+	enum(trustedtokenprofiles.PublicKeyTypes())
+}
+
+func enum[T ~string](values []T) {
+	typ := reflect.TypeOf(values).Elem()
+	for _, v := range values {
+		enums[typ] = append(enums[typ], string(v))
+	}
+}
+
+func main() {
+	root := flag.String("root", ".", "root directory of the module")
+	flag.Parse()
+
+	out, err := build(*root)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(*root, outputFile), out, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// build returns the contents of the schema file.
+func build(root string) ([]byte, error) {
+	g := &generator{root: root, docs: map[string]string{}, parsed: map[string]bool{}, defs: map[string]*schema.Schema{}}
+	s, err := g.schema(reflect.TypeOf(snapshot.Snapshot{}))
+	if err != nil {
+		return nil, err
+	}
+	s.Schema = schema.Draft
+	s.Title = "Stytch configuration snapshot"
+	s.Defs = g.defs
+
+	doc := g.defs["snapshot.Snapshot"]
+	doc.Required = []string{"version"}
+	doc.Properties["version"].Const = snapshot.FormatVersion
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(s); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+type generator struct {
+	root string
+	// docs maps "pkgpath.Type" and "pkgpath.Type.Field" to their doc comments.
+	docs   map[string]string
+	parsed map[string]bool
+	defs   map[string]*schema.Schema
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+func (g *generator) schema(t reflect.Type) (*schema.Schema, error) {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if values, ok := enums[t]; ok {
+		return &schema.Schema{Type: "string", Enum: values}, nil
+	}
+	if t == timeType {
+		return &schema.Schema{Type: "string", Format: "date-time"}, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		return g.ref(t)
+	case reflect.Slice, reflect.Array:
+		items, err := g.schema(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &schema.Schema{Type: "array", Items: items}, nil
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("%s: map keys must be strings", t)
+		}
+		s := &schema.Schema{Type: "object"}
+		if values, ok := enums[t.Key()]; ok {
+			s.PropertyNames = &schema.Schema{Enum: values}
+		}
+		if t.Elem().Kind() != reflect.Interface {
+			elem, err := g.schema(t.Elem())
+			if err != nil {
+				return nil, err
+			}
+			s.AdditionalProperties = elem
+		}
+		return s, nil
+	case reflect.Interface:
+		return &schema.Schema{}, nil
+	case reflect.String:
+		if t.PkgPath() != "" {
+			return nil, fmt.Errorf("%s: enumerated type has no list of values; add it to enums", t)
+		}
+		return &schema.Schema{Type: "string"}, nil
+	case reflect.Bool:
+		return &schema.Schema{Type: "boolean"}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return &schema.Schema{Type: "integer"}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		zero := 0.0
+		return &schema.Schema{Type: "integer", Minimum: &zero}, nil
+	case reflect.Float32, reflect.Float64:
+		return &schema.Schema{Type: "number"}, nil
+	}
+	return nil, fmt.Errorf("%s: unsupported kind %s", t, t.Kind())
+}
+
+// ref adds the schema of a struct type to the definitions and returns a reference to it.
+func (g *generator) ref(t reflect.Type) (*schema.Schema, error) {
+	name := path.Base(t.PkgPath()) + "." + t.Name()
+	ref := &schema.Schema{Ref: "#/$defs/" + name}
+	if _, ok := g.defs[name]; ok {
+		return ref, nil
+	}
+	if err := g.parse(t.PkgPath()); err != nil {
+		return nil, err
+	}
+	def := &schema.Schema{
+		Type:                 "object",
+		Description:          g.docs[t.PkgPath()+"."+t.Name()],
+		Properties:           map[string]*schema.Schema{},
+		AdditionalProperties: schema.False,
+	}
+	// Add the definition before its fields so that recursive types terminate.
+	g.defs[name] = def
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		jsonName, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		switch jsonName {
+		case "-":
+			continue
+		case "":
+			jsonName = f.Name
+		}
+		prop, err := g.schema(f.Type)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", name, f.Name, err)
+		}
+		prop.Description = g.docs[t.PkgPath()+"."+t.Name()+"."+f.Name]
+		def.Properties[jsonName] = prop
+	}
+	return ref, nil
+}
+
+// parse reads the doc comments of the types and fields in a package of this module.
+func (g *generator) parse(pkgPath string) error {
+	if g.parsed[pkgPath] {
+		return nil
+	}
+	g.parsed[pkgPath] = true
+	rel, ok := strings.CutPrefix(pkgPath, modulePath+"/")
+	if !ok {
+		return fmt.Errorf("%s: not a package of this module", pkgPath)
+	}
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, filepath.Join(g.root, rel), func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+	if err != nil {
+		return err
+	}
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				gen, ok := decl.(*ast.GenDecl)
+				if !ok || gen.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range gen.Specs {
+					ts := spec.(*ast.TypeSpec)
+					doc := ts.Doc
+					if doc == nil && len(gen.Specs) == 1 {
+						doc = gen.Doc
+					}
+					g.docs[pkgPath+"."+ts.Name.Name] = text(doc)
+					st, ok := ts.Type.(*ast.StructType)
+					if !ok {
+						continue
+					}
+					for _, field := range st.Fields.List {
+						for _, name := range field.Names {
+							g.docs[pkgPath+"."+ts.Name.Name+"."+name.Name] = text(field.Doc)
+						}
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// text returns a doc comment as a single line.
+func text(doc *ast.CommentGroup) string {
+	if doc == nil {
+		return ""
+	}
+	return strings.Join(strings.Fields(doc.Text()), " ")
+}
@@ -0,0 +1,20 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneratedFileIsUpToDate(t *testing.T) {
+	root := filepath.Join("..", "..", "..")
+	want, err := build(root)
+	require.NoError(t, err)
+
+	got, err := os.ReadFile(filepath.Join(root, outputFile))
+	require.NoError(t, err)
+	assert.Equal(t, string(want), string(got), "run go generate ./pkg/schema")
+}
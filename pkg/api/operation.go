@@ -0,0 +1,75 @@
+package api
+
+import (
+	"net/http"
+	"strings"
+)
+
+// Operation describes the HTTP request sent by a resource client method.
+type Operation struct {
+	// Name is the API field and method name, such as "Projects.Create".
+	Name string
+	// Method is the HTTP method, such as http.MethodPost.
+	Method string
+	// Path is the request path with its parameters in braces, named after the request fields they
+	// are taken from, such as "/pwa/v3/projects/{ProjectSlug}/environments/{EnvironmentSlug}".
+	Path string
+}
+
+// ReadOnly reports whether the operation only reads from the workspace.
+func (o Operation) ReadOnly() bool {
+	return o.Method == http.MethodGet
+}
+
+// Operations returns the operations sent by every resource client method, sorted by name.
+func Operations() []Operation {
+	return append([]Operation(nil), operations...)
+}
+
+// MatchOperation returns the operation that sends requests with the given method and path, along
+// with the values of its path parameters. The path is relative to the base URI, without a query
+// string. When several operations match, the one with the most literal path segments wins, so
+// "/redirect_urls/all" matches RedirectURLs.GetAll rather than a parameter.
+func MatchOperation(method, path string) (Operation, map[string]string, bool) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	var (
+		best       Operation
+		bestParams map[string]string
+		bestScore  = -1
+	)
+	for _, op := range operations {
+		if op.Method != method {
+			continue
+		}
+		params, score, ok := matchPath(op.Path, segments)
+		if ok && score > bestScore {
+			best, bestParams, bestScore = op, params, score
+		}
+	}
+	return best, bestParams, bestScore >= 0
+}
+
+// matchPath matches segments against a path template and returns the path parameters and the
+// number of literal segments.
+func matchPath(template string, segments []string) (map[string]string, int, bool) {
+	parts := strings.Split(strings.Trim(template, "/"), "/")
+	if len(parts) != len(segments) {
+		return nil, 0, false
+	}
+	params := map[string]string{}
+	literals := 0
+	for i, part := range parts {
+		if strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}") {
+			if segments[i] == "" {
+				return nil, 0, false
+			}
+			params[part[1:len(part)-1]] = segments[i]
+			continue
+		}
+		if part != segments[i] {
+			return nil, 0, false
+		}
+		literals++
+	}
+	return params, literals, true
+}
@@ -0,0 +1,77 @@
+package api_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
+)
+
+func TestMatchOperation(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		method     string
+		path       string
+		wantName   string
+		wantParams map[string]string
+	}{
+		{
+			name:       "literal path",
+			method:     http.MethodPost,
+			path:       "/pwa/v3/projects",
+			wantName:   "Projects.Create",
+			wantParams: map[string]string{},
+		},
+		{
+			name:       "path parameters",
+			method:     http.MethodDelete,
+			path:       "/pwa/v3/projects/my-project/environments/test/secrets/secret-123",
+			wantName:   "Secrets.Delete",
+			wantParams: map[string]string{"ProjectSlug": "my-project", "EnvironmentSlug": "test", "SecretID": "secret-123"},
+		},
+		{
+			name:       "prefers literal segments",
+			method:     http.MethodGet,
+			path:       "/pwa/v3/projects/my-project/environments/test/redirect_urls/all",
+			wantName:   "RedirectURLs.GetAll",
+			wantParams: map[string]string{"ProjectSlug": "my-project", "EnvironmentSlug": "test"},
+		},
+		{
+			name:     "unknown path",
+			method:   http.MethodGet,
+			path:     "/pwa/v3/unknown",
+			wantName: "",
+		},
+		{
+			name:     "wrong method",
+			method:   http.MethodPost,
+			path:     "/pwa/v3/projects/my-project",
+			wantName: "",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			op, params, ok := api.MatchOperation(tc.method, tc.path)
+
+			// Assert
+			assert.Equal(t, tc.wantName != "", ok)
+			assert.Equal(t, tc.wantName, op.Name)
+			assert.Equal(t, tc.wantParams, params)
+		})
+	}
+}
+
+func TestOperations(t *testing.T) {
+	// Act
+	ops := api.Operations()
+
+	// Assert
+	names := map[string]bool{}
+	for _, op := range ops {
+		assert.False(t, names[op.Name], "duplicate operation %s", op.Name)
+		names[op.Name] = true
+		assert.Equal(t, op.Method == http.MethodGet, op.ReadOnly(), op.Name)
+	}
+	assert.True(t, names["Projects.Create"])
+}
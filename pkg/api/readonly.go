@@ -0,0 +1,64 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrReadOnly is returned, wrapped, for requests that a read-only client refuses to send.
+var ErrReadOnly = errors.New("read-only client")
+
+// NewReadOnlyClient creates a client with the given workspace key ID and secret whose resource
+// clients only have the methods that read from the workspace, for dashboards and reporting jobs
+// that must never change anything. As a second line of defense, the HTTP client it is configured
+// with is wrapped in a ReadOnlyTransport, so that it cannot send anything but GET requests either.
+func NewReadOnlyClient(workspaceKeyID string, workspaceKeySecret string, opts ...APIOption) *ReadOnlyAPI {
+	return ReadOnly(NewClient(workspaceKeyID, workspaceKeySecret, readOnlyOptions(opts)...))
+}
+
+// NewReadOnlyAccessTokenClient creates a read-only client with an access token. See
+// NewReadOnlyClient.
+func NewReadOnlyAccessTokenClient(accessToken string, opts ...APIOption) *ReadOnlyAPI {
+	return ReadOnly(NewAccessTokenClient(accessToken, readOnlyOptions(opts)...))
+}
+
+// readOnlyOptions appends an option that replaces the configured HTTP client with a copy whose
+// transport is wrapped in a ReadOnlyTransport. The configured client is left as it is, since it may
+// be shared.
+func readOnlyOptions(opts []APIOption) []APIOption {
+	c := apiConfig{httpClient: &http.Client{}}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	httpClient := *c.httpClient
+	httpClient.Transport = &ReadOnlyTransport{Base: httpClient.Transport}
+	return append(append([]APIOption(nil), opts...), WithHTTPClient(&httpClient))
+}
+
+// ReadOnlyTransport is an http.RoundTripper that refuses to send requests that are not GET
+// requests, which are the only requests sent by read-only operations (see Operation.ReadOnly), and
+// sends the others with Base.
+type ReadOnlyTransport struct {
+	// Base sends the requests. If nil, http.DefaultTransport is used.
+	Base http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *ReadOnlyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		if req.Body != nil {
+			_ = req.Body.Close()
+		}
+		desc := req.Method + " " + req.URL.Path
+		if op, _, ok := MatchOperation(req.Method, req.URL.Path); ok {
+			desc = op.Name
+		}
+		return nil, fmt.Errorf("%w: refusing to send %s", ErrReadOnly, desc)
+	}
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return base.RoundTrip(req)
+}
@@ -0,0 +1,121 @@
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/apifake"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
+)
+
+func TestReadOnlyAPI(t *testing.T) {
+	// Arrange
+	ops := map[string]api.Operation{}
+	for _, op := range api.Operations() {
+		ops[op.Name] = op
+	}
+	readOnly := reflect.TypeOf(api.ReadOnlyAPI{})
+
+	for i := 0; i < readOnly.NumField(); i++ {
+		field := readOnly.Field(i)
+		t.Run(field.Name, func(t *testing.T) {
+			// Act
+			var names []string
+			for j := 0; j < field.Type.NumMethod(); j++ {
+				names = append(names, field.Type.Method(j).Name)
+			}
+
+			// Assert
+			require.NotEmpty(t, names)
+			for _, name := range names {
+				op, ok := ops[field.Name+"."+name]
+				if assert.True(t, ok, name) {
+					assert.True(t, op.ReadOnly(), name)
+				}
+			}
+		})
+	}
+}
+
+func TestNewReadOnlyClient(t *testing.T) {
+	ctx := context.Background()
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		_, _ = w.Write([]byte(`{"project": {"project_slug": "my-project"}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	t.Run("reads", func(t *testing.T) {
+		// Arrange
+		requests = nil
+		client := api.NewReadOnlyClient("key-id", "key-secret", api.WithBaseURI(server.URL))
+
+		// Act
+		resp, err := client.Projects.Get(ctx, projects.GetRequest{ProjectSlug: "my-project"})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "my-project", resp.Project.ProjectSlug)
+		assert.Equal(t, []string{"GET /pwa/v3/projects/my-project"}, requests)
+	})
+
+	t.Run("does not change the configured HTTP client", func(t *testing.T) {
+		// Arrange
+		requests = nil
+		httpClient := server.Client()
+		transport := httpClient.Transport
+
+		// Act
+		client := api.NewReadOnlyAccessTokenClient("access-token",
+			api.WithBaseURI(server.URL), api.WithHTTPClient(httpClient))
+		_, err := client.Projects.GetAll(ctx, projects.GetAllRequest{})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Same(t, transport, httpClient.Transport)
+		assert.Equal(t, []string{"GET /pwa/v3/projects"}, requests)
+	})
+}
+
+func TestReadOnlyTransport(t *testing.T) {
+	// Arrange
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+	client := api.NewClient("key-id", "key-secret",
+		api.WithBaseURI(server.URL),
+		api.WithHTTPClient(&http.Client{Transport: &api.ReadOnlyTransport{Base: server.Client().Transport}}))
+
+	// Act
+	_, err := client.Projects.Delete(context.Background(), projects.DeleteRequest{ProjectSlug: "my-project"})
+
+	// Assert
+	require.ErrorIs(t, err, api.ErrReadOnly)
+	assert.Contains(t, err.Error(), "read-only client: refusing to send Projects.Delete")
+	assert.Empty(t, requests)
+}
+
+func TestReadOnly(t *testing.T) {
+	// Arrange
+	fake := apifake.New()
+	fake.Projects.GetReturns(&projects.GetResponse{Project: projects.Project{ProjectSlug: "my-project"}}, nil)
+	client := api.ReadOnly(fake)
+
+	// Act
+	resp, err := client.Projects.Get(context.Background(), projects.GetRequest{ProjectSlug: "my-project"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "my-project", resp.Project.ProjectSlug)
+	assert.Len(t, fake.Projects.GetCalls(), 1)
+}
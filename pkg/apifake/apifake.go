@@ -0,0 +1,86 @@
+// Package apifake provides in-memory fakes of the resource clients in pkg/api, for testing code
+// written against api.Interface without a live workspace.
+//
+// Each fake method records the request it was called with and returns a programmable response:
+//
+//	fake := apifake.New()
+//	fake.Projects.GetReturns(&projects.GetResponse{Project: projects.Project{ProjectSlug: "p"}}, nil)
+//	fake.Secrets.DeleteFunc = func(ctx context.Context, body secrets.DeleteRequest) (*secrets.DeleteResponse, error) {
+//		return nil, errors.New("boom")
+//	}
+//
+//	runCodeUnderTest(fake)
+//
+//	calls := fake.Projects.GetCalls()
+//
+// The fakes hold no state beyond what they are programmed with: a Create followed by a Get does not
+// return the created resource unless the test arranges for it.
+package apifake
+
+import (
+	"context"
+	"sync"
+)
+
+// Call is a single recorded call to a fake resource client.
+type Call struct {
+	// Operation is the client field and method name, such as "Projects.Create".
+	Operation string
+	// Request is the request value passed to the method.
+	Request any
+}
+
+// Calls returns every call made to the resource clients of a, in order.
+func (a *API) Calls() []Call {
+	return a.recorder.calls()
+}
+
+type recorder struct {
+	mu  sync.Mutex
+	log []Call
+}
+
+// record appends a call to the log. Fakes created without New have no recorder, which is fine.
+func (r *recorder) record(operation string, request any) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.log = append(r.log, Call{Operation: operation, Request: request})
+}
+
+func (r *recorder) calls() []Call {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Call(nil), r.log...)
+}
+
+type result[Resp any] struct {
+	resp *Resp
+	err  error
+}
+
+// respond returns the response for a fake call. A canceled context fails the call the same way it
+// would fail a real request.
+func respond[Req, Resp any](
+	ctx context.Context,
+	body Req,
+	fn func(context.Context, Req) (*Resp, error),
+	ret *result[Resp],
+) (*Resp, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	switch {
+	case fn != nil:
+		return fn(ctx, body)
+	case ret != nil:
+		return ret.resp, ret.err
+	default:
+		return new(Resp), nil
+	}
+}
@@ -0,0 +1,84 @@
+package apifake_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/apifake"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/secrets"
+)
+
+// deleteSecret stands in for application code written against api.Interface.
+func deleteSecret(ctx context.Context, client api.Interface, projectSlug, secretID string) error {
+	_, err := client.SecretsAPI().Delete(ctx, secrets.DeleteRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: "production",
+		SecretID:        secretID,
+	})
+	return err
+}
+
+func TestAPI(t *testing.T) {
+	t.Run("records calls", func(t *testing.T) {
+		// Arrange
+		fake := apifake.New()
+		ctx := context.Background()
+
+		// Act
+		err := deleteSecret(ctx, fake, "project", "secret-1")
+		require.NoError(t, err)
+		_, err = fake.ProjectsAPI().GetAll(ctx, projects.GetAllRequest{})
+		require.NoError(t, err)
+
+		// Assert
+		assert.Equal(t, []secrets.DeleteRequest{{
+			ProjectSlug:     "project",
+			EnvironmentSlug: "production",
+			SecretID:        "secret-1",
+		}}, fake.Secrets.DeleteCalls())
+		calls := fake.Calls()
+		require.Len(t, calls, 2)
+		assert.Equal(t, "Secrets.Delete", calls[0].Operation)
+		assert.Equal(t, "Projects.GetAll", calls[1].Operation)
+	})
+
+	t.Run("returns programmed responses", func(t *testing.T) {
+		// Arrange
+		fake := apifake.New()
+		ctx := context.Background()
+		fake.Projects.GetReturns(&projects.GetResponse{Project: projects.Project{Name: "From Returns"}}, nil)
+		boom := errors.New("boom")
+		fake.Secrets.DeleteFunc = func(context.Context, secrets.DeleteRequest) (*secrets.DeleteResponse, error) {
+			return nil, boom
+		}
+
+		// Act
+		resp, err := fake.Projects.Get(ctx, projects.GetRequest{ProjectSlug: "project"})
+		deleteErr := deleteSecret(ctx, fake, "project", "secret-1")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "From Returns", resp.Project.Name)
+		assert.ErrorIs(t, deleteErr, boom)
+	})
+
+	t.Run("defaults to an empty response", func(t *testing.T) {
+		fake := &apifake.ProjectsAPI{}
+		resp, err := fake.GetAll(context.Background(), projects.GetAllRequest{})
+		require.NoError(t, err)
+		assert.Empty(t, resp.Projects)
+	})
+
+	t.Run("honors context cancellation", func(t *testing.T) {
+		fake := apifake.New()
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := fake.Projects.GetAll(ctx, projects.GetAllRequest{})
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
@@ -0,0 +1,2077 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package apifake
+
+import (
+	"context"
+	"sync"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/countrycodeallowlist"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/emailtemplates"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/environments"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/eventlogstreaming"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/jwttemplates"
+	migrationprojects "github.com/stytchauth/stytch-management-go/v3/pkg/models/migration/projects"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/passwordstrengthconfig"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/publictokens"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/rbacpolicy"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/redirecturls"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/sdk"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/secrets"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/trustedtokenprofiles"
+)
+
+// API is a fake implementation of api.Interface. Create one with New.
+type API struct {
+	recorder *recorder
+
+	CountryCodeAllowlist   *CountryCodeAllowlistAPI
+	EmailTemplates         *EmailTemplatesAPI
+	Environments           *EnvironmentsAPI
+	EventLogStreaming      *EventLogStreamingAPI
+	JWTTemplates           *JWTTemplatesAPI
+	PasswordStrengthConfig *PasswordStrengthConfigAPI
+	Projects               *ProjectsAPI
+	PublicTokens           *PublicTokensAPI
+	RBACPolicy             *RBACPolicyAPI
+	RedirectURLs           *RedirectURLsAPI
+	SDK                    *SDKAPI
+	Secrets                *SecretsAPI
+	TrustedTokenProfiles   *TrustedTokenProfilesAPI
+	V1ToV3MigrationClient  *V1ToV3MigrationAPI
+}
+
+// New returns an API whose resource clients are all fakes. Calls to any of them are also recorded,
+// in order, in the log returned by Calls.
+func New() *API {
+	r := &recorder{}
+	return &API{
+		recorder:               r,
+		CountryCodeAllowlist:   &CountryCodeAllowlistAPI{recorder: r},
+		EmailTemplates:         &EmailTemplatesAPI{recorder: r},
+		Environments:           &EnvironmentsAPI{recorder: r},
+		EventLogStreaming:      &EventLogStreamingAPI{recorder: r},
+		JWTTemplates:           &JWTTemplatesAPI{recorder: r},
+		PasswordStrengthConfig: &PasswordStrengthConfigAPI{recorder: r},
+		Projects:               &ProjectsAPI{recorder: r},
+		PublicTokens:           &PublicTokensAPI{recorder: r},
+		RBACPolicy:             &RBACPolicyAPI{recorder: r},
+		RedirectURLs:           &RedirectURLsAPI{recorder: r},
+		SDK:                    &SDKAPI{recorder: r},
+		Secrets:                &SecretsAPI{recorder: r},
+		TrustedTokenProfiles:   &TrustedTokenProfilesAPI{recorder: r},
+		V1ToV3MigrationClient:  &V1ToV3MigrationAPI{recorder: r},
+	}
+}
+
+var _ api.Interface = (*API)(nil)
+
+// CountryCodeAllowlistAPI implements api.Interface.
+func (a *API) CountryCodeAllowlistAPI() api.CountryCodeAllowlistAPI {
+	return a.CountryCodeAllowlist
+}
+
+// EmailTemplatesAPI implements api.Interface.
+func (a *API) EmailTemplatesAPI() api.EmailTemplatesAPI {
+	return a.EmailTemplates
+}
+
+// EnvironmentsAPI implements api.Interface.
+func (a *API) EnvironmentsAPI() api.EnvironmentsAPI {
+	return a.Environments
+}
+
+// EventLogStreamingAPI implements api.Interface.
+func (a *API) EventLogStreamingAPI() api.EventLogStreamingAPI {
+	return a.EventLogStreaming
+}
+
+// JWTTemplatesAPI implements api.Interface.
+func (a *API) JWTTemplatesAPI() api.JWTTemplatesAPI {
+	return a.JWTTemplates
+}
+
+// PasswordStrengthConfigAPI implements api.Interface.
+func (a *API) PasswordStrengthConfigAPI() api.PasswordStrengthConfigAPI {
+	return a.PasswordStrengthConfig
+}
+
+// ProjectsAPI implements api.Interface.
+func (a *API) ProjectsAPI() api.ProjectsAPI {
+	return a.Projects
+}
+
+// PublicTokensAPI implements api.Interface.
+func (a *API) PublicTokensAPI() api.PublicTokensAPI {
+	return a.PublicTokens
+}
+
+// RBACPolicyAPI implements api.Interface.
+func (a *API) RBACPolicyAPI() api.RBACPolicyAPI {
+	return a.RBACPolicy
+}
+
+// RedirectURLsAPI implements api.Interface.
+func (a *API) RedirectURLsAPI() api.RedirectURLsAPI {
+	return a.RedirectURLs
+}
+
+// SDKAPI implements api.Interface.
+func (a *API) SDKAPI() api.SDKAPI {
+	return a.SDK
+}
+
+// SecretsAPI implements api.Interface.
+func (a *API) SecretsAPI() api.SecretsAPI {
+	return a.Secrets
+}
+
+// TrustedTokenProfilesAPI implements api.Interface.
+func (a *API) TrustedTokenProfilesAPI() api.TrustedTokenProfilesAPI {
+	return a.TrustedTokenProfiles
+}
+
+// V1ToV3MigrationAPI implements api.Interface.
+func (a *API) V1ToV3MigrationAPI() api.V1ToV3MigrationAPI {
+	return a.V1ToV3MigrationClient
+}
+
+// CountryCodeAllowlistAPI is a fake implementation of api.CountryCodeAllowlistAPI. Each method records its request and
+// then returns, in order of precedence, the result of the method's Func field, the response set
+// with the method's Returns function, or an empty response.
+type CountryCodeAllowlistAPI struct {
+	recorder *recorder
+	mu       sync.Mutex
+
+	// GetAllowedSMSCountryCodesFunc, if set, handles calls to GetAllowedSMSCountryCodes.
+	GetAllowedSMSCountryCodesFunc    func(ctx context.Context, body countrycodeallowlist.GetAllowedSMSCountryCodesRequest) (*countrycodeallowlist.GetAllowedSMSCountryCodesResponse, error)
+	getAllowedSMSCountryCodesCalls   []countrycodeallowlist.GetAllowedSMSCountryCodesRequest
+	getAllowedSMSCountryCodesReturns *result[countrycodeallowlist.GetAllowedSMSCountryCodesResponse]
+
+	// GetAllowedWhatsAppCountryCodesFunc, if set, handles calls to GetAllowedWhatsAppCountryCodes.
+	GetAllowedWhatsAppCountryCodesFunc    func(ctx context.Context, body countrycodeallowlist.GetAllowedWhatsAppCountryCodesRequest) (*countrycodeallowlist.GetAllowedWhatsAppCountryCodesResponse, error)
+	getAllowedWhatsAppCountryCodesCalls   []countrycodeallowlist.GetAllowedWhatsAppCountryCodesRequest
+	getAllowedWhatsAppCountryCodesReturns *result[countrycodeallowlist.GetAllowedWhatsAppCountryCodesResponse]
+
+	// SetAllowedSMSCountryCodesFunc, if set, handles calls to SetAllowedSMSCountryCodes.
+	SetAllowedSMSCountryCodesFunc    func(ctx context.Context, body countrycodeallowlist.SetAllowedSMSCountryCodesRequest) (*countrycodeallowlist.SetAllowedSMSCountryCodesResponse, error)
+	setAllowedSMSCountryCodesCalls   []countrycodeallowlist.SetAllowedSMSCountryCodesRequest
+	setAllowedSMSCountryCodesReturns *result[countrycodeallowlist.SetAllowedSMSCountryCodesResponse]
+
+	// SetAllowedWhatsAppCountryCodesFunc, if set, handles calls to SetAllowedWhatsAppCountryCodes.
+	SetAllowedWhatsAppCountryCodesFunc    func(ctx context.Context, body countrycodeallowlist.SetAllowedWhatsAppCountryCodesRequest) (*countrycodeallowlist.SetAllowedWhatsAppCountryCodesResponse, error)
+	setAllowedWhatsAppCountryCodesCalls   []countrycodeallowlist.SetAllowedWhatsAppCountryCodesRequest
+	setAllowedWhatsAppCountryCodesReturns *result[countrycodeallowlist.SetAllowedWhatsAppCountryCodesResponse]
+}
+
+var _ api.CountryCodeAllowlistAPI = (*CountryCodeAllowlistAPI)(nil)
+
+// GetAllowedSMSCountryCodes records the request and returns the programmed response.
+func (f *CountryCodeAllowlistAPI) GetAllowedSMSCountryCodes(ctx context.Context, body countrycodeallowlist.GetAllowedSMSCountryCodesRequest) (*countrycodeallowlist.GetAllowedSMSCountryCodesResponse, error) {
+	f.mu.Lock()
+	f.getAllowedSMSCountryCodesCalls = append(f.getAllowedSMSCountryCodesCalls, body)
+	fn, ret := f.GetAllowedSMSCountryCodesFunc, f.getAllowedSMSCountryCodesReturns
+	f.mu.Unlock()
+	f.recorder.record("CountryCodeAllowlist.GetAllowedSMSCountryCodes", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// GetAllowedSMSCountryCodesReturns programs GetAllowedSMSCountryCodes to return resp and err.
+func (f *CountryCodeAllowlistAPI) GetAllowedSMSCountryCodesReturns(resp *countrycodeallowlist.GetAllowedSMSCountryCodesResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getAllowedSMSCountryCodesReturns = &result[countrycodeallowlist.GetAllowedSMSCountryCodesResponse]{resp: resp, err: err}
+}
+
+// GetAllowedSMSCountryCodesCalls returns the requests passed to GetAllowedSMSCountryCodes, in order.
+func (f *CountryCodeAllowlistAPI) GetAllowedSMSCountryCodesCalls() []countrycodeallowlist.GetAllowedSMSCountryCodesRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]countrycodeallowlist.GetAllowedSMSCountryCodesRequest(nil), f.getAllowedSMSCountryCodesCalls...)
+}
+
+// GetAllowedWhatsAppCountryCodes records the request and returns the programmed response.
+func (f *CountryCodeAllowlistAPI) GetAllowedWhatsAppCountryCodes(ctx context.Context, body countrycodeallowlist.GetAllowedWhatsAppCountryCodesRequest) (*countrycodeallowlist.GetAllowedWhatsAppCountryCodesResponse, error) {
+	f.mu.Lock()
+	f.getAllowedWhatsAppCountryCodesCalls = append(f.getAllowedWhatsAppCountryCodesCalls, body)
+	fn, ret := f.GetAllowedWhatsAppCountryCodesFunc, f.getAllowedWhatsAppCountryCodesReturns
+	f.mu.Unlock()
+	f.recorder.record("CountryCodeAllowlist.GetAllowedWhatsAppCountryCodes", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// GetAllowedWhatsAppCountryCodesReturns programs GetAllowedWhatsAppCountryCodes to return resp and err.
+func (f *CountryCodeAllowlistAPI) GetAllowedWhatsAppCountryCodesReturns(resp *countrycodeallowlist.GetAllowedWhatsAppCountryCodesResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getAllowedWhatsAppCountryCodesReturns = &result[countrycodeallowlist.GetAllowedWhatsAppCountryCodesResponse]{resp: resp, err: err}
+}
+
+// GetAllowedWhatsAppCountryCodesCalls returns the requests passed to GetAllowedWhatsAppCountryCodes, in order.
+func (f *CountryCodeAllowlistAPI) GetAllowedWhatsAppCountryCodesCalls() []countrycodeallowlist.GetAllowedWhatsAppCountryCodesRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]countrycodeallowlist.GetAllowedWhatsAppCountryCodesRequest(nil), f.getAllowedWhatsAppCountryCodesCalls...)
+}
+
+// SetAllowedSMSCountryCodes records the request and returns the programmed response.
+func (f *CountryCodeAllowlistAPI) SetAllowedSMSCountryCodes(ctx context.Context, body countrycodeallowlist.SetAllowedSMSCountryCodesRequest) (*countrycodeallowlist.SetAllowedSMSCountryCodesResponse, error) {
+	f.mu.Lock()
+	f.setAllowedSMSCountryCodesCalls = append(f.setAllowedSMSCountryCodesCalls, body)
+	fn, ret := f.SetAllowedSMSCountryCodesFunc, f.setAllowedSMSCountryCodesReturns
+	f.mu.Unlock()
+	f.recorder.record("CountryCodeAllowlist.SetAllowedSMSCountryCodes", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// SetAllowedSMSCountryCodesReturns programs SetAllowedSMSCountryCodes to return resp and err.
+func (f *CountryCodeAllowlistAPI) SetAllowedSMSCountryCodesReturns(resp *countrycodeallowlist.SetAllowedSMSCountryCodesResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.setAllowedSMSCountryCodesReturns = &result[countrycodeallowlist.SetAllowedSMSCountryCodesResponse]{resp: resp, err: err}
+}
+
+// SetAllowedSMSCountryCodesCalls returns the requests passed to SetAllowedSMSCountryCodes, in order.
+func (f *CountryCodeAllowlistAPI) SetAllowedSMSCountryCodesCalls() []countrycodeallowlist.SetAllowedSMSCountryCodesRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]countrycodeallowlist.SetAllowedSMSCountryCodesRequest(nil), f.setAllowedSMSCountryCodesCalls...)
+}
+
+// SetAllowedWhatsAppCountryCodes records the request and returns the programmed response.
+func (f *CountryCodeAllowlistAPI) SetAllowedWhatsAppCountryCodes(ctx context.Context, body countrycodeallowlist.SetAllowedWhatsAppCountryCodesRequest) (*countrycodeallowlist.SetAllowedWhatsAppCountryCodesResponse, error) {
+	f.mu.Lock()
+	f.setAllowedWhatsAppCountryCodesCalls = append(f.setAllowedWhatsAppCountryCodesCalls, body)
+	fn, ret := f.SetAllowedWhatsAppCountryCodesFunc, f.setAllowedWhatsAppCountryCodesReturns
+	f.mu.Unlock()
+	f.recorder.record("CountryCodeAllowlist.SetAllowedWhatsAppCountryCodes", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// SetAllowedWhatsAppCountryCodesReturns programs SetAllowedWhatsAppCountryCodes to return resp and err.
+func (f *CountryCodeAllowlistAPI) SetAllowedWhatsAppCountryCodesReturns(resp *countrycodeallowlist.SetAllowedWhatsAppCountryCodesResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.setAllowedWhatsAppCountryCodesReturns = &result[countrycodeallowlist.SetAllowedWhatsAppCountryCodesResponse]{resp: resp, err: err}
+}
+
+// SetAllowedWhatsAppCountryCodesCalls returns the requests passed to SetAllowedWhatsAppCountryCodes, in order.
+func (f *CountryCodeAllowlistAPI) SetAllowedWhatsAppCountryCodesCalls() []countrycodeallowlist.SetAllowedWhatsAppCountryCodesRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]countrycodeallowlist.SetAllowedWhatsAppCountryCodesRequest(nil), f.setAllowedWhatsAppCountryCodesCalls...)
+}
+
+// EmailTemplatesAPI is a fake implementation of api.EmailTemplatesAPI. Each method records its request and
+// then returns, in order of precedence, the result of the method's Func field, the response set
+// with the method's Returns function, or an empty response.
+type EmailTemplatesAPI struct {
+	recorder *recorder
+	mu       sync.Mutex
+
+	// CreateFunc, if set, handles calls to Create.
+	CreateFunc    func(ctx context.Context, body emailtemplates.CreateRequest) (*emailtemplates.CreateResponse, error)
+	createCalls   []emailtemplates.CreateRequest
+	createReturns *result[emailtemplates.CreateResponse]
+
+	// DeleteFunc, if set, handles calls to Delete.
+	DeleteFunc    func(ctx context.Context, body emailtemplates.DeleteRequest) (*emailtemplates.DeleteResponse, error)
+	deleteCalls   []emailtemplates.DeleteRequest
+	deleteReturns *result[emailtemplates.DeleteResponse]
+
+	// GetFunc, if set, handles calls to Get.
+	GetFunc    func(ctx context.Context, body emailtemplates.GetRequest) (*emailtemplates.GetResponse, error)
+	getCalls   []emailtemplates.GetRequest
+	getReturns *result[emailtemplates.GetResponse]
+
+	// GetAllFunc, if set, handles calls to GetAll.
+	GetAllFunc    func(ctx context.Context, body emailtemplates.GetAllRequest) (*emailtemplates.GetAllResponse, error)
+	getAllCalls   []emailtemplates.GetAllRequest
+	getAllReturns *result[emailtemplates.GetAllResponse]
+
+	// GetDefaultFunc, if set, handles calls to GetDefault.
+	GetDefaultFunc    func(ctx context.Context, body emailtemplates.GetDefaultRequest) (*emailtemplates.GetDefaultResponse, error)
+	getDefaultCalls   []emailtemplates.GetDefaultRequest
+	getDefaultReturns *result[emailtemplates.GetDefaultResponse]
+
+	// SetDefaultFunc, if set, handles calls to SetDefault.
+	SetDefaultFunc    func(ctx context.Context, body emailtemplates.SetDefaultRequest) (*emailtemplates.SetDefaultResponse, error)
+	setDefaultCalls   []emailtemplates.SetDefaultRequest
+	setDefaultReturns *result[emailtemplates.SetDefaultResponse]
+
+	// UnsetDefaultFunc, if set, handles calls to UnsetDefault.
+	UnsetDefaultFunc    func(ctx context.Context, body emailtemplates.UnsetDefaultRequest) (*emailtemplates.UnsetDefaultResponse, error)
+	unsetDefaultCalls   []emailtemplates.UnsetDefaultRequest
+	unsetDefaultReturns *result[emailtemplates.UnsetDefaultResponse]
+
+	// UpdateFunc, if set, handles calls to Update.
+	UpdateFunc    func(ctx context.Context, body emailtemplates.UpdateRequest) (*emailtemplates.UpdateResponse, error)
+	updateCalls   []emailtemplates.UpdateRequest
+	updateReturns *result[emailtemplates.UpdateResponse]
+}
+
+var _ api.EmailTemplatesAPI = (*EmailTemplatesAPI)(nil)
+
+// Create records the request and returns the programmed response.
+func (f *EmailTemplatesAPI) Create(ctx context.Context, body emailtemplates.CreateRequest) (*emailtemplates.CreateResponse, error) {
+	f.mu.Lock()
+	f.createCalls = append(f.createCalls, body)
+	fn, ret := f.CreateFunc, f.createReturns
+	f.mu.Unlock()
+	f.recorder.record("EmailTemplates.Create", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// CreateReturns programs Create to return resp and err.
+func (f *EmailTemplatesAPI) CreateReturns(resp *emailtemplates.CreateResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.createReturns = &result[emailtemplates.CreateResponse]{resp: resp, err: err}
+}
+
+// CreateCalls returns the requests passed to Create, in order.
+func (f *EmailTemplatesAPI) CreateCalls() []emailtemplates.CreateRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]emailtemplates.CreateRequest(nil), f.createCalls...)
+}
+
+// Delete records the request and returns the programmed response.
+func (f *EmailTemplatesAPI) Delete(ctx context.Context, body emailtemplates.DeleteRequest) (*emailtemplates.DeleteResponse, error) {
+	f.mu.Lock()
+	f.deleteCalls = append(f.deleteCalls, body)
+	fn, ret := f.DeleteFunc, f.deleteReturns
+	f.mu.Unlock()
+	f.recorder.record("EmailTemplates.Delete", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// DeleteReturns programs Delete to return resp and err.
+func (f *EmailTemplatesAPI) DeleteReturns(resp *emailtemplates.DeleteResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.deleteReturns = &result[emailtemplates.DeleteResponse]{resp: resp, err: err}
+}
+
+// DeleteCalls returns the requests passed to Delete, in order.
+func (f *EmailTemplatesAPI) DeleteCalls() []emailtemplates.DeleteRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]emailtemplates.DeleteRequest(nil), f.deleteCalls...)
+}
+
+// Get records the request and returns the programmed response.
+func (f *EmailTemplatesAPI) Get(ctx context.Context, body emailtemplates.GetRequest) (*emailtemplates.GetResponse, error) {
+	f.mu.Lock()
+	f.getCalls = append(f.getCalls, body)
+	fn, ret := f.GetFunc, f.getReturns
+	f.mu.Unlock()
+	f.recorder.record("EmailTemplates.Get", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// GetReturns programs Get to return resp and err.
+func (f *EmailTemplatesAPI) GetReturns(resp *emailtemplates.GetResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getReturns = &result[emailtemplates.GetResponse]{resp: resp, err: err}
+}
+
+// GetCalls returns the requests passed to Get, in order.
+func (f *EmailTemplatesAPI) GetCalls() []emailtemplates.GetRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]emailtemplates.GetRequest(nil), f.getCalls...)
+}
+
+// GetAll records the request and returns the programmed response.
+func (f *EmailTemplatesAPI) GetAll(ctx context.Context, body emailtemplates.GetAllRequest) (*emailtemplates.GetAllResponse, error) {
+	f.mu.Lock()
+	f.getAllCalls = append(f.getAllCalls, body)
+	fn, ret := f.GetAllFunc, f.getAllReturns
+	f.mu.Unlock()
+	f.recorder.record("EmailTemplates.GetAll", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// GetAllReturns programs GetAll to return resp and err.
+func (f *EmailTemplatesAPI) GetAllReturns(resp *emailtemplates.GetAllResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getAllReturns = &result[emailtemplates.GetAllResponse]{resp: resp, err: err}
+}
+
+// GetAllCalls returns the requests passed to GetAll, in order.
+func (f *EmailTemplatesAPI) GetAllCalls() []emailtemplates.GetAllRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]emailtemplates.GetAllRequest(nil), f.getAllCalls...)
+}
+
+// GetDefault records the request and returns the programmed response.
+func (f *EmailTemplatesAPI) GetDefault(ctx context.Context, body emailtemplates.GetDefaultRequest) (*emailtemplates.GetDefaultResponse, error) {
+	f.mu.Lock()
+	f.getDefaultCalls = append(f.getDefaultCalls, body)
+	fn, ret := f.GetDefaultFunc, f.getDefaultReturns
+	f.mu.Unlock()
+	f.recorder.record("EmailTemplates.GetDefault", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// GetDefaultReturns programs GetDefault to return resp and err.
+func (f *EmailTemplatesAPI) GetDefaultReturns(resp *emailtemplates.GetDefaultResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getDefaultReturns = &result[emailtemplates.GetDefaultResponse]{resp: resp, err: err}
+}
+
+// GetDefaultCalls returns the requests passed to GetDefault, in order.
+func (f *EmailTemplatesAPI) GetDefaultCalls() []emailtemplates.GetDefaultRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]emailtemplates.GetDefaultRequest(nil), f.getDefaultCalls...)
+}
+
+// SetDefault records the request and returns the programmed response.
+func (f *EmailTemplatesAPI) SetDefault(ctx context.Context, body emailtemplates.SetDefaultRequest) (*emailtemplates.SetDefaultResponse, error) {
+	f.mu.Lock()
+	f.setDefaultCalls = append(f.setDefaultCalls, body)
+	fn, ret := f.SetDefaultFunc, f.setDefaultReturns
+	f.mu.Unlock()
+	f.recorder.record("EmailTemplates.SetDefault", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// SetDefaultReturns programs SetDefault to return resp and err.
+func (f *EmailTemplatesAPI) SetDefaultReturns(resp *emailtemplates.SetDefaultResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.setDefaultReturns = &result[emailtemplates.SetDefaultResponse]{resp: resp, err: err}
+}
+
+// SetDefaultCalls returns the requests passed to SetDefault, in order.
+func (f *EmailTemplatesAPI) SetDefaultCalls() []emailtemplates.SetDefaultRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]emailtemplates.SetDefaultRequest(nil), f.setDefaultCalls...)
+}
+
+// UnsetDefault records the request and returns the programmed response.
+func (f *EmailTemplatesAPI) UnsetDefault(ctx context.Context, body emailtemplates.UnsetDefaultRequest) (*emailtemplates.UnsetDefaultResponse, error) {
+	f.mu.Lock()
+	f.unsetDefaultCalls = append(f.unsetDefaultCalls, body)
+	fn, ret := f.UnsetDefaultFunc, f.unsetDefaultReturns
+	f.mu.Unlock()
+	f.recorder.record("EmailTemplates.UnsetDefault", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// UnsetDefaultReturns programs UnsetDefault to return resp and err.
+func (f *EmailTemplatesAPI) UnsetDefaultReturns(resp *emailtemplates.UnsetDefaultResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.unsetDefaultReturns = &result[emailtemplates.UnsetDefaultResponse]{resp: resp, err: err}
+}
+
+// UnsetDefaultCalls returns the requests passed to UnsetDefault, in order.
+func (f *EmailTemplatesAPI) UnsetDefaultCalls() []emailtemplates.UnsetDefaultRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]emailtemplates.UnsetDefaultRequest(nil), f.unsetDefaultCalls...)
+}
+
+// Update records the request and returns the programmed response.
+func (f *EmailTemplatesAPI) Update(ctx context.Context, body emailtemplates.UpdateRequest) (*emailtemplates.UpdateResponse, error) {
+	f.mu.Lock()
+	f.updateCalls = append(f.updateCalls, body)
+	fn, ret := f.UpdateFunc, f.updateReturns
+	f.mu.Unlock()
+	f.recorder.record("EmailTemplates.Update", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// UpdateReturns programs Update to return resp and err.
+func (f *EmailTemplatesAPI) UpdateReturns(resp *emailtemplates.UpdateResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.updateReturns = &result[emailtemplates.UpdateResponse]{resp: resp, err: err}
+}
+
+// UpdateCalls returns the requests passed to Update, in order.
+func (f *EmailTemplatesAPI) UpdateCalls() []emailtemplates.UpdateRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]emailtemplates.UpdateRequest(nil), f.updateCalls...)
+}
+
+// EnvironmentsAPI is a fake implementation of api.EnvironmentsAPI. Each method records its request and
+// then returns, in order of precedence, the result of the method's Func field, the response set
+// with the method's Returns function, or an empty response.
+type EnvironmentsAPI struct {
+	recorder *recorder
+	mu       sync.Mutex
+
+	// CreateFunc, if set, handles calls to Create.
+	CreateFunc    func(ctx context.Context, body environments.CreateRequest) (*environments.CreateResponse, error)
+	createCalls   []environments.CreateRequest
+	createReturns *result[environments.CreateResponse]
+
+	// DeleteFunc, if set, handles calls to Delete.
+	DeleteFunc    func(ctx context.Context, body environments.DeleteRequest) (*environments.DeleteResponse, error)
+	deleteCalls   []environments.DeleteRequest
+	deleteReturns *result[environments.DeleteResponse]
+
+	// GetFunc, if set, handles calls to Get.
+	GetFunc    func(ctx context.Context, body environments.GetRequest) (*environments.GetResponse, error)
+	getCalls   []environments.GetRequest
+	getReturns *result[environments.GetResponse]
+
+	// GetAllFunc, if set, handles calls to GetAll.
+	GetAllFunc    func(ctx context.Context, body environments.GetAllRequest) (*environments.GetAllResponse, error)
+	getAllCalls   []environments.GetAllRequest
+	getAllReturns *result[environments.GetAllResponse]
+
+	// GetMetricsFunc, if set, handles calls to GetMetrics.
+	GetMetricsFunc    func(ctx context.Context, body environments.GetMetricsRequest) (*environments.GetMetricsResponse, error)
+	getMetricsCalls   []environments.GetMetricsRequest
+	getMetricsReturns *result[environments.GetMetricsResponse]
+
+	// UpdateFunc, if set, handles calls to Update.
+	UpdateFunc    func(ctx context.Context, body environments.UpdateRequest) (*environments.UpdateResponse, error)
+	updateCalls   []environments.UpdateRequest
+	updateReturns *result[environments.UpdateResponse]
+}
+
+var _ api.EnvironmentsAPI = (*EnvironmentsAPI)(nil)
+
+// Create records the request and returns the programmed response.
+func (f *EnvironmentsAPI) Create(ctx context.Context, body environments.CreateRequest) (*environments.CreateResponse, error) {
+	f.mu.Lock()
+	f.createCalls = append(f.createCalls, body)
+	fn, ret := f.CreateFunc, f.createReturns
+	f.mu.Unlock()
+	f.recorder.record("Environments.Create", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// CreateReturns programs Create to return resp and err.
+func (f *EnvironmentsAPI) CreateReturns(resp *environments.CreateResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.createReturns = &result[environments.CreateResponse]{resp: resp, err: err}
+}
+
+// CreateCalls returns the requests passed to Create, in order.
+func (f *EnvironmentsAPI) CreateCalls() []environments.CreateRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]environments.CreateRequest(nil), f.createCalls...)
+}
+
+// Delete records the request and returns the programmed response.
+func (f *EnvironmentsAPI) Delete(ctx context.Context, body environments.DeleteRequest) (*environments.DeleteResponse, error) {
+	f.mu.Lock()
+	f.deleteCalls = append(f.deleteCalls, body)
+	fn, ret := f.DeleteFunc, f.deleteReturns
+	f.mu.Unlock()
+	f.recorder.record("Environments.Delete", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// DeleteReturns programs Delete to return resp and err.
+func (f *EnvironmentsAPI) DeleteReturns(resp *environments.DeleteResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.deleteReturns = &result[environments.DeleteResponse]{resp: resp, err: err}
+}
+
+// DeleteCalls returns the requests passed to Delete, in order.
+func (f *EnvironmentsAPI) DeleteCalls() []environments.DeleteRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]environments.DeleteRequest(nil), f.deleteCalls...)
+}
+
+// Get records the request and returns the programmed response.
+func (f *EnvironmentsAPI) Get(ctx context.Context, body environments.GetRequest) (*environments.GetResponse, error) {
+	f.mu.Lock()
+	f.getCalls = append(f.getCalls, body)
+	fn, ret := f.GetFunc, f.getReturns
+	f.mu.Unlock()
+	f.recorder.record("Environments.Get", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// GetReturns programs Get to return resp and err.
+func (f *EnvironmentsAPI) GetReturns(resp *environments.GetResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getReturns = &result[environments.GetResponse]{resp: resp, err: err}
+}
+
+// GetCalls returns the requests passed to Get, in order.
+func (f *EnvironmentsAPI) GetCalls() []environments.GetRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]environments.GetRequest(nil), f.getCalls...)
+}
+
+// GetAll records the request and returns the programmed response.
+func (f *EnvironmentsAPI) GetAll(ctx context.Context, body environments.GetAllRequest) (*environments.GetAllResponse, error) {
+	f.mu.Lock()
+	f.getAllCalls = append(f.getAllCalls, body)
+	fn, ret := f.GetAllFunc, f.getAllReturns
+	f.mu.Unlock()
+	f.recorder.record("Environments.GetAll", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// GetAllReturns programs GetAll to return resp and err.
+func (f *EnvironmentsAPI) GetAllReturns(resp *environments.GetAllResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getAllReturns = &result[environments.GetAllResponse]{resp: resp, err: err}
+}
+
+// GetAllCalls returns the requests passed to GetAll, in order.
+func (f *EnvironmentsAPI) GetAllCalls() []environments.GetAllRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]environments.GetAllRequest(nil), f.getAllCalls...)
+}
+
+// GetMetrics records the request and returns the programmed response.
+func (f *EnvironmentsAPI) GetMetrics(ctx context.Context, body environments.GetMetricsRequest) (*environments.GetMetricsResponse, error) {
+	f.mu.Lock()
+	f.getMetricsCalls = append(f.getMetricsCalls, body)
+	fn, ret := f.GetMetricsFunc, f.getMetricsReturns
+	f.mu.Unlock()
+	f.recorder.record("Environments.GetMetrics", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// GetMetricsReturns programs GetMetrics to return resp and err.
+func (f *EnvironmentsAPI) GetMetricsReturns(resp *environments.GetMetricsResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getMetricsReturns = &result[environments.GetMetricsResponse]{resp: resp, err: err}
+}
+
+// GetMetricsCalls returns the requests passed to GetMetrics, in order.
+func (f *EnvironmentsAPI) GetMetricsCalls() []environments.GetMetricsRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]environments.GetMetricsRequest(nil), f.getMetricsCalls...)
+}
+
+// Update records the request and returns the programmed response.
+func (f *EnvironmentsAPI) Update(ctx context.Context, body environments.UpdateRequest) (*environments.UpdateResponse, error) {
+	f.mu.Lock()
+	f.updateCalls = append(f.updateCalls, body)
+	fn, ret := f.UpdateFunc, f.updateReturns
+	f.mu.Unlock()
+	f.recorder.record("Environments.Update", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// UpdateReturns programs Update to return resp and err.
+func (f *EnvironmentsAPI) UpdateReturns(resp *environments.UpdateResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.updateReturns = &result[environments.UpdateResponse]{resp: resp, err: err}
+}
+
+// UpdateCalls returns the requests passed to Update, in order.
+func (f *EnvironmentsAPI) UpdateCalls() []environments.UpdateRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]environments.UpdateRequest(nil), f.updateCalls...)
+}
+
+// EventLogStreamingAPI is a fake implementation of api.EventLogStreamingAPI. Each method records its request and
+// then returns, in order of precedence, the result of the method's Func field, the response set
+// with the method's Returns function, or an empty response.
+type EventLogStreamingAPI struct {
+	recorder *recorder
+	mu       sync.Mutex
+
+	// CreateFunc, if set, handles calls to Create.
+	CreateFunc    func(ctx context.Context, body eventlogstreaming.CreateRequest) (*eventlogstreaming.CreateResponse, error)
+	createCalls   []eventlogstreaming.CreateRequest
+	createReturns *result[eventlogstreaming.CreateResponse]
+
+	// DeleteFunc, if set, handles calls to Delete.
+	DeleteFunc    func(ctx context.Context, body eventlogstreaming.DeleteRequest) (*eventlogstreaming.DeleteResponse, error)
+	deleteCalls   []eventlogstreaming.DeleteRequest
+	deleteReturns *result[eventlogstreaming.DeleteResponse]
+
+	// DisableFunc, if set, handles calls to Disable.
+	DisableFunc    func(ctx context.Context, body eventlogstreaming.DisableRequest) (*eventlogstreaming.DisableResponse, error)
+	disableCalls   []eventlogstreaming.DisableRequest
+	disableReturns *result[eventlogstreaming.DisableResponse]
+
+	// EnableFunc, if set, handles calls to Enable.
+	EnableFunc    func(ctx context.Context, body eventlogstreaming.EnableRequest) (*eventlogstreaming.EnableResponse, error)
+	enableCalls   []eventlogstreaming.EnableRequest
+	enableReturns *result[eventlogstreaming.EnableResponse]
+
+	// GetFunc, if set, handles calls to Get.
+	GetFunc    func(ctx context.Context, body eventlogstreaming.GetRequest) (*eventlogstreaming.GetResponse, error)
+	getCalls   []eventlogstreaming.GetRequest
+	getReturns *result[eventlogstreaming.GetResponse]
+
+	// UpdateFunc, if set, handles calls to Update.
+	UpdateFunc    func(ctx context.Context, body eventlogstreaming.UpdateRequest) (*eventlogstreaming.UpdateResponse, error)
+	updateCalls   []eventlogstreaming.UpdateRequest
+	updateReturns *result[eventlogstreaming.UpdateResponse]
+}
+
+var _ api.EventLogStreamingAPI = (*EventLogStreamingAPI)(nil)
+
+// Create records the request and returns the programmed response.
+func (f *EventLogStreamingAPI) Create(ctx context.Context, body eventlogstreaming.CreateRequest) (*eventlogstreaming.CreateResponse, error) {
+	f.mu.Lock()
+	f.createCalls = append(f.createCalls, body)
+	fn, ret := f.CreateFunc, f.createReturns
+	f.mu.Unlock()
+	f.recorder.record("EventLogStreaming.Create", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// CreateReturns programs Create to return resp and err.
+func (f *EventLogStreamingAPI) CreateReturns(resp *eventlogstreaming.CreateResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.createReturns = &result[eventlogstreaming.CreateResponse]{resp: resp, err: err}
+}
+
+// CreateCalls returns the requests passed to Create, in order.
+func (f *EventLogStreamingAPI) CreateCalls() []eventlogstreaming.CreateRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]eventlogstreaming.CreateRequest(nil), f.createCalls...)
+}
+
+// Delete records the request and returns the programmed response.
+func (f *EventLogStreamingAPI) Delete(ctx context.Context, body eventlogstreaming.DeleteRequest) (*eventlogstreaming.DeleteResponse, error) {
+	f.mu.Lock()
+	f.deleteCalls = append(f.deleteCalls, body)
+	fn, ret := f.DeleteFunc, f.deleteReturns
+	f.mu.Unlock()
+	f.recorder.record("EventLogStreaming.Delete", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// DeleteReturns programs Delete to return resp and err.
+func (f *EventLogStreamingAPI) DeleteReturns(resp *eventlogstreaming.DeleteResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.deleteReturns = &result[eventlogstreaming.DeleteResponse]{resp: resp, err: err}
+}
+
+// DeleteCalls returns the requests passed to Delete, in order.
+func (f *EventLogStreamingAPI) DeleteCalls() []eventlogstreaming.DeleteRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]eventlogstreaming.DeleteRequest(nil), f.deleteCalls...)
+}
+
+// Disable records the request and returns the programmed response.
+func (f *EventLogStreamingAPI) Disable(ctx context.Context, body eventlogstreaming.DisableRequest) (*eventlogstreaming.DisableResponse, error) {
+	f.mu.Lock()
+	f.disableCalls = append(f.disableCalls, body)
+	fn, ret := f.DisableFunc, f.disableReturns
+	f.mu.Unlock()
+	f.recorder.record("EventLogStreaming.Disable", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// DisableReturns programs Disable to return resp and err.
+func (f *EventLogStreamingAPI) DisableReturns(resp *eventlogstreaming.DisableResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.disableReturns = &result[eventlogstreaming.DisableResponse]{resp: resp, err: err}
+}
+
+// DisableCalls returns the requests passed to Disable, in order.
+func (f *EventLogStreamingAPI) DisableCalls() []eventlogstreaming.DisableRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]eventlogstreaming.DisableRequest(nil), f.disableCalls...)
+}
+
+// Enable records the request and returns the programmed response.
+func (f *EventLogStreamingAPI) Enable(ctx context.Context, body eventlogstreaming.EnableRequest) (*eventlogstreaming.EnableResponse, error) {
+	f.mu.Lock()
+	f.enableCalls = append(f.enableCalls, body)
+	fn, ret := f.EnableFunc, f.enableReturns
+	f.mu.Unlock()
+	f.recorder.record("EventLogStreaming.Enable", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// EnableReturns programs Enable to return resp and err.
+func (f *EventLogStreamingAPI) EnableReturns(resp *eventlogstreaming.EnableResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.enableReturns = &result[eventlogstreaming.EnableResponse]{resp: resp, err: err}
+}
+
+// EnableCalls returns the requests passed to Enable, in order.
+func (f *EventLogStreamingAPI) EnableCalls() []eventlogstreaming.EnableRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]eventlogstreaming.EnableRequest(nil), f.enableCalls...)
+}
+
+// Get records the request and returns the programmed response.
+func (f *EventLogStreamingAPI) Get(ctx context.Context, body eventlogstreaming.GetRequest) (*eventlogstreaming.GetResponse, error) {
+	f.mu.Lock()
+	f.getCalls = append(f.getCalls, body)
+	fn, ret := f.GetFunc, f.getReturns
+	f.mu.Unlock()
+	f.recorder.record("EventLogStreaming.Get", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// GetReturns programs Get to return resp and err.
+func (f *EventLogStreamingAPI) GetReturns(resp *eventlogstreaming.GetResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getReturns = &result[eventlogstreaming.GetResponse]{resp: resp, err: err}
+}
+
+// GetCalls returns the requests passed to Get, in order.
+func (f *EventLogStreamingAPI) GetCalls() []eventlogstreaming.GetRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]eventlogstreaming.GetRequest(nil), f.getCalls...)
+}
+
+// Update records the request and returns the programmed response.
+func (f *EventLogStreamingAPI) Update(ctx context.Context, body eventlogstreaming.UpdateRequest) (*eventlogstreaming.UpdateResponse, error) {
+	f.mu.Lock()
+	f.updateCalls = append(f.updateCalls, body)
+	fn, ret := f.UpdateFunc, f.updateReturns
+	f.mu.Unlock()
+	f.recorder.record("EventLogStreaming.Update", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// UpdateReturns programs Update to return resp and err.
+func (f *EventLogStreamingAPI) UpdateReturns(resp *eventlogstreaming.UpdateResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.updateReturns = &result[eventlogstreaming.UpdateResponse]{resp: resp, err: err}
+}
+
+// UpdateCalls returns the requests passed to Update, in order.
+func (f *EventLogStreamingAPI) UpdateCalls() []eventlogstreaming.UpdateRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]eventlogstreaming.UpdateRequest(nil), f.updateCalls...)
+}
+
+// JWTTemplatesAPI is a fake implementation of api.JWTTemplatesAPI. Each method records its request and
+// then returns, in order of precedence, the result of the method's Func field, the response set
+// with the method's Returns function, or an empty response.
+type JWTTemplatesAPI struct {
+	recorder *recorder
+	mu       sync.Mutex
+
+	// GetFunc, if set, handles calls to Get.
+	GetFunc    func(ctx context.Context, body jwttemplates.GetRequest) (*jwttemplates.GetResponse, error)
+	getCalls   []jwttemplates.GetRequest
+	getReturns *result[jwttemplates.GetResponse]
+
+	// SetFunc, if set, handles calls to Set.
+	SetFunc    func(ctx context.Context, body jwttemplates.SetRequest) (*jwttemplates.SetResponse, error)
+	setCalls   []jwttemplates.SetRequest
+	setReturns *result[jwttemplates.SetResponse]
+}
+
+var _ api.JWTTemplatesAPI = (*JWTTemplatesAPI)(nil)
+
+// Get records the request and returns the programmed response.
+func (f *JWTTemplatesAPI) Get(ctx context.Context, body jwttemplates.GetRequest) (*jwttemplates.GetResponse, error) {
+	f.mu.Lock()
+	f.getCalls = append(f.getCalls, body)
+	fn, ret := f.GetFunc, f.getReturns
+	f.mu.Unlock()
+	f.recorder.record("JWTTemplates.Get", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// GetReturns programs Get to return resp and err.
+func (f *JWTTemplatesAPI) GetReturns(resp *jwttemplates.GetResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getReturns = &result[jwttemplates.GetResponse]{resp: resp, err: err}
+}
+
+// GetCalls returns the requests passed to Get, in order.
+func (f *JWTTemplatesAPI) GetCalls() []jwttemplates.GetRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]jwttemplates.GetRequest(nil), f.getCalls...)
+}
+
+// Set records the request and returns the programmed response.
+func (f *JWTTemplatesAPI) Set(ctx context.Context, body jwttemplates.SetRequest) (*jwttemplates.SetResponse, error) {
+	f.mu.Lock()
+	f.setCalls = append(f.setCalls, body)
+	fn, ret := f.SetFunc, f.setReturns
+	f.mu.Unlock()
+	f.recorder.record("JWTTemplates.Set", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// SetReturns programs Set to return resp and err.
+func (f *JWTTemplatesAPI) SetReturns(resp *jwttemplates.SetResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.setReturns = &result[jwttemplates.SetResponse]{resp: resp, err: err}
+}
+
+// SetCalls returns the requests passed to Set, in order.
+func (f *JWTTemplatesAPI) SetCalls() []jwttemplates.SetRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]jwttemplates.SetRequest(nil), f.setCalls...)
+}
+
+// PasswordStrengthConfigAPI is a fake implementation of api.PasswordStrengthConfigAPI. Each method records its request and
+// then returns, in order of precedence, the result of the method's Func field, the response set
+// with the method's Returns function, or an empty response.
+type PasswordStrengthConfigAPI struct {
+	recorder *recorder
+	mu       sync.Mutex
+
+	// GetFunc, if set, handles calls to Get.
+	GetFunc    func(ctx context.Context, body passwordstrengthconfig.GetRequest) (*passwordstrengthconfig.GetResponse, error)
+	getCalls   []passwordstrengthconfig.GetRequest
+	getReturns *result[passwordstrengthconfig.GetResponse]
+
+	// SetFunc, if set, handles calls to Set.
+	SetFunc    func(ctx context.Context, body passwordstrengthconfig.SetRequest) (*passwordstrengthconfig.SetResponse, error)
+	setCalls   []passwordstrengthconfig.SetRequest
+	setReturns *result[passwordstrengthconfig.SetResponse]
+}
+
+var _ api.PasswordStrengthConfigAPI = (*PasswordStrengthConfigAPI)(nil)
+
+// Get records the request and returns the programmed response.
+func (f *PasswordStrengthConfigAPI) Get(ctx context.Context, body passwordstrengthconfig.GetRequest) (*passwordstrengthconfig.GetResponse, error) {
+	f.mu.Lock()
+	f.getCalls = append(f.getCalls, body)
+	fn, ret := f.GetFunc, f.getReturns
+	f.mu.Unlock()
+	f.recorder.record("PasswordStrengthConfig.Get", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// GetReturns programs Get to return resp and err.
+func (f *PasswordStrengthConfigAPI) GetReturns(resp *passwordstrengthconfig.GetResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getReturns = &result[passwordstrengthconfig.GetResponse]{resp: resp, err: err}
+}
+
+// GetCalls returns the requests passed to Get, in order.
+func (f *PasswordStrengthConfigAPI) GetCalls() []passwordstrengthconfig.GetRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]passwordstrengthconfig.GetRequest(nil), f.getCalls...)
+}
+
+// Set records the request and returns the programmed response.
+func (f *PasswordStrengthConfigAPI) Set(ctx context.Context, body passwordstrengthconfig.SetRequest) (*passwordstrengthconfig.SetResponse, error) {
+	f.mu.Lock()
+	f.setCalls = append(f.setCalls, body)
+	fn, ret := f.SetFunc, f.setReturns
+	f.mu.Unlock()
+	f.recorder.record("PasswordStrengthConfig.Set", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// SetReturns programs Set to return resp and err.
+func (f *PasswordStrengthConfigAPI) SetReturns(resp *passwordstrengthconfig.SetResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.setReturns = &result[passwordstrengthconfig.SetResponse]{resp: resp, err: err}
+}
+
+// SetCalls returns the requests passed to Set, in order.
+func (f *PasswordStrengthConfigAPI) SetCalls() []passwordstrengthconfig.SetRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]passwordstrengthconfig.SetRequest(nil), f.setCalls...)
+}
+
+// ProjectsAPI is a fake implementation of api.ProjectsAPI. Each method records its request and
+// then returns, in order of precedence, the result of the method's Func field, the response set
+// with the method's Returns function, or an empty response.
+type ProjectsAPI struct {
+	recorder *recorder
+	mu       sync.Mutex
+
+	// CreateFunc, if set, handles calls to Create.
+	CreateFunc    func(ctx context.Context, body projects.CreateRequest) (*projects.CreateResponse, error)
+	createCalls   []projects.CreateRequest
+	createReturns *result[projects.CreateResponse]
+
+	// DeleteFunc, if set, handles calls to Delete.
+	DeleteFunc    func(ctx context.Context, body projects.DeleteRequest) (*projects.DeleteResponse, error)
+	deleteCalls   []projects.DeleteRequest
+	deleteReturns *result[projects.DeleteResponse]
+
+	// GetFunc, if set, handles calls to Get.
+	GetFunc    func(ctx context.Context, body projects.GetRequest) (*projects.GetResponse, error)
+	getCalls   []projects.GetRequest
+	getReturns *result[projects.GetResponse]
+
+	// GetAllFunc, if set, handles calls to GetAll.
+	GetAllFunc    func(ctx context.Context, body projects.GetAllRequest) (*projects.GetAllResponse, error)
+	getAllCalls   []projects.GetAllRequest
+	getAllReturns *result[projects.GetAllResponse]
+
+	// UpdateFunc, if set, handles calls to Update.
+	UpdateFunc    func(ctx context.Context, body projects.UpdateRequest) (*projects.UpdateResponse, error)
+	updateCalls   []projects.UpdateRequest
+	updateReturns *result[projects.UpdateResponse]
+}
+
+var _ api.ProjectsAPI = (*ProjectsAPI)(nil)
+
+// Create records the request and returns the programmed response.
+func (f *ProjectsAPI) Create(ctx context.Context, body projects.CreateRequest) (*projects.CreateResponse, error) {
+	f.mu.Lock()
+	f.createCalls = append(f.createCalls, body)
+	fn, ret := f.CreateFunc, f.createReturns
+	f.mu.Unlock()
+	f.recorder.record("Projects.Create", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// CreateReturns programs Create to return resp and err.
+func (f *ProjectsAPI) CreateReturns(resp *projects.CreateResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.createReturns = &result[projects.CreateResponse]{resp: resp, err: err}
+}
+
+// CreateCalls returns the requests passed to Create, in order.
+func (f *ProjectsAPI) CreateCalls() []projects.CreateRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]projects.CreateRequest(nil), f.createCalls...)
+}
+
+// Delete records the request and returns the programmed response.
+func (f *ProjectsAPI) Delete(ctx context.Context, body projects.DeleteRequest) (*projects.DeleteResponse, error) {
+	f.mu.Lock()
+	f.deleteCalls = append(f.deleteCalls, body)
+	fn, ret := f.DeleteFunc, f.deleteReturns
+	f.mu.Unlock()
+	f.recorder.record("Projects.Delete", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// DeleteReturns programs Delete to return resp and err.
+func (f *ProjectsAPI) DeleteReturns(resp *projects.DeleteResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.deleteReturns = &result[projects.DeleteResponse]{resp: resp, err: err}
+}
+
+// DeleteCalls returns the requests passed to Delete, in order.
+func (f *ProjectsAPI) DeleteCalls() []projects.DeleteRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]projects.DeleteRequest(nil), f.deleteCalls...)
+}
+
+// Get records the request and returns the programmed response.
+func (f *ProjectsAPI) Get(ctx context.Context, body projects.GetRequest) (*projects.GetResponse, error) {
+	f.mu.Lock()
+	f.getCalls = append(f.getCalls, body)
+	fn, ret := f.GetFunc, f.getReturns
+	f.mu.Unlock()
+	f.recorder.record("Projects.Get", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// GetReturns programs Get to return resp and err.
+func (f *ProjectsAPI) GetReturns(resp *projects.GetResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getReturns = &result[projects.GetResponse]{resp: resp, err: err}
+}
+
+// GetCalls returns the requests passed to Get, in order.
+func (f *ProjectsAPI) GetCalls() []projects.GetRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]projects.GetRequest(nil), f.getCalls...)
+}
+
+// GetAll records the request and returns the programmed response.
+func (f *ProjectsAPI) GetAll(ctx context.Context, body projects.GetAllRequest) (*projects.GetAllResponse, error) {
+	f.mu.Lock()
+	f.getAllCalls = append(f.getAllCalls, body)
+	fn, ret := f.GetAllFunc, f.getAllReturns
+	f.mu.Unlock()
+	f.recorder.record("Projects.GetAll", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// GetAllReturns programs GetAll to return resp and err.
+func (f *ProjectsAPI) GetAllReturns(resp *projects.GetAllResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getAllReturns = &result[projects.GetAllResponse]{resp: resp, err: err}
+}
+
+// GetAllCalls returns the requests passed to GetAll, in order.
+func (f *ProjectsAPI) GetAllCalls() []projects.GetAllRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]projects.GetAllRequest(nil), f.getAllCalls...)
+}
+
+// Update records the request and returns the programmed response.
+func (f *ProjectsAPI) Update(ctx context.Context, body projects.UpdateRequest) (*projects.UpdateResponse, error) {
+	f.mu.Lock()
+	f.updateCalls = append(f.updateCalls, body)
+	fn, ret := f.UpdateFunc, f.updateReturns
+	f.mu.Unlock()
+	f.recorder.record("Projects.Update", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// UpdateReturns programs Update to return resp and err.
+func (f *ProjectsAPI) UpdateReturns(resp *projects.UpdateResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.updateReturns = &result[projects.UpdateResponse]{resp: resp, err: err}
+}
+
+// UpdateCalls returns the requests passed to Update, in order.
+func (f *ProjectsAPI) UpdateCalls() []projects.UpdateRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]projects.UpdateRequest(nil), f.updateCalls...)
+}
+
+// PublicTokensAPI is a fake implementation of api.PublicTokensAPI. Each method records its request and
+// then returns, in order of precedence, the result of the method's Func field, the response set
+// with the method's Returns function, or an empty response.
+type PublicTokensAPI struct {
+	recorder *recorder
+	mu       sync.Mutex
+
+	// CreateFunc, if set, handles calls to Create.
+	CreateFunc    func(ctx context.Context, body publictokens.CreateRequest) (*publictokens.CreateResponse, error)
+	createCalls   []publictokens.CreateRequest
+	createReturns *result[publictokens.CreateResponse]
+
+	// DeleteFunc, if set, handles calls to Delete.
+	DeleteFunc    func(ctx context.Context, body publictokens.DeleteRequest) (*publictokens.DeleteResponse, error)
+	deleteCalls   []publictokens.DeleteRequest
+	deleteReturns *result[publictokens.DeleteResponse]
+
+	// GetFunc, if set, handles calls to Get.
+	GetFunc    func(ctx context.Context, body publictokens.GetRequest) (*publictokens.GetResponse, error)
+	getCalls   []publictokens.GetRequest
+	getReturns *result[publictokens.GetResponse]
+
+	// GetAllFunc, if set, handles calls to GetAll.
+	GetAllFunc    func(ctx context.Context, body publictokens.GetAllRequest) (*publictokens.GetAllResponse, error)
+	getAllCalls   []publictokens.GetAllRequest
+	getAllReturns *result[publictokens.GetAllResponse]
+}
+
+var _ api.PublicTokensAPI = (*PublicTokensAPI)(nil)
+
+// Create records the request and returns the programmed response.
+func (f *PublicTokensAPI) Create(ctx context.Context, body publictokens.CreateRequest) (*publictokens.CreateResponse, error) {
+	f.mu.Lock()
+	f.createCalls = append(f.createCalls, body)
+	fn, ret := f.CreateFunc, f.createReturns
+	f.mu.Unlock()
+	f.recorder.record("PublicTokens.Create", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// CreateReturns programs Create to return resp and err.
+func (f *PublicTokensAPI) CreateReturns(resp *publictokens.CreateResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.createReturns = &result[publictokens.CreateResponse]{resp: resp, err: err}
+}
+
+// CreateCalls returns the requests passed to Create, in order.
+func (f *PublicTokensAPI) CreateCalls() []publictokens.CreateRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]publictokens.CreateRequest(nil), f.createCalls...)
+}
+
+// Delete records the request and returns the programmed response.
+func (f *PublicTokensAPI) Delete(ctx context.Context, body publictokens.DeleteRequest) (*publictokens.DeleteResponse, error) {
+	f.mu.Lock()
+	f.deleteCalls = append(f.deleteCalls, body)
+	fn, ret := f.DeleteFunc, f.deleteReturns
+	f.mu.Unlock()
+	f.recorder.record("PublicTokens.Delete", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// DeleteReturns programs Delete to return resp and err.
+func (f *PublicTokensAPI) DeleteReturns(resp *publictokens.DeleteResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.deleteReturns = &result[publictokens.DeleteResponse]{resp: resp, err: err}
+}
+
+// DeleteCalls returns the requests passed to Delete, in order.
+func (f *PublicTokensAPI) DeleteCalls() []publictokens.DeleteRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]publictokens.DeleteRequest(nil), f.deleteCalls...)
+}
+
+// Get records the request and returns the programmed response.
+func (f *PublicTokensAPI) Get(ctx context.Context, body publictokens.GetRequest) (*publictokens.GetResponse, error) {
+	f.mu.Lock()
+	f.getCalls = append(f.getCalls, body)
+	fn, ret := f.GetFunc, f.getReturns
+	f.mu.Unlock()
+	f.recorder.record("PublicTokens.Get", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// GetReturns programs Get to return resp and err.
+func (f *PublicTokensAPI) GetReturns(resp *publictokens.GetResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getReturns = &result[publictokens.GetResponse]{resp: resp, err: err}
+}
+
+// GetCalls returns the requests passed to Get, in order.
+func (f *PublicTokensAPI) GetCalls() []publictokens.GetRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]publictokens.GetRequest(nil), f.getCalls...)
+}
+
+// GetAll records the request and returns the programmed response.
+func (f *PublicTokensAPI) GetAll(ctx context.Context, body publictokens.GetAllRequest) (*publictokens.GetAllResponse, error) {
+	f.mu.Lock()
+	f.getAllCalls = append(f.getAllCalls, body)
+	fn, ret := f.GetAllFunc, f.getAllReturns
+	f.mu.Unlock()
+	f.recorder.record("PublicTokens.GetAll", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// GetAllReturns programs GetAll to return resp and err.
+func (f *PublicTokensAPI) GetAllReturns(resp *publictokens.GetAllResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getAllReturns = &result[publictokens.GetAllResponse]{resp: resp, err: err}
+}
+
+// GetAllCalls returns the requests passed to GetAll, in order.
+func (f *PublicTokensAPI) GetAllCalls() []publictokens.GetAllRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]publictokens.GetAllRequest(nil), f.getAllCalls...)
+}
+
+// RBACPolicyAPI is a fake implementation of api.RBACPolicyAPI. Each method records its request and
+// then returns, in order of precedence, the result of the method's Func field, the response set
+// with the method's Returns function, or an empty response.
+type RBACPolicyAPI struct {
+	recorder *recorder
+	mu       sync.Mutex
+
+	// GetFunc, if set, handles calls to Get.
+	GetFunc    func(ctx context.Context, body rbacpolicy.GetRequest) (*rbacpolicy.GetResponse, error)
+	getCalls   []rbacpolicy.GetRequest
+	getReturns *result[rbacpolicy.GetResponse]
+
+	// SetFunc, if set, handles calls to Set.
+	SetFunc    func(ctx context.Context, body rbacpolicy.SetRequest) (*rbacpolicy.SetResponse, error)
+	setCalls   []rbacpolicy.SetRequest
+	setReturns *result[rbacpolicy.SetResponse]
+}
+
+var _ api.RBACPolicyAPI = (*RBACPolicyAPI)(nil)
+
+// Get records the request and returns the programmed response.
+func (f *RBACPolicyAPI) Get(ctx context.Context, body rbacpolicy.GetRequest) (*rbacpolicy.GetResponse, error) {
+	f.mu.Lock()
+	f.getCalls = append(f.getCalls, body)
+	fn, ret := f.GetFunc, f.getReturns
+	f.mu.Unlock()
+	f.recorder.record("RBACPolicy.Get", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// GetReturns programs Get to return resp and err.
+func (f *RBACPolicyAPI) GetReturns(resp *rbacpolicy.GetResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getReturns = &result[rbacpolicy.GetResponse]{resp: resp, err: err}
+}
+
+// GetCalls returns the requests passed to Get, in order.
+func (f *RBACPolicyAPI) GetCalls() []rbacpolicy.GetRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]rbacpolicy.GetRequest(nil), f.getCalls...)
+}
+
+// Set records the request and returns the programmed response.
+func (f *RBACPolicyAPI) Set(ctx context.Context, body rbacpolicy.SetRequest) (*rbacpolicy.SetResponse, error) {
+	f.mu.Lock()
+	f.setCalls = append(f.setCalls, body)
+	fn, ret := f.SetFunc, f.setReturns
+	f.mu.Unlock()
+	f.recorder.record("RBACPolicy.Set", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// SetReturns programs Set to return resp and err.
+func (f *RBACPolicyAPI) SetReturns(resp *rbacpolicy.SetResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.setReturns = &result[rbacpolicy.SetResponse]{resp: resp, err: err}
+}
+
+// SetCalls returns the requests passed to Set, in order.
+func (f *RBACPolicyAPI) SetCalls() []rbacpolicy.SetRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]rbacpolicy.SetRequest(nil), f.setCalls...)
+}
+
+// RedirectURLsAPI is a fake implementation of api.RedirectURLsAPI. Each method records its request and
+// then returns, in order of precedence, the result of the method's Func field, the response set
+// with the method's Returns function, or an empty response.
+type RedirectURLsAPI struct {
+	recorder *recorder
+	mu       sync.Mutex
+
+	// CreateFunc, if set, handles calls to Create.
+	CreateFunc    func(ctx context.Context, body redirecturls.CreateRequest) (*redirecturls.CreateResponse, error)
+	createCalls   []redirecturls.CreateRequest
+	createReturns *result[redirecturls.CreateResponse]
+
+	// DeleteFunc, if set, handles calls to Delete.
+	DeleteFunc    func(ctx context.Context, body redirecturls.DeleteRequest) (*redirecturls.DeleteResponse, error)
+	deleteCalls   []redirecturls.DeleteRequest
+	deleteReturns *result[redirecturls.DeleteResponse]
+
+	// GetFunc, if set, handles calls to Get.
+	GetFunc    func(ctx context.Context, body redirecturls.GetRequest) (*redirecturls.GetResponse, error)
+	getCalls   []redirecturls.GetRequest
+	getReturns *result[redirecturls.GetResponse]
+
+	// GetAllFunc, if set, handles calls to GetAll.
+	GetAllFunc    func(ctx context.Context, body redirecturls.GetAllRequest) (*redirecturls.GetAllResponse, error)
+	getAllCalls   []redirecturls.GetAllRequest
+	getAllReturns *result[redirecturls.GetAllResponse]
+
+	// UpdateFunc, if set, handles calls to Update.
+	UpdateFunc    func(ctx context.Context, body redirecturls.UpdateRequest) (*redirecturls.UpdateResponse, error)
+	updateCalls   []redirecturls.UpdateRequest
+	updateReturns *result[redirecturls.UpdateResponse]
+}
+
+var _ api.RedirectURLsAPI = (*RedirectURLsAPI)(nil)
+
+// Create records the request and returns the programmed response.
+func (f *RedirectURLsAPI) Create(ctx context.Context, body redirecturls.CreateRequest) (*redirecturls.CreateResponse, error) {
+	f.mu.Lock()
+	f.createCalls = append(f.createCalls, body)
+	fn, ret := f.CreateFunc, f.createReturns
+	f.mu.Unlock()
+	f.recorder.record("RedirectURLs.Create", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// CreateReturns programs Create to return resp and err.
+func (f *RedirectURLsAPI) CreateReturns(resp *redirecturls.CreateResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.createReturns = &result[redirecturls.CreateResponse]{resp: resp, err: err}
+}
+
+// CreateCalls returns the requests passed to Create, in order.
+func (f *RedirectURLsAPI) CreateCalls() []redirecturls.CreateRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]redirecturls.CreateRequest(nil), f.createCalls...)
+}
+
+// Delete records the request and returns the programmed response.
+func (f *RedirectURLsAPI) Delete(ctx context.Context, body redirecturls.DeleteRequest) (*redirecturls.DeleteResponse, error) {
+	f.mu.Lock()
+	f.deleteCalls = append(f.deleteCalls, body)
+	fn, ret := f.DeleteFunc, f.deleteReturns
+	f.mu.Unlock()
+	f.recorder.record("RedirectURLs.Delete", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// DeleteReturns programs Delete to return resp and err.
+func (f *RedirectURLsAPI) DeleteReturns(resp *redirecturls.DeleteResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.deleteReturns = &result[redirecturls.DeleteResponse]{resp: resp, err: err}
+}
+
+// DeleteCalls returns the requests passed to Delete, in order.
+func (f *RedirectURLsAPI) DeleteCalls() []redirecturls.DeleteRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]redirecturls.DeleteRequest(nil), f.deleteCalls...)
+}
+
+// Get records the request and returns the programmed response.
+func (f *RedirectURLsAPI) Get(ctx context.Context, body redirecturls.GetRequest) (*redirecturls.GetResponse, error) {
+	f.mu.Lock()
+	f.getCalls = append(f.getCalls, body)
+	fn, ret := f.GetFunc, f.getReturns
+	f.mu.Unlock()
+	f.recorder.record("RedirectURLs.Get", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// GetReturns programs Get to return resp and err.
+func (f *RedirectURLsAPI) GetReturns(resp *redirecturls.GetResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getReturns = &result[redirecturls.GetResponse]{resp: resp, err: err}
+}
+
+// GetCalls returns the requests passed to Get, in order.
+func (f *RedirectURLsAPI) GetCalls() []redirecturls.GetRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]redirecturls.GetRequest(nil), f.getCalls...)
+}
+
+// GetAll records the request and returns the programmed response.
+func (f *RedirectURLsAPI) GetAll(ctx context.Context, body redirecturls.GetAllRequest) (*redirecturls.GetAllResponse, error) {
+	f.mu.Lock()
+	f.getAllCalls = append(f.getAllCalls, body)
+	fn, ret := f.GetAllFunc, f.getAllReturns
+	f.mu.Unlock()
+	f.recorder.record("RedirectURLs.GetAll", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// GetAllReturns programs GetAll to return resp and err.
+func (f *RedirectURLsAPI) GetAllReturns(resp *redirecturls.GetAllResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getAllReturns = &result[redirecturls.GetAllResponse]{resp: resp, err: err}
+}
+
+// GetAllCalls returns the requests passed to GetAll, in order.
+func (f *RedirectURLsAPI) GetAllCalls() []redirecturls.GetAllRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]redirecturls.GetAllRequest(nil), f.getAllCalls...)
+}
+
+// Update records the request and returns the programmed response.
+func (f *RedirectURLsAPI) Update(ctx context.Context, body redirecturls.UpdateRequest) (*redirecturls.UpdateResponse, error) {
+	f.mu.Lock()
+	f.updateCalls = append(f.updateCalls, body)
+	fn, ret := f.UpdateFunc, f.updateReturns
+	f.mu.Unlock()
+	f.recorder.record("RedirectURLs.Update", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// UpdateReturns programs Update to return resp and err.
+func (f *RedirectURLsAPI) UpdateReturns(resp *redirecturls.UpdateResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.updateReturns = &result[redirecturls.UpdateResponse]{resp: resp, err: err}
+}
+
+// UpdateCalls returns the requests passed to Update, in order.
+func (f *RedirectURLsAPI) UpdateCalls() []redirecturls.UpdateRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]redirecturls.UpdateRequest(nil), f.updateCalls...)
+}
+
+// SDKAPI is a fake implementation of api.SDKAPI. Each method records its request and
+// then returns, in order of precedence, the result of the method's Func field, the response set
+// with the method's Returns function, or an empty response.
+type SDKAPI struct {
+	recorder *recorder
+	mu       sync.Mutex
+
+	// GetB2BConfigFunc, if set, handles calls to GetB2BConfig.
+	GetB2BConfigFunc    func(ctx context.Context, body sdk.GetB2BConfigRequest) (*sdk.GetB2BConfigResponse, error)
+	getB2BConfigCalls   []sdk.GetB2BConfigRequest
+	getB2BConfigReturns *result[sdk.GetB2BConfigResponse]
+
+	// GetConsumerConfigFunc, if set, handles calls to GetConsumerConfig.
+	GetConsumerConfigFunc    func(ctx context.Context, body sdk.GetConsumerConfigRequest) (*sdk.GetConsumerConfigResponse, error)
+	getConsumerConfigCalls   []sdk.GetConsumerConfigRequest
+	getConsumerConfigReturns *result[sdk.GetConsumerConfigResponse]
+
+	// SetB2BConfigFunc, if set, handles calls to SetB2BConfig.
+	SetB2BConfigFunc    func(ctx context.Context, body sdk.SetB2BConfigRequest) (*sdk.SetB2BConfigResponse, error)
+	setB2BConfigCalls   []sdk.SetB2BConfigRequest
+	setB2BConfigReturns *result[sdk.SetB2BConfigResponse]
+
+	// SetConsumerConfigFunc, if set, handles calls to SetConsumerConfig.
+	SetConsumerConfigFunc    func(ctx context.Context, body sdk.SetConsumerConfigRequest) (*sdk.SetConsumerConfigResponse, error)
+	setConsumerConfigCalls   []sdk.SetConsumerConfigRequest
+	setConsumerConfigReturns *result[sdk.SetConsumerConfigResponse]
+}
+
+var _ api.SDKAPI = (*SDKAPI)(nil)
+
+// GetB2BConfig records the request and returns the programmed response.
+func (f *SDKAPI) GetB2BConfig(ctx context.Context, body sdk.GetB2BConfigRequest) (*sdk.GetB2BConfigResponse, error) {
+	f.mu.Lock()
+	f.getB2BConfigCalls = append(f.getB2BConfigCalls, body)
+	fn, ret := f.GetB2BConfigFunc, f.getB2BConfigReturns
+	f.mu.Unlock()
+	f.recorder.record("SDK.GetB2BConfig", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// GetB2BConfigReturns programs GetB2BConfig to return resp and err.
+func (f *SDKAPI) GetB2BConfigReturns(resp *sdk.GetB2BConfigResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getB2BConfigReturns = &result[sdk.GetB2BConfigResponse]{resp: resp, err: err}
+}
+
+// GetB2BConfigCalls returns the requests passed to GetB2BConfig, in order.
+func (f *SDKAPI) GetB2BConfigCalls() []sdk.GetB2BConfigRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]sdk.GetB2BConfigRequest(nil), f.getB2BConfigCalls...)
+}
+
+// GetConsumerConfig records the request and returns the programmed response.
+func (f *SDKAPI) GetConsumerConfig(ctx context.Context, body sdk.GetConsumerConfigRequest) (*sdk.GetConsumerConfigResponse, error) {
+	f.mu.Lock()
+	f.getConsumerConfigCalls = append(f.getConsumerConfigCalls, body)
+	fn, ret := f.GetConsumerConfigFunc, f.getConsumerConfigReturns
+	f.mu.Unlock()
+	f.recorder.record("SDK.GetConsumerConfig", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// GetConsumerConfigReturns programs GetConsumerConfig to return resp and err.
+func (f *SDKAPI) GetConsumerConfigReturns(resp *sdk.GetConsumerConfigResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getConsumerConfigReturns = &result[sdk.GetConsumerConfigResponse]{resp: resp, err: err}
+}
+
+// GetConsumerConfigCalls returns the requests passed to GetConsumerConfig, in order.
+func (f *SDKAPI) GetConsumerConfigCalls() []sdk.GetConsumerConfigRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]sdk.GetConsumerConfigRequest(nil), f.getConsumerConfigCalls...)
+}
+
+// SetB2BConfig records the request and returns the programmed response.
+func (f *SDKAPI) SetB2BConfig(ctx context.Context, body sdk.SetB2BConfigRequest) (*sdk.SetB2BConfigResponse, error) {
+	f.mu.Lock()
+	f.setB2BConfigCalls = append(f.setB2BConfigCalls, body)
+	fn, ret := f.SetB2BConfigFunc, f.setB2BConfigReturns
+	f.mu.Unlock()
+	f.recorder.record("SDK.SetB2BConfig", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// SetB2BConfigReturns programs SetB2BConfig to return resp and err.
+func (f *SDKAPI) SetB2BConfigReturns(resp *sdk.SetB2BConfigResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.setB2BConfigReturns = &result[sdk.SetB2BConfigResponse]{resp: resp, err: err}
+}
+
+// SetB2BConfigCalls returns the requests passed to SetB2BConfig, in order.
+func (f *SDKAPI) SetB2BConfigCalls() []sdk.SetB2BConfigRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]sdk.SetB2BConfigRequest(nil), f.setB2BConfigCalls...)
+}
+
+// SetConsumerConfig records the request and returns the programmed response.
+func (f *SDKAPI) SetConsumerConfig(ctx context.Context, body sdk.SetConsumerConfigRequest) (*sdk.SetConsumerConfigResponse, error) {
+	f.mu.Lock()
+	f.setConsumerConfigCalls = append(f.setConsumerConfigCalls, body)
+	fn, ret := f.SetConsumerConfigFunc, f.setConsumerConfigReturns
+	f.mu.Unlock()
+	f.recorder.record("SDK.SetConsumerConfig", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// SetConsumerConfigReturns programs SetConsumerConfig to return resp and err.
+func (f *SDKAPI) SetConsumerConfigReturns(resp *sdk.SetConsumerConfigResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.setConsumerConfigReturns = &result[sdk.SetConsumerConfigResponse]{resp: resp, err: err}
+}
+
+// SetConsumerConfigCalls returns the requests passed to SetConsumerConfig, in order.
+func (f *SDKAPI) SetConsumerConfigCalls() []sdk.SetConsumerConfigRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]sdk.SetConsumerConfigRequest(nil), f.setConsumerConfigCalls...)
+}
+
+// SecretsAPI is a fake implementation of api.SecretsAPI. Each method records its request and
+// then returns, in order of precedence, the result of the method's Func field, the response set
+// with the method's Returns function, or an empty response.
+type SecretsAPI struct {
+	recorder *recorder
+	mu       sync.Mutex
+
+	// CreateFunc, if set, handles calls to Create.
+	CreateFunc    func(ctx context.Context, body secrets.CreateRequest) (*secrets.CreateResponse, error)
+	createCalls   []secrets.CreateRequest
+	createReturns *result[secrets.CreateResponse]
+
+	// DeleteFunc, if set, handles calls to Delete.
+	DeleteFunc    func(ctx context.Context, body secrets.DeleteRequest) (*secrets.DeleteResponse, error)
+	deleteCalls   []secrets.DeleteRequest
+	deleteReturns *result[secrets.DeleteResponse]
+
+	// GetFunc, if set, handles calls to Get.
+	GetFunc    func(ctx context.Context, body secrets.GetRequest) (*secrets.GetResponse, error)
+	getCalls   []secrets.GetRequest
+	getReturns *result[secrets.GetResponse]
+
+	// GetAllFunc, if set, handles calls to GetAll.
+	GetAllFunc    func(ctx context.Context, body secrets.GetAllRequest) (*secrets.GetAllResponse, error)
+	getAllCalls   []secrets.GetAllRequest
+	getAllReturns *result[secrets.GetAllResponse]
+}
+
+var _ api.SecretsAPI = (*SecretsAPI)(nil)
+
+// Create records the request and returns the programmed response.
+func (f *SecretsAPI) Create(ctx context.Context, body secrets.CreateRequest) (*secrets.CreateResponse, error) {
+	f.mu.Lock()
+	f.createCalls = append(f.createCalls, body)
+	fn, ret := f.CreateFunc, f.createReturns
+	f.mu.Unlock()
+	f.recorder.record("Secrets.Create", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// CreateReturns programs Create to return resp and err.
+func (f *SecretsAPI) CreateReturns(resp *secrets.CreateResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.createReturns = &result[secrets.CreateResponse]{resp: resp, err: err}
+}
+
+// CreateCalls returns the requests passed to Create, in order.
+func (f *SecretsAPI) CreateCalls() []secrets.CreateRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]secrets.CreateRequest(nil), f.createCalls...)
+}
+
+// Delete records the request and returns the programmed response.
+func (f *SecretsAPI) Delete(ctx context.Context, body secrets.DeleteRequest) (*secrets.DeleteResponse, error) {
+	f.mu.Lock()
+	f.deleteCalls = append(f.deleteCalls, body)
+	fn, ret := f.DeleteFunc, f.deleteReturns
+	f.mu.Unlock()
+	f.recorder.record("Secrets.Delete", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// DeleteReturns programs Delete to return resp and err.
+func (f *SecretsAPI) DeleteReturns(resp *secrets.DeleteResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.deleteReturns = &result[secrets.DeleteResponse]{resp: resp, err: err}
+}
+
+// DeleteCalls returns the requests passed to Delete, in order.
+func (f *SecretsAPI) DeleteCalls() []secrets.DeleteRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]secrets.DeleteRequest(nil), f.deleteCalls...)
+}
+
+// Get records the request and returns the programmed response.
+func (f *SecretsAPI) Get(ctx context.Context, body secrets.GetRequest) (*secrets.GetResponse, error) {
+	f.mu.Lock()
+	f.getCalls = append(f.getCalls, body)
+	fn, ret := f.GetFunc, f.getReturns
+	f.mu.Unlock()
+	f.recorder.record("Secrets.Get", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// GetReturns programs Get to return resp and err.
+func (f *SecretsAPI) GetReturns(resp *secrets.GetResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getReturns = &result[secrets.GetResponse]{resp: resp, err: err}
+}
+
+// GetCalls returns the requests passed to Get, in order.
+func (f *SecretsAPI) GetCalls() []secrets.GetRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]secrets.GetRequest(nil), f.getCalls...)
+}
+
+// GetAll records the request and returns the programmed response.
+func (f *SecretsAPI) GetAll(ctx context.Context, body secrets.GetAllRequest) (*secrets.GetAllResponse, error) {
+	f.mu.Lock()
+	f.getAllCalls = append(f.getAllCalls, body)
+	fn, ret := f.GetAllFunc, f.getAllReturns
+	f.mu.Unlock()
+	f.recorder.record("Secrets.GetAll", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// GetAllReturns programs GetAll to return resp and err.
+func (f *SecretsAPI) GetAllReturns(resp *secrets.GetAllResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getAllReturns = &result[secrets.GetAllResponse]{resp: resp, err: err}
+}
+
+// GetAllCalls returns the requests passed to GetAll, in order.
+func (f *SecretsAPI) GetAllCalls() []secrets.GetAllRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]secrets.GetAllRequest(nil), f.getAllCalls...)
+}
+
+// TrustedTokenProfilesAPI is a fake implementation of api.TrustedTokenProfilesAPI. Each method records its request and
+// then returns, in order of precedence, the result of the method's Func field, the response set
+// with the method's Returns function, or an empty response.
+type TrustedTokenProfilesAPI struct {
+	recorder *recorder
+	mu       sync.Mutex
+
+	// CreateFunc, if set, handles calls to Create.
+	CreateFunc    func(ctx context.Context, body trustedtokenprofiles.CreateRequest) (*trustedtokenprofiles.CreateResponse, error)
+	createCalls   []trustedtokenprofiles.CreateRequest
+	createReturns *result[trustedtokenprofiles.CreateResponse]
+
+	// CreatePEMFileFunc, if set, handles calls to CreatePEMFile.
+	CreatePEMFileFunc    func(ctx context.Context, body trustedtokenprofiles.CreatePEMFileRequest) (*trustedtokenprofiles.CreatePEMFileResponse, error)
+	createPEMFileCalls   []trustedtokenprofiles.CreatePEMFileRequest
+	createPEMFileReturns *result[trustedtokenprofiles.CreatePEMFileResponse]
+
+	// DeleteFunc, if set, handles calls to Delete.
+	DeleteFunc    func(ctx context.Context, body trustedtokenprofiles.DeleteRequest) (*trustedtokenprofiles.DeleteResponse, error)
+	deleteCalls   []trustedtokenprofiles.DeleteRequest
+	deleteReturns *result[trustedtokenprofiles.DeleteResponse]
+
+	// DeletePEMFileFunc, if set, handles calls to DeletePEMFile.
+	DeletePEMFileFunc    func(ctx context.Context, body trustedtokenprofiles.DeletePEMFileRequest) (*trustedtokenprofiles.DeletePEMFileResponse, error)
+	deletePEMFileCalls   []trustedtokenprofiles.DeletePEMFileRequest
+	deletePEMFileReturns *result[trustedtokenprofiles.DeletePEMFileResponse]
+
+	// GetFunc, if set, handles calls to Get.
+	GetFunc    func(ctx context.Context, body trustedtokenprofiles.GetRequest) (*trustedtokenprofiles.GetResponse, error)
+	getCalls   []trustedtokenprofiles.GetRequest
+	getReturns *result[trustedtokenprofiles.GetResponse]
+
+	// GetAllFunc, if set, handles calls to GetAll.
+	GetAllFunc    func(ctx context.Context, body trustedtokenprofiles.GetAllRequest) (*trustedtokenprofiles.GetAllResponse, error)
+	getAllCalls   []trustedtokenprofiles.GetAllRequest
+	getAllReturns *result[trustedtokenprofiles.GetAllResponse]
+
+	// GetPEMFileFunc, if set, handles calls to GetPEMFile.
+	GetPEMFileFunc    func(ctx context.Context, body trustedtokenprofiles.GetPEMFileRequest) (*trustedtokenprofiles.GetPEMFileResponse, error)
+	getPEMFileCalls   []trustedtokenprofiles.GetPEMFileRequest
+	getPEMFileReturns *result[trustedtokenprofiles.GetPEMFileResponse]
+
+	// UpdateFunc, if set, handles calls to Update.
+	UpdateFunc    func(ctx context.Context, body trustedtokenprofiles.UpdateRequest) (*trustedtokenprofiles.UpdateResponse, error)
+	updateCalls   []trustedtokenprofiles.UpdateRequest
+	updateReturns *result[trustedtokenprofiles.UpdateResponse]
+}
+
+var _ api.TrustedTokenProfilesAPI = (*TrustedTokenProfilesAPI)(nil)
+
+// Create records the request and returns the programmed response.
+func (f *TrustedTokenProfilesAPI) Create(ctx context.Context, body trustedtokenprofiles.CreateRequest) (*trustedtokenprofiles.CreateResponse, error) {
+	f.mu.Lock()
+	f.createCalls = append(f.createCalls, body)
+	fn, ret := f.CreateFunc, f.createReturns
+	f.mu.Unlock()
+	f.recorder.record("TrustedTokenProfiles.Create", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// CreateReturns programs Create to return resp and err.
+func (f *TrustedTokenProfilesAPI) CreateReturns(resp *trustedtokenprofiles.CreateResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.createReturns = &result[trustedtokenprofiles.CreateResponse]{resp: resp, err: err}
+}
+
+// CreateCalls returns the requests passed to Create, in order.
+func (f *TrustedTokenProfilesAPI) CreateCalls() []trustedtokenprofiles.CreateRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]trustedtokenprofiles.CreateRequest(nil), f.createCalls...)
+}
+
+// CreatePEMFile records the request and returns the programmed response.
+func (f *TrustedTokenProfilesAPI) CreatePEMFile(ctx context.Context, body trustedtokenprofiles.CreatePEMFileRequest) (*trustedtokenprofiles.CreatePEMFileResponse, error) {
+	f.mu.Lock()
+	f.createPEMFileCalls = append(f.createPEMFileCalls, body)
+	fn, ret := f.CreatePEMFileFunc, f.createPEMFileReturns
+	f.mu.Unlock()
+	f.recorder.record("TrustedTokenProfiles.CreatePEMFile", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// CreatePEMFileReturns programs CreatePEMFile to return resp and err.
+func (f *TrustedTokenProfilesAPI) CreatePEMFileReturns(resp *trustedtokenprofiles.CreatePEMFileResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.createPEMFileReturns = &result[trustedtokenprofiles.CreatePEMFileResponse]{resp: resp, err: err}
+}
+
+// CreatePEMFileCalls returns the requests passed to CreatePEMFile, in order.
+func (f *TrustedTokenProfilesAPI) CreatePEMFileCalls() []trustedtokenprofiles.CreatePEMFileRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]trustedtokenprofiles.CreatePEMFileRequest(nil), f.createPEMFileCalls...)
+}
+
+// Delete records the request and returns the programmed response.
+func (f *TrustedTokenProfilesAPI) Delete(ctx context.Context, body trustedtokenprofiles.DeleteRequest) (*trustedtokenprofiles.DeleteResponse, error) {
+	f.mu.Lock()
+	f.deleteCalls = append(f.deleteCalls, body)
+	fn, ret := f.DeleteFunc, f.deleteReturns
+	f.mu.Unlock()
+	f.recorder.record("TrustedTokenProfiles.Delete", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// DeleteReturns programs Delete to return resp and err.
+func (f *TrustedTokenProfilesAPI) DeleteReturns(resp *trustedtokenprofiles.DeleteResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.deleteReturns = &result[trustedtokenprofiles.DeleteResponse]{resp: resp, err: err}
+}
+
+// DeleteCalls returns the requests passed to Delete, in order.
+func (f *TrustedTokenProfilesAPI) DeleteCalls() []trustedtokenprofiles.DeleteRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]trustedtokenprofiles.DeleteRequest(nil), f.deleteCalls...)
+}
+
+// DeletePEMFile records the request and returns the programmed response.
+func (f *TrustedTokenProfilesAPI) DeletePEMFile(ctx context.Context, body trustedtokenprofiles.DeletePEMFileRequest) (*trustedtokenprofiles.DeletePEMFileResponse, error) {
+	f.mu.Lock()
+	f.deletePEMFileCalls = append(f.deletePEMFileCalls, body)
+	fn, ret := f.DeletePEMFileFunc, f.deletePEMFileReturns
+	f.mu.Unlock()
+	f.recorder.record("TrustedTokenProfiles.DeletePEMFile", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// DeletePEMFileReturns programs DeletePEMFile to return resp and err.
+func (f *TrustedTokenProfilesAPI) DeletePEMFileReturns(resp *trustedtokenprofiles.DeletePEMFileResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.deletePEMFileReturns = &result[trustedtokenprofiles.DeletePEMFileResponse]{resp: resp, err: err}
+}
+
+// DeletePEMFileCalls returns the requests passed to DeletePEMFile, in order.
+func (f *TrustedTokenProfilesAPI) DeletePEMFileCalls() []trustedtokenprofiles.DeletePEMFileRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]trustedtokenprofiles.DeletePEMFileRequest(nil), f.deletePEMFileCalls...)
+}
+
+// Get records the request and returns the programmed response.
+func (f *TrustedTokenProfilesAPI) Get(ctx context.Context, body trustedtokenprofiles.GetRequest) (*trustedtokenprofiles.GetResponse, error) {
+	f.mu.Lock()
+	f.getCalls = append(f.getCalls, body)
+	fn, ret := f.GetFunc, f.getReturns
+	f.mu.Unlock()
+	f.recorder.record("TrustedTokenProfiles.Get", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// GetReturns programs Get to return resp and err.
+func (f *TrustedTokenProfilesAPI) GetReturns(resp *trustedtokenprofiles.GetResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getReturns = &result[trustedtokenprofiles.GetResponse]{resp: resp, err: err}
+}
+
+// GetCalls returns the requests passed to Get, in order.
+func (f *TrustedTokenProfilesAPI) GetCalls() []trustedtokenprofiles.GetRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]trustedtokenprofiles.GetRequest(nil), f.getCalls...)
+}
+
+// GetAll records the request and returns the programmed response.
+func (f *TrustedTokenProfilesAPI) GetAll(ctx context.Context, body trustedtokenprofiles.GetAllRequest) (*trustedtokenprofiles.GetAllResponse, error) {
+	f.mu.Lock()
+	f.getAllCalls = append(f.getAllCalls, body)
+	fn, ret := f.GetAllFunc, f.getAllReturns
+	f.mu.Unlock()
+	f.recorder.record("TrustedTokenProfiles.GetAll", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// GetAllReturns programs GetAll to return resp and err.
+func (f *TrustedTokenProfilesAPI) GetAllReturns(resp *trustedtokenprofiles.GetAllResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getAllReturns = &result[trustedtokenprofiles.GetAllResponse]{resp: resp, err: err}
+}
+
+// GetAllCalls returns the requests passed to GetAll, in order.
+func (f *TrustedTokenProfilesAPI) GetAllCalls() []trustedtokenprofiles.GetAllRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]trustedtokenprofiles.GetAllRequest(nil), f.getAllCalls...)
+}
+
+// GetPEMFile records the request and returns the programmed response.
+func (f *TrustedTokenProfilesAPI) GetPEMFile(ctx context.Context, body trustedtokenprofiles.GetPEMFileRequest) (*trustedtokenprofiles.GetPEMFileResponse, error) {
+	f.mu.Lock()
+	f.getPEMFileCalls = append(f.getPEMFileCalls, body)
+	fn, ret := f.GetPEMFileFunc, f.getPEMFileReturns
+	f.mu.Unlock()
+	f.recorder.record("TrustedTokenProfiles.GetPEMFile", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// GetPEMFileReturns programs GetPEMFile to return resp and err.
+func (f *TrustedTokenProfilesAPI) GetPEMFileReturns(resp *trustedtokenprofiles.GetPEMFileResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getPEMFileReturns = &result[trustedtokenprofiles.GetPEMFileResponse]{resp: resp, err: err}
+}
+
+// GetPEMFileCalls returns the requests passed to GetPEMFile, in order.
+func (f *TrustedTokenProfilesAPI) GetPEMFileCalls() []trustedtokenprofiles.GetPEMFileRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]trustedtokenprofiles.GetPEMFileRequest(nil), f.getPEMFileCalls...)
+}
+
+// Update records the request and returns the programmed response.
+func (f *TrustedTokenProfilesAPI) Update(ctx context.Context, body trustedtokenprofiles.UpdateRequest) (*trustedtokenprofiles.UpdateResponse, error) {
+	f.mu.Lock()
+	f.updateCalls = append(f.updateCalls, body)
+	fn, ret := f.UpdateFunc, f.updateReturns
+	f.mu.Unlock()
+	f.recorder.record("TrustedTokenProfiles.Update", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// UpdateReturns programs Update to return resp and err.
+func (f *TrustedTokenProfilesAPI) UpdateReturns(resp *trustedtokenprofiles.UpdateResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.updateReturns = &result[trustedtokenprofiles.UpdateResponse]{resp: resp, err: err}
+}
+
+// UpdateCalls returns the requests passed to Update, in order.
+func (f *TrustedTokenProfilesAPI) UpdateCalls() []trustedtokenprofiles.UpdateRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]trustedtokenprofiles.UpdateRequest(nil), f.updateCalls...)
+}
+
+// V1ToV3MigrationAPI is a fake implementation of api.V1ToV3MigrationAPI. Each method records its request and
+// then returns, in order of precedence, the result of the method's Func field, the response set
+// with the method's Returns function, or an empty response.
+type V1ToV3MigrationAPI struct {
+	recorder *recorder
+	mu       sync.Mutex
+
+	// GetProjectFunc, if set, handles calls to GetProject.
+	GetProjectFunc    func(ctx context.Context, body migrationprojects.GetProjectRequest) (*migrationprojects.GetProjectResponse, error)
+	getProjectCalls   []migrationprojects.GetProjectRequest
+	getProjectReturns *result[migrationprojects.GetProjectResponse]
+
+	// GetProjectsFunc, if set, handles calls to GetProjects.
+	GetProjectsFunc    func(ctx context.Context, body migrationprojects.GetProjectsRequest) (*migrationprojects.GetProjectsResponse, error)
+	getProjectsCalls   []migrationprojects.GetProjectsRequest
+	getProjectsReturns *result[migrationprojects.GetProjectsResponse]
+}
+
+var _ api.V1ToV3MigrationAPI = (*V1ToV3MigrationAPI)(nil)
+
+// GetProject records the request and returns the programmed response.
+func (f *V1ToV3MigrationAPI) GetProject(ctx context.Context, body migrationprojects.GetProjectRequest) (*migrationprojects.GetProjectResponse, error) {
+	f.mu.Lock()
+	f.getProjectCalls = append(f.getProjectCalls, body)
+	fn, ret := f.GetProjectFunc, f.getProjectReturns
+	f.mu.Unlock()
+	f.recorder.record("V1ToV3MigrationClient.GetProject", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// GetProjectReturns programs GetProject to return resp and err.
+func (f *V1ToV3MigrationAPI) GetProjectReturns(resp *migrationprojects.GetProjectResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getProjectReturns = &result[migrationprojects.GetProjectResponse]{resp: resp, err: err}
+}
+
+// GetProjectCalls returns the requests passed to GetProject, in order.
+func (f *V1ToV3MigrationAPI) GetProjectCalls() []migrationprojects.GetProjectRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]migrationprojects.GetProjectRequest(nil), f.getProjectCalls...)
+}
+
+// GetProjects records the request and returns the programmed response.
+func (f *V1ToV3MigrationAPI) GetProjects(ctx context.Context, body migrationprojects.GetProjectsRequest) (*migrationprojects.GetProjectsResponse, error) {
+	f.mu.Lock()
+	f.getProjectsCalls = append(f.getProjectsCalls, body)
+	fn, ret := f.GetProjectsFunc, f.getProjectsReturns
+	f.mu.Unlock()
+	f.recorder.record("V1ToV3MigrationClient.GetProjects", body)
+	return respond(ctx, body, fn, ret)
+}
+
+// GetProjectsReturns programs GetProjects to return resp and err.
+func (f *V1ToV3MigrationAPI) GetProjectsReturns(resp *migrationprojects.GetProjectsResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getProjectsReturns = &result[migrationprojects.GetProjectsResponse]{resp: resp, err: err}
+}
+
+// GetProjectsCalls returns the requests passed to GetProjects, in order.
+func (f *V1ToV3MigrationAPI) GetProjectsCalls() []migrationprojects.GetProjectsRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]migrationprojects.GetProjectsRequest(nil), f.getProjectsCalls...)
+}
@@ -0,0 +1,99 @@
+// Package apitest provides helpers for integration tests that run against a live Stytch workspace.
+//
+// Tests get a client with NewClient, which skips the test unless workspace credentials are set in
+// the environment, and then create the resources they need with the Disposable* and fixture
+// methods. Everything created is removed with t.Cleanup when the test finishes:
+//
+//	func TestMyIntegration(t *testing.T) {
+//		client := apitest.NewClient(t)
+//		env := client.DisposableEnvironment(projects.VerticalB2B, environments.EnvironmentTypeTest)
+//		secret := client.CreateSecret(env)
+//		// ...
+//	}
+//
+// Cleanups do not run when the test binary is killed, for example when a test times out. Call
+// Sweep, typically from TestMain, to delete disposable projects left behind by earlier runs.
+package apitest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/stytcherror"
+)
+
+// Environment variables read by NewClient.
+const (
+	EnvWorkspaceKeyID     = "STYTCH_WORKSPACE_KEY_ID"
+	EnvWorkspaceKeySecret = "STYTCH_WORKSPACE_KEY_SECRET"
+	EnvWorkspaceBaseURI   = "STYTCH_WORKSPACE_BASE_URI"
+)
+
+// NamePrefix starts the name of every project created by DisposableProject. Sweep only deletes
+// projects whose names start with it.
+const NamePrefix = "apitest-"
+
+// LiveEnvironment is the slug and name of the live environment created in disposable projects. It
+// matches the first live environment created when a project is created from the dashboard.
+const LiveEnvironment = "production"
+
+// maxTestNameLength bounds the part of a disposable resource name taken from the test name.
+const maxTestNameLength = 40
+
+// Client is an API client bound to a single test.
+type Client struct {
+	*api.API
+	t testing.TB
+}
+
+// NewClient returns a client for the workspace identified by the STYTCH_WORKSPACE_KEY_ID and
+// STYTCH_WORKSPACE_KEY_SECRET environment variables, and STYTCH_WORKSPACE_BASE_URI if set. The test
+// is skipped if the credentials are not set.
+func NewClient(t testing.TB, opts ...api.APIOption) *Client {
+	t.Helper()
+
+	keyID := os.Getenv(EnvWorkspaceKeyID)
+	keySecret := os.Getenv(EnvWorkspaceKeySecret)
+	if keyID == "" || keySecret == "" {
+		t.Skipf("%s and %s environment variables are required for this test", EnvWorkspaceKeyID, EnvWorkspaceKeySecret)
+	}
+
+	if baseURI := os.Getenv(EnvWorkspaceBaseURI); baseURI != "" {
+		opts = append([]api.APIOption{api.WithBaseURI(baseURI)}, opts...)
+	}
+
+	return &Client{
+		API: api.NewClient(keyID, keySecret, opts...),
+		t:   t,
+	}
+}
+
+// UniqueName returns a name starting with NamePrefix that is unique to this call and includes the
+// test name, so leaked resources can be traced back to the test that created them.
+func UniqueName(t testing.TB) string {
+	name := t.Name()
+	if len(name) > maxTestNameLength {
+		name = name[:maxTestNameLength]
+	}
+	return fmt.Sprintf("%s%s %s", NamePrefix, randomSuffix(), name)
+}
+
+func randomSuffix() string {
+	b := make([]byte, 4)
+	if _, err := rand.Read(b); err != nil {
+		panic(fmt.Sprintf("apitest: reading random bytes: %v", err))
+	}
+	return hex.EncodeToString(b)
+}
+
+// isNotFound reports whether err is a Stytch 404, which cleanups treat as already cleaned up.
+func isNotFound(err error) bool {
+	var stytchErr stytcherror.Error
+	return errors.As(err, &stytchErr) && stytchErr.StatusCode == http.StatusNotFound
+}
@@ -0,0 +1,83 @@
+package apitest
+
+import (
+	"context"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/environments"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
+)
+
+// DisposableProject creates a uniquely named project with a live environment and deletes it when
+// the test finishes.
+func (c *Client) DisposableProject(vertical projects.Vertical) projects.Project {
+	c.t.Helper()
+	ctx := context.Background()
+
+	resp, err := c.Projects.Create(ctx, projects.CreateRequest{
+		Name:     UniqueName(c.t),
+		Vertical: vertical,
+	})
+	if err != nil {
+		c.t.Fatalf("creating disposable project: %v", err)
+	}
+	project := resp.Project
+
+	// Register the cleanup before anything else can fail so the project is never leaked.
+	c.t.Cleanup(func() {
+		_, err := c.Projects.Delete(context.Background(), projects.DeleteRequest{
+			ProjectSlug: project.ProjectSlug,
+		})
+		if err != nil && !isNotFound(err) {
+			c.t.Errorf("deleting disposable project %s: %v", project.ProjectSlug, err)
+		}
+	})
+
+	// Create a live environment since otherwise we cannot create disposable test environments.
+	liveSlug := LiveEnvironment
+	_, err = c.Environments.Create(ctx, environments.CreateRequest{
+		ProjectSlug:     project.ProjectSlug,
+		Name:            LiveEnvironment,
+		Type:            environments.EnvironmentTypeLive,
+		EnvironmentSlug: &liveSlug,
+	})
+	if err != nil {
+		c.t.Fatalf("creating live environment in disposable project %s: %v", project.ProjectSlug, err)
+	}
+
+	return project
+}
+
+// DisposableEnvironment returns an environment of the given type in a new disposable project. The
+// environment is deleted along with the project when the test finishes.
+func (c *Client) DisposableEnvironment(
+	vertical projects.Vertical, environmentType environments.EnvironmentType,
+) environments.Environment {
+	c.t.Helper()
+	project := c.DisposableProject(vertical)
+	ctx := context.Background()
+
+	envResp, err := c.Environments.GetAll(ctx, environments.GetAllRequest{
+		ProjectSlug: project.ProjectSlug,
+	})
+	if err != nil {
+		c.t.Fatalf("listing environments in disposable project %s: %v", project.ProjectSlug, err)
+	}
+
+	// Return an existing environment of the requested type if there is one.
+	for _, env := range envResp.Environments {
+		if env.Type == environmentType {
+			return env
+		}
+	}
+
+	// Otherwise, we need to create a new one because one of that type does not exist
+	createResp, err := c.Environments.Create(ctx, environments.CreateRequest{
+		ProjectSlug: project.ProjectSlug,
+		Name:        UniqueName(c.t),
+		Type:        environmentType,
+	})
+	if err != nil {
+		c.t.Fatalf("creating disposable environment in project %s: %v", project.ProjectSlug, err)
+	}
+	return createResp.Environment
+}
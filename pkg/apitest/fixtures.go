@@ -0,0 +1,115 @@
+package apitest
+
+import (
+	"context"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/environments"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/rbacpolicy"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/redirecturls"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/secrets"
+)
+
+// CreateSecret creates a secret in env and deletes it when the test finishes. The returned value includes
+// the full secret, which is only available at creation.
+func (c *Client) CreateSecret(env environments.Environment) secrets.Secret {
+	c.t.Helper()
+	resp, err := c.Secrets.Create(context.Background(), secrets.CreateRequest{
+		ProjectSlug:     env.ProjectSlug,
+		EnvironmentSlug: env.EnvironmentSlug,
+	})
+	if err != nil {
+		c.t.Fatalf("creating secret in %s/%s: %v", env.ProjectSlug, env.EnvironmentSlug, err)
+	}
+
+	c.t.Cleanup(func() {
+		_, err := c.Secrets.Delete(context.Background(), secrets.DeleteRequest{
+			ProjectSlug:     env.ProjectSlug,
+			EnvironmentSlug: env.EnvironmentSlug,
+			SecretID:        resp.Secret.SecretID,
+		})
+		if err != nil && !isNotFound(err) {
+			c.t.Errorf("deleting secret %s: %v", resp.Secret.SecretID, err)
+		}
+	})
+	return resp.Secret
+}
+
+// CreateRedirectURL creates a redirect URL in env that is valid for the given types, or for LOGIN if no
+// types are given, and deletes it when the test finishes. The URL does not become the default for
+// any type unless it is the only URL of that type.
+func (c *Client) CreateRedirectURL(
+	env environments.Environment, url string, types ...redirecturls.RedirectURLType,
+) redirecturls.RedirectURL {
+	c.t.Helper()
+	if len(types) == 0 {
+		types = []redirecturls.RedirectURLType{redirecturls.RedirectURLTypeLogin}
+	}
+	validTypes := make([]redirecturls.URLType, len(types))
+	for i, typ := range types {
+		validTypes[i] = redirecturls.URLType{Type: typ}
+	}
+
+	resp, err := c.RedirectURLs.Create(context.Background(), redirecturls.CreateRequest{
+		ProjectSlug:     env.ProjectSlug,
+		EnvironmentSlug: env.EnvironmentSlug,
+		URL:             url,
+		ValidTypes:      validTypes,
+	})
+	if err != nil {
+		c.t.Fatalf("creating redirect URL %s in %s/%s: %v", url, env.ProjectSlug, env.EnvironmentSlug, err)
+	}
+
+	c.t.Cleanup(func() {
+		_, err := c.RedirectURLs.Delete(context.Background(), redirecturls.DeleteRequest{
+			ProjectSlug:     env.ProjectSlug,
+			EnvironmentSlug: env.EnvironmentSlug,
+			URL:             url,
+		})
+		if err != nil && !isNotFound(err) {
+			c.t.Errorf("deleting redirect URL %s: %v", url, err)
+		}
+	})
+	return resp.RedirectURL
+}
+
+// SetRBACPolicy replaces the RBAC policy of env with policy and restores the previous policy when the
+// test finishes. Stytch-managed resources in policy are ignored, as they cannot be set. It returns
+// the policy as stored by Stytch.
+func (c *Client) SetRBACPolicy(env environments.Environment, policy rbacpolicy.Policy) rbacpolicy.Policy {
+	c.t.Helper()
+	ctx := context.Background()
+
+	previous, err := c.RBACPolicy.Get(ctx, rbacpolicy.GetRequest{
+		ProjectSlug:     env.ProjectSlug,
+		EnvironmentSlug: env.EnvironmentSlug,
+	})
+	if err != nil {
+		c.t.Fatalf("getting RBAC policy of %s/%s: %v", env.ProjectSlug, env.EnvironmentSlug, err)
+	}
+
+	resp, err := c.RBACPolicy.Set(ctx, setRequest(env, policy))
+	if err != nil {
+		c.t.Fatalf("setting RBAC policy of %s/%s: %v", env.ProjectSlug, env.EnvironmentSlug, err)
+	}
+
+	c.t.Cleanup(func() {
+		_, err := c.RBACPolicy.Set(context.Background(), setRequest(env, previous.Policy))
+		if err != nil && !isNotFound(err) {
+			c.t.Errorf("restoring RBAC policy of %s/%s: %v", env.ProjectSlug, env.EnvironmentSlug, err)
+		}
+	})
+	return resp.Policy
+}
+
+func setRequest(env environments.Environment, policy rbacpolicy.Policy) rbacpolicy.SetRequest {
+	return rbacpolicy.SetRequest{
+		ProjectSlug:     env.ProjectSlug,
+		EnvironmentSlug: env.EnvironmentSlug,
+		StytchMember:    policy.StytchMember,
+		StytchAdmin:     policy.StytchAdmin,
+		StytchUser:      policy.StytchUser,
+		CustomRoles:     policy.CustomRoles,
+		CustomResources: policy.CustomResources,
+		CustomScopes:    policy.CustomScopes,
+	}
+}
@@ -0,0 +1,49 @@
+package apitest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
+)
+
+// Sweep deletes disposable projects, identified by NamePrefix, that were created before cutoff.
+// Projects created by tests that are still running are newer than any sensible cutoff and are left
+// alone, so Sweep is safe to call while other test runs share the workspace. A cutoff an hour or
+// more in the past is a reasonable default:
+//
+//	func TestMain(m *testing.M) {
+//		client := api.NewClient(os.Getenv(apitest.EnvWorkspaceKeyID), os.Getenv(apitest.EnvWorkspaceKeySecret))
+//		if _, err := apitest.Sweep(context.Background(), client.Projects, time.Now().Add(-time.Hour)); err != nil {
+//			log.Printf("sweeping leaked projects: %v", err)
+//		}
+//		os.Exit(m.Run())
+//	}
+//
+// Sweep returns the projects it deleted. It attempts every deletion even if some fail, and returns
+// the failures joined together.
+func Sweep(ctx context.Context, client api.ProjectsAPI, cutoff time.Time) ([]projects.Project, error) {
+	resp, err := client.GetAll(ctx, projects.GetAllRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("listing projects: %w", err)
+	}
+
+	var deleted []projects.Project
+	var errs []error
+	for _, project := range resp.Projects {
+		if !strings.HasPrefix(project.Name, NamePrefix) || !project.CreatedAt.Before(cutoff) {
+			continue
+		}
+		_, err := client.Delete(ctx, projects.DeleteRequest{ProjectSlug: project.ProjectSlug})
+		if err != nil && !isNotFound(err) {
+			errs = append(errs, fmt.Errorf("deleting project %s: %w", project.ProjectSlug, err))
+			continue
+		}
+		deleted = append(deleted, project)
+	}
+	return deleted, errors.Join(errs...)
+}
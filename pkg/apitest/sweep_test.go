@@ -0,0 +1,76 @@
+package apitest_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/apifake"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/apitest"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/stytcherror"
+)
+
+func TestSweep(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	cutoff := now.Add(-time.Hour)
+
+	t.Run("deletes only old disposable projects", func(t *testing.T) {
+		// Arrange
+		fake := apifake.New()
+		fake.Projects.GetAllReturns(&projects.GetAllResponse{Projects: []projects.Project{
+			{ProjectSlug: "leaked", Name: apitest.NamePrefix + "0a1b2c3d TestLeaked", CreatedAt: now.Add(-2 * time.Hour)},
+			{ProjectSlug: "running", Name: apitest.NamePrefix + "4e5f6a7b TestRunning", CreatedAt: now.Add(-time.Minute)},
+			{ProjectSlug: "production", Name: "Production app", CreatedAt: now.Add(-48 * time.Hour)},
+		}}, nil)
+
+		// Act
+		deleted, err := apitest.Sweep(context.Background(), fake.Projects, cutoff)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, deleted, 1)
+		assert.Equal(t, "leaked", deleted[0].ProjectSlug)
+		assert.Equal(t, []projects.DeleteRequest{{ProjectSlug: "leaked"}}, fake.Projects.DeleteCalls())
+	})
+
+	t.Run("continues past failed deletions", func(t *testing.T) {
+		// Arrange
+		fake := apifake.New()
+		fake.Projects.GetAllReturns(&projects.GetAllResponse{Projects: []projects.Project{
+			{ProjectSlug: "fails", Name: apitest.NamePrefix + "a", CreatedAt: now.Add(-2 * time.Hour)},
+			{ProjectSlug: "gone", Name: apitest.NamePrefix + "b", CreatedAt: now.Add(-2 * time.Hour)},
+			{ProjectSlug: "ok", Name: apitest.NamePrefix + "c", CreatedAt: now.Add(-2 * time.Hour)},
+		}}, nil)
+		boom := errors.New("boom")
+		fake.Projects.DeleteFunc = func(_ context.Context, body projects.DeleteRequest) (*projects.DeleteResponse, error) {
+			switch body.ProjectSlug {
+			case "fails":
+				return nil, boom
+			case "gone":
+				return nil, stytcherror.Error{StatusCode: 404}
+			}
+			return &projects.DeleteResponse{}, nil
+		}
+
+		// Act
+		deleted, err := apitest.Sweep(context.Background(), fake.Projects, cutoff)
+
+		// Assert
+		assert.ErrorIs(t, err, boom)
+		require.Len(t, deleted, 2)
+		assert.Equal(t, "gone", deleted[0].ProjectSlug)
+		assert.Equal(t, "ok", deleted[1].ProjectSlug)
+	})
+}
+
+func TestUniqueName(t *testing.T) {
+	a := apitest.UniqueName(t)
+	b := apitest.UniqueName(t)
+	assert.NotEqual(t, a, b)
+	assert.Contains(t, a, apitest.NamePrefix)
+	assert.Contains(t, a, "TestUniqueName")
+}
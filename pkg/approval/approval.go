@@ -0,0 +1,427 @@
+// Package approval holds sensitive changes for a second person to approve before they run, for
+// change-management controls such as SOC 2 CC8.1.
+//
+// A Queue wraps a client. Calls through Queue.API that match its Policy are not sent: they are
+// saved to the Store as pending changes, with a diff for the reviewer, and fail with a
+// *PendingError. Every other call is passed to the client:
+//
+//	queue := &approval.Queue{Client: client, Store: &approval.DirStore{Dir: "changes"}, Identity: "alice"}
+//	_, err := queue.API().SecretsAPI().Delete(ctx, req)
+//	var pending *approval.PendingError
+//	if errors.As(err, &pending) {
+//		fmt.Println(pending.Change) // queued for approval
+//	}
+//
+// Another identity then approves the change, which runs it, or rejects it:
+//
+//	reviewer := &approval.Queue{Client: client, Store: &approval.DirStore{Dir: "changes"}, Identity: "bob"}
+//	change, err := reviewer.Approve(ctx, id)
+//
+// The identity that requested a change cannot approve it. Identities are taken as they are given;
+// authenticating them, and protecting the store from changes outside this package, is up to the
+// caller.
+//
+// Calls whose requests hold credentials, such as EventLogStreaming.Create with a Datadog API key,
+// cannot be queued: they fail rather than write the credentials to the store. Leave them out of
+// the policy. Credentials in diffs, such as a password being replaced, are masked.
+package approval
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/diff"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/internal/redact"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/internal/request"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/environments"
+)
+
+// timeFormat is the layout of the timestamp in change IDs. It sorts lexically in time order.
+const timeFormat = "20060102T150405Z"
+
+// Rule selects operations that need approval.
+type Rule struct {
+	// Operation is the name of an operation, such as "Secrets.Delete", or a pattern as accepted by
+	// path.Match, such as "RBACPolicy.*". Only operations that change the workspace are matched.
+	Operation string
+	// EnvironmentTypes, if not empty, restrict the rule to operations on environments of these
+	// types. Projects.Delete is an operation on a LIVE environment, since it deletes the project's.
+	EnvironmentTypes []environments.EnvironmentType
+}
+
+// Policy is a list of rules. An operation needs approval if any rule matches it.
+type Policy []Rule
+
+// DefaultPolicy is used by a Queue without a Policy: changes to RBAC policies, deletions of
+// secrets and projects, and deletions of LIVE environments need approval.
+var DefaultPolicy = Policy{
+	{Operation: "Environments.Delete", EnvironmentTypes: []environments.EnvironmentType{environments.EnvironmentTypeLive}},
+	{Operation: "Projects.Delete"},
+	{Operation: "RBACPolicy.Set"},
+	{Operation: "Secrets.Delete"},
+}
+
+// Status is the state of a change.
+type Status string
+
+const (
+	// StatusPending changes wait for a review.
+	StatusPending Status = "pending"
+	// StatusApproving changes were claimed by a reviewer and are running. A change that stays
+	// approving was interrupted before its outcome was recorded: it may or may not have run, and it
+	// is never run again.
+	StatusApproving Status = "approving"
+	// StatusApproved changes were approved and ran successfully.
+	StatusApproved Status = "approved"
+	// StatusFailed changes were approved but failed when they ran. They are not retried.
+	StatusFailed Status = "failed"
+	// StatusRejected changes were rejected and never ran.
+	StatusRejected Status = "rejected"
+)
+
+// Change is an operation held for approval.
+type Change struct {
+	// ID identifies the change in the store, such as "20261019T120000Z-3f2a9c1b": the time it was
+	// requested, in UTC, and a random suffix.
+	ID        string `json:"id"`
+	Operation string `json:"operation"`
+	// ProjectSlug and EnvironmentSlug are the project and environment the operation targets, if any.
+	ProjectSlug     string `json:"project_slug,omitempty"`
+	EnvironmentSlug string `json:"environment_slug,omitempty"`
+	// Request holds the fields of the request, by Go field name, so that path parameters that are
+	// not part of the request body are kept.
+	Request json.RawMessage `json:"request"`
+	// Diff shows what the operation changes, as of when it was requested or last found out of date
+	// by Approve: the current value and the requested one for updates, and the deleted resource for
+	// deletions.
+	Diff        []diff.Change `json:"diff"`
+	Status      Status        `json:"status"`
+	RequestedBy string        `json:"requested_by"`
+	RequestedAt time.Time     `json:"requested_at"`
+	ReviewedBy  string        `json:"reviewed_by,omitempty"`
+	ReviewedAt  *time.Time    `json:"reviewed_at,omitempty"`
+	// Reason is the reason given for a rejection.
+	Reason string `json:"reason,omitempty"`
+	// Error is the error returned by the operation of a failed change.
+	Error string `json:"error,omitempty"`
+}
+
+// String formats the change for a reviewer: a summary line followed by the diff, one change per
+// line.
+func (c *Change) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %s", c.ID, c.Operation)
+	switch {
+	case c.EnvironmentSlug != "":
+		fmt.Fprintf(&b, " on environment %s of project %s", c.EnvironmentSlug, c.ProjectSlug)
+	case c.ProjectSlug != "":
+		fmt.Fprintf(&b, " on project %s", c.ProjectSlug)
+	}
+	fmt.Fprintf(&b, ", requested by %s at %s (%s)", c.RequestedBy, c.RequestedAt.Format(time.RFC3339), c.Status)
+	for _, d := range c.Diff {
+		b.WriteString("\n  " + d.String())
+	}
+	return b.String()
+}
+
+var (
+	// ErrNotPending is returned, wrapped, for reviews of changes that are no longer pending.
+	ErrNotPending = errors.New("not pending")
+	// ErrStale is returned, wrapped, for approvals of changes whose diff has changed since it was
+	// reviewed.
+	ErrStale = errors.New("changed since it was reviewed")
+)
+
+// PendingError is returned, wrapped, for calls that were queued for approval instead of being
+// sent.
+type PendingError struct {
+	Change *Change
+}
+
+func (e *PendingError) Error() string {
+	return fmt.Sprintf("approval: %s queued for approval as change %s", e.Change.Operation, e.Change.ID)
+}
+
+// Queue holds the calls that need approval in a Store, and runs them once approved.
+type Queue struct {
+	// Client sends the calls that do not need approval, and the approved ones.
+	Client api.Interface
+	// Store holds the changes.
+	Store Store
+	// Policy selects the operations that need approval. If nil, DefaultPolicy is used.
+	Policy Policy
+	// Identity identifies the person or system using the queue. It is recorded as the requester
+	// of the changes it queues and the reviewer of those it approves or rejects.
+	Identity string
+	// Now returns the current time. It defaults to time.Now.
+	Now func() time.Time
+}
+
+func (q *Queue) now() time.Time {
+	if q.Now != nil {
+		return q.Now().UTC()
+	}
+	return time.Now().UTC()
+}
+
+// API returns an api.Interface that queues the calls that need approval and passes the others to
+// the queue's client.
+func (q *Queue) API() *API {
+	a := &API{queue: q}
+	a.clients = newClients(a, q.Client)
+	return a
+}
+
+// API is an api.Interface that queues the calls that need approval. Create one with Queue.API.
+type API struct {
+	queue *Queue
+	// clients holds the wrapped resource clients, built by newClients.
+	clients
+}
+
+// submit queues a call to operation with body if it needs approval, and returns a *PendingError
+// for it. It returns nil if the call does not need approval.
+func (a *API) submit(ctx context.Context, operation string, body any) error {
+	q := a.queue
+	if q.Identity == "" {
+		return errors.New("approval: the queue has no identity")
+	}
+	projectSlug, envSlug := request.Slugs(body)
+	ok, err := q.needsApproval(ctx, operation, projectSlug, envSlug)
+	if err != nil || !ok {
+		return err
+	}
+
+	encoded, err := encodeRequest(body)
+	if err != nil {
+		return err
+	}
+	if redact.ContainsCredentials(encoded) {
+		return fmt.Errorf("approval: %s cannot be queued: its request holds credentials, which would be stored in plain text", operation)
+	}
+	changes, err := reviewDiff(ctx, q.Client, operation, body)
+	if err != nil {
+		return fmt.Errorf("approval: computing the diff of %s: %w", operation, err)
+	}
+	now := q.now().Truncate(time.Second)
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return err
+	}
+	change := &Change{
+		ID:              now.Format(timeFormat) + "-" + hex.EncodeToString(suffix),
+		Operation:       operation,
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+		Request:         encoded,
+		Diff:            changes,
+		Status:          StatusPending,
+		RequestedBy:     q.Identity,
+		RequestedAt:     now,
+	}
+	if err := q.Store.Put(change); err != nil {
+		return err
+	}
+	return &PendingError{Change: change}
+}
+
+// needsApproval reports whether the policy matches an operation.
+func (q *Queue) needsApproval(ctx context.Context, operation, projectSlug, envSlug string) (bool, error) {
+	policy := q.Policy
+	if policy == nil {
+		policy = DefaultPolicy
+	}
+	var envType environments.EnvironmentType
+	for _, rule := range policy {
+		if ok, err := path.Match(rule.Operation, operation); !ok || err != nil {
+			continue
+		}
+		if len(rule.EnvironmentTypes) == 0 {
+			return true, nil
+		}
+		switch {
+		case operation == "Projects.Delete":
+			envType = environments.EnvironmentTypeLive
+		case envSlug == "":
+			continue
+		case envType == "":
+			// The type is looked up for every call rather than cached like guard and scope do: calls
+			// that bypass the queue, such as Environments.Delete under a custom policy, could
+			// otherwise leave a stale type behind that lets a call on a LIVE environment through.
+			resp, err := q.Client.EnvironmentsAPI().Get(ctx, environments.GetRequest{
+				ProjectSlug:     projectSlug,
+				EnvironmentSlug: envSlug,
+			})
+			if err != nil {
+				// Fail closed: a call that cannot be shown not to need approval is not sent.
+				return false, fmt.Errorf("approval: resolving the type of environment %s: %w", envSlug, err)
+			}
+			envType = resp.Environment.Type
+		}
+		if slices.Contains(rule.EnvironmentTypes, envType) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Pending returns the changes waiting for a review, oldest first.
+func (q *Queue) Pending() ([]Change, error) {
+	changes, err := q.Store.List()
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(changes, func(c Change) bool { return c.Status != StatusPending }), nil
+}
+
+// Approve runs a pending change with the queue's client and records the review. The change is
+// claimed in the store first, so that it runs at most once even if several reviewers sharing the
+// store approve it at the same time. The change is recorded as failed, and the error is returned,
+// if the operation fails. A change cannot be approved by the identity that requested it.
+//
+// The diff is computed again before the change runs. If it no longer matches the reviewed one,
+// because what the change replaces or deletes was modified since it was requested, the change is
+// not run: it stays pending with the new diff, and an error wrapping ErrStale is returned along
+// with it, so that the new diff can be reviewed.
+func (q *Queue) Approve(ctx context.Context, id string) (*Change, error) {
+	change, err := q.review(id)
+	if err != nil {
+		return nil, err
+	}
+	body, err := decode(change.Operation, change.Request)
+	if err != nil {
+		return nil, fmt.Errorf("approval: change %s: %w", id, err)
+	}
+	current, err := reviewDiff(ctx, q.Client, change.Operation, body)
+	if err != nil {
+		return nil, fmt.Errorf("approval: computing the diff of change %s: %w", id, err)
+	}
+	if !sameDiff(current, change.Diff) {
+		change.Diff = current
+		change.ReviewedBy, change.ReviewedAt = "", nil
+		if err := q.Store.Claim(change); err != nil {
+			return nil, err
+		}
+		return change, fmt.Errorf("approval: change %s %w; review the new diff and approve it again", id, ErrStale)
+	}
+
+	change.Status = StatusApproving
+	if err := q.Store.Claim(change); err != nil {
+		return nil, err
+	}
+	_, runErr := invoke(ctx, q.Client, change.Operation, body)
+	change.Status = StatusApproved
+	if runErr != nil {
+		change.Status = StatusFailed
+		change.Error = runErr.Error()
+	}
+	if err := q.Store.Put(change); err != nil {
+		return nil, errors.Join(runErr, err)
+	}
+	return change, runErr
+}
+
+// Reject records that a pending change was rejected, with the reason given. The change never
+// runs. A change cannot be rejected by the identity that requested it; that identity can leave it
+// pending.
+func (q *Queue) Reject(id, reason string) (*Change, error) {
+	change, err := q.review(id)
+	if err != nil {
+		return nil, err
+	}
+	change.Status = StatusRejected
+	change.Reason = reason
+	if err := q.Store.Claim(change); err != nil {
+		return nil, err
+	}
+	return change, nil
+}
+
+// review returns a pending change with its reviewer set, after checking that the queue's identity
+// may review it.
+func (q *Queue) review(id string) (*Change, error) {
+	if q.Identity == "" {
+		return nil, errors.New("approval: the queue has no identity")
+	}
+	change, err := q.Store.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case change.Status != StatusPending:
+		return nil, fmt.Errorf("approval: change %s is %s, %w", id, change.Status, ErrNotPending)
+	case change.RequestedBy == q.Identity:
+		return nil, fmt.Errorf("approval: change %s was requested by %s, who cannot review it", id, q.Identity)
+	}
+	now := q.now().Truncate(time.Second)
+	change.ReviewedBy = q.Identity
+	change.ReviewedAt = &now
+	return change, nil
+}
+
+// sameDiff reports whether two diffs show the same changes. Diffs read from the store hold decoded
+// JSON rather than model types, so both are compared as JSON.
+func sameDiff(a, b []diff.Change) bool {
+	ja, errA := diffJSON(a)
+	jb, errB := diffJSON(b)
+	return errA == nil && errB == nil && bytes.Equal(ja, jb)
+}
+
+func diffJSON(changes []diff.Change) ([]byte, error) {
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	b, err := json.Marshal(changes)
+	if err != nil {
+		return nil, err
+	}
+	// Decode and encode again, so that struct fields are in the sorted order of object keys.
+	var v any
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil, err
+	}
+	return json.Marshal(v)
+}
+
+// encodeRequest encodes the exported fields of a request struct as a JSON object keyed by Go field
+// name. The JSON names of the models cannot be used, since path parameters have none.
+func encodeRequest(body any) (json.RawMessage, error) {
+	rv := reflect.ValueOf(body)
+	fields := map[string]any{}
+	for i := 0; i < rv.NumField(); i++ {
+		if f := rv.Type().Field(i); f.IsExported() {
+			fields[f.Name] = rv.Field(i).Interface()
+		}
+	}
+	return json.Marshal(fields)
+}
+
+// decodeRequest decodes a request encoded by encodeRequest into the struct body points to.
+func decodeRequest(data json.RawMessage, body any) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	rv := reflect.ValueOf(body).Elem()
+	for name, value := range fields {
+		f := rv.FieldByName(name)
+		if !f.IsValid() || !f.CanSet() {
+			return fmt.Errorf("unknown request field %s", name)
+		}
+		if err := json.Unmarshal(value, f.Addr().Interface()); err != nil {
+			return fmt.Errorf("request field %s: %w", name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,446 @@
+package approval_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/apifake"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/approval"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/diff"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/environments"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/eventlogstreaming"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/rbacpolicy"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/redirecturls"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/secrets"
+)
+
+// newFake returns a fake whose environments "production" are LIVE and whose other environments
+// are TEST, with a secret ending in "abcd".
+func newFake() *apifake.API {
+	fake := apifake.New()
+	fake.Environments.GetFunc = func(_ context.Context, body environments.GetRequest) (*environments.GetResponse, error) {
+		envType := environments.EnvironmentTypeTest
+		if body.EnvironmentSlug == "production" {
+			envType = environments.EnvironmentTypeLive
+		}
+		return &environments.GetResponse{Environment: environments.Environment{
+			ProjectSlug:     body.ProjectSlug,
+			EnvironmentSlug: body.EnvironmentSlug,
+			Type:            envType,
+		}}, nil
+	}
+	fake.Secrets.GetReturns(&secrets.GetResponse{Secret: secrets.MaskedSecret{SecretID: "secret-1", LastFour: "abcd"}}, nil)
+	return fake
+}
+
+// newQueues returns a requester's and a reviewer's queue sharing a store in a temporary directory.
+func newQueues(t *testing.T, fake *apifake.API) (*approval.Queue, *approval.Queue) {
+	t.Helper()
+	store := &approval.DirStore{Dir: t.TempDir()}
+	now := func() time.Time { return time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC) }
+	return &approval.Queue{Client: fake, Store: store, Identity: "alice", Now: now},
+		&approval.Queue{Client: fake, Store: store, Identity: "bob", Now: now}
+}
+
+var deleteSecret = secrets.DeleteRequest{ProjectSlug: "my-project", EnvironmentSlug: "production", SecretID: "secret-1"}
+
+// queue submits a deletion of secret-1 through q and returns the pending change.
+func queue(t *testing.T, q *approval.Queue) *approval.Change {
+	t.Helper()
+	_, err := q.API().SecretsAPI().Delete(context.Background(), deleteSecret)
+	var pending *approval.PendingError
+	require.ErrorAs(t, err, &pending)
+	return pending.Change
+}
+
+func TestAPI(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("queues calls that need approval", func(t *testing.T) {
+		// Arrange
+		fake := newFake()
+		requester, _ := newQueues(t, fake)
+
+		// Act
+		_, err := requester.API().SecretsAPI().Delete(ctx, deleteSecret)
+
+		// Assert
+		var pending *approval.PendingError
+		require.ErrorAs(t, err, &pending)
+		assert.EqualError(t, err, "approval: Secrets.Delete queued for approval as change "+pending.Change.ID)
+		assert.Empty(t, fake.Secrets.DeleteCalls())
+		assert.Regexp(t, `^20261019T120000Z-[0-9a-f]{8}$`, pending.Change.ID)
+		assert.Equal(t, approval.StatusPending, pending.Change.Status)
+		assert.Equal(t, "alice", pending.Change.RequestedBy)
+		assert.Contains(t, pending.Change.Diff, diff.Change{Path: "last_four", Before: "abcd", After: ""})
+
+		changes, err := requester.Pending()
+		require.NoError(t, err)
+		require.Len(t, changes, 1)
+		assert.Equal(t, pending.Change.ID, changes[0].ID)
+	})
+
+	t.Run("passes other calls", func(t *testing.T) {
+		// Arrange
+		fake := newFake()
+		requester, _ := newQueues(t, fake)
+
+		// Act
+		_, errCreate := requester.API().SecretsAPI().Create(ctx, secrets.CreateRequest{ProjectSlug: "my-project", EnvironmentSlug: "production"})
+		_, errGet := requester.API().SecretsAPI().Get(ctx, secrets.GetRequest{ProjectSlug: "my-project", EnvironmentSlug: "production"})
+
+		// Assert
+		require.NoError(t, errCreate)
+		require.NoError(t, errGet)
+		assert.Len(t, fake.Secrets.CreateCalls(), 1)
+		changes, err := requester.Pending()
+		require.NoError(t, err)
+		assert.Empty(t, changes)
+	})
+
+	t.Run("environment types", func(t *testing.T) {
+		// Arrange
+		fake := newFake()
+		requester, _ := newQueues(t, fake)
+
+		// Act
+		_, errTest := requester.API().EnvironmentsAPI().Delete(ctx, environments.DeleteRequest{ProjectSlug: "my-project", EnvironmentSlug: "test"})
+		_, errLive := requester.API().EnvironmentsAPI().Delete(ctx, environments.DeleteRequest{ProjectSlug: "my-project", EnvironmentSlug: "production"})
+
+		// Assert
+		assert.NoError(t, errTest)
+		assert.ErrorAs(t, errLive, new(*approval.PendingError))
+		assert.Equal(t, []environments.DeleteRequest{{ProjectSlug: "my-project", EnvironmentSlug: "test"}}, fake.Environments.DeleteCalls())
+	})
+
+	t.Run("shows the RBAC policy change", func(t *testing.T) {
+		// Arrange
+		fake := newFake()
+		fake.RBACPolicy.GetReturns(&rbacpolicy.GetResponse{Policy: rbacpolicy.Policy{
+			StytchResources: []rbacpolicy.Resource{{ResourceID: "stytch.member"}},
+			CustomRoles:     []rbacpolicy.Role{{RoleID: "viewer", Description: "Read only"}},
+		}}, nil)
+		requester, _ := newQueues(t, fake)
+
+		// Act
+		_, err := requester.API().RBACPolicyAPI().Set(ctx, rbacpolicy.SetRequest{
+			ProjectSlug:     "my-project",
+			EnvironmentSlug: "test",
+			CustomRoles:     []rbacpolicy.Role{{RoleID: "viewer", Description: "Can read everything"}},
+		})
+
+		// Assert
+		var pending *approval.PendingError
+		require.ErrorAs(t, err, &pending)
+		assert.Equal(t, []diff.Change{{Path: "custom_roles[0].description", Before: "Read only", After: "Can read everything"}}, pending.Change.Diff)
+		assert.Contains(t, pending.Change.String(), " RBACPolicy.Set on environment test of project my-project, requested by alice at 2026-10-19T12:00:00Z (pending)\n"+
+			`  custom_roles[0].description: "Read only" -> "Can read everything"`)
+	})
+
+	t.Run("refuses requests with credentials", func(t *testing.T) {
+		// Arrange
+		fake := newFake()
+		requester, _ := newQueues(t, fake)
+		requester.Policy = approval.Policy{{Operation: "EventLogStreaming.*"}}
+
+		// Act
+		_, err := requester.API().EventLogStreamingAPI().Create(ctx, eventlogstreaming.CreateRequest{
+			ProjectSlug:     "my-project",
+			EnvironmentSlug: "production",
+			DestinationType: eventlogstreaming.DestinationTypeDatadog,
+			DestinationConfig: &eventlogstreaming.DestinationConfig{
+				Datadog: &eventlogstreaming.DatadogConfig{APIKey: "datadog-api-key-1234", Site: "US"},
+			},
+		})
+
+		// Assert
+		assert.EqualError(t, err, "approval: EventLogStreaming.Create cannot be queued: its request holds credentials, which would be stored in plain text")
+		assert.Empty(t, fake.EventLogStreaming.CreateCalls())
+		changes, err := requester.Store.List()
+		require.NoError(t, err)
+		assert.Empty(t, changes)
+	})
+
+	t.Run("no identity", func(t *testing.T) {
+		// Arrange
+		fake := newFake()
+		requester, _ := newQueues(t, fake)
+		requester.Identity = ""
+
+		// Act
+		_, err := requester.API().SecretsAPI().Delete(ctx, deleteSecret)
+
+		// Assert
+		assert.EqualError(t, err, "approval: the queue has no identity")
+		assert.Empty(t, fake.Secrets.DeleteCalls())
+	})
+}
+
+func TestApprove(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("runs the change", func(t *testing.T) {
+		// Arrange
+		fake := newFake()
+		requester, reviewer := newQueues(t, fake)
+		id := queue(t, requester).ID
+
+		// Act
+		change, err := reviewer.Approve(ctx, id)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, approval.StatusApproved, change.Status)
+		assert.Equal(t, "bob", change.ReviewedBy)
+		assert.Equal(t, []secrets.DeleteRequest{deleteSecret}, fake.Secrets.DeleteCalls())
+		stored, err := reviewer.Store.Get(id)
+		require.NoError(t, err)
+		assert.Equal(t, approval.StatusApproved, stored.Status)
+		pending, err := reviewer.Pending()
+		require.NoError(t, err)
+		assert.Empty(t, pending)
+	})
+
+	t.Run("keeps every request field", func(t *testing.T) {
+		// Arrange
+		fake := newFake()
+		requester, reviewer := newQueues(t, fake)
+		requester.Policy = approval.Policy{{Operation: "RedirectURLs.*"}}
+		promote := true
+		req := redirecturls.DeleteRequest{
+			ProjectSlug:          "my-project",
+			EnvironmentSlug:      "test",
+			URL:                  "https://example.com/callback",
+			DoNotPromoteDefaults: &promote,
+		}
+		_, err := requester.API().RedirectURLsAPI().Delete(ctx, req)
+		var pending *approval.PendingError
+		require.ErrorAs(t, err, &pending)
+
+		// Act
+		_, err = reviewer.Approve(ctx, pending.Change.ID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []redirecturls.DeleteRequest{req}, fake.RedirectURLs.DeleteCalls())
+	})
+
+	t.Run("not by the requester", func(t *testing.T) {
+		// Arrange
+		fake := newFake()
+		requester, _ := newQueues(t, fake)
+		id := queue(t, requester).ID
+
+		// Act
+		_, err := requester.Approve(ctx, id)
+
+		// Assert
+		assert.EqualError(t, err, "approval: change "+id+" was requested by alice, who cannot review it")
+		assert.Empty(t, fake.Secrets.DeleteCalls())
+	})
+
+	t.Run("only once", func(t *testing.T) {
+		// Arrange
+		fake := newFake()
+		requester, reviewer := newQueues(t, fake)
+		id := queue(t, requester).ID
+		_, err := reviewer.Approve(ctx, id)
+		require.NoError(t, err)
+
+		// Act
+		_, err = reviewer.Approve(ctx, id)
+
+		// Assert
+		assert.EqualError(t, err, "approval: change "+id+" is approved, not pending")
+		assert.Len(t, fake.Secrets.DeleteCalls(), 1)
+	})
+
+	t.Run("once across stores sharing a directory", func(t *testing.T) {
+		// Arrange
+		fake := newFake()
+		requester, _ := newQueues(t, fake)
+		id := queue(t, requester).ID
+		dir := requester.Store.(*approval.DirStore).Dir
+
+		// Act
+		var wg sync.WaitGroup
+		errs := make([]error, 8)
+		for i := range errs {
+			// Each reviewer has its own store, as separate processes would.
+			reviewer := &approval.Queue{Client: fake, Store: &approval.DirStore{Dir: dir}, Identity: "bob"}
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				_, errs[i] = reviewer.Approve(ctx, id)
+			}(i)
+		}
+		wg.Wait()
+
+		// Assert
+		succeeded := 0
+		for _, err := range errs {
+			if err == nil {
+				succeeded++
+			} else {
+				assert.ErrorIs(t, err, approval.ErrNotPending)
+			}
+		}
+		assert.Equal(t, 1, succeeded)
+		assert.Len(t, fake.Secrets.DeleteCalls(), 1)
+	})
+
+	t.Run("not after an interrupted approval", func(t *testing.T) {
+		// Arrange
+		fake := newFake()
+		requester, reviewer := newQueues(t, fake)
+		change := queue(t, requester)
+		change.Status = approval.StatusApproving
+		require.NoError(t, reviewer.Store.Put(change))
+
+		// Act
+		_, err := reviewer.Approve(ctx, change.ID)
+
+		// Assert
+		assert.ErrorIs(t, err, approval.ErrNotPending)
+		assert.Empty(t, fake.Secrets.DeleteCalls())
+	})
+
+	t.Run("not if what it changes has moved", func(t *testing.T) {
+		// Arrange
+		fake := newFake()
+		policy := func(description string) *rbacpolicy.GetResponse {
+			return &rbacpolicy.GetResponse{Policy: rbacpolicy.Policy{
+				CustomRoles: []rbacpolicy.Role{{RoleID: "viewer", Description: description}},
+			}}
+		}
+		fake.RBACPolicy.GetReturns(policy("Read only"), nil)
+		requester, reviewer := newQueues(t, fake)
+		_, err := requester.API().RBACPolicyAPI().Set(ctx, rbacpolicy.SetRequest{
+			ProjectSlug:     "my-project",
+			EnvironmentSlug: "test",
+			CustomRoles:     []rbacpolicy.Role{{RoleID: "viewer", Description: "Can read everything"}},
+		})
+		var pending *approval.PendingError
+		require.ErrorAs(t, err, &pending)
+		id := pending.Change.ID
+		fake.RBACPolicy.GetReturns(policy("Read secrets"), nil)
+
+		// Act
+		change, err := reviewer.Approve(ctx, id)
+
+		// Assert
+		assert.ErrorIs(t, err, approval.ErrStale)
+		assert.Empty(t, fake.RBACPolicy.SetCalls())
+		want := []diff.Change{{Path: "custom_roles[0].description", Before: "Read secrets", After: "Can read everything"}}
+		assert.Equal(t, want, change.Diff)
+		stored, err := reviewer.Store.Get(id)
+		require.NoError(t, err)
+		assert.Equal(t, approval.StatusPending, stored.Status)
+		assert.Empty(t, stored.ReviewedBy)
+		assert.Equal(t, "Read secrets", stored.Diff[0].Before)
+
+		_, err = reviewer.Approve(ctx, id)
+		require.NoError(t, err)
+		assert.Len(t, fake.RBACPolicy.SetCalls(), 1)
+	})
+
+	t.Run("records failures", func(t *testing.T) {
+		// Arrange
+		fake := newFake()
+		fake.Secrets.DeleteReturns(nil, errors.New("boom"))
+		requester, reviewer := newQueues(t, fake)
+		id := queue(t, requester).ID
+
+		// Act
+		change, err := reviewer.Approve(ctx, id)
+
+		// Assert
+		assert.EqualError(t, err, "boom")
+		assert.Equal(t, approval.StatusFailed, change.Status)
+		assert.Equal(t, "boom", change.Error)
+	})
+
+	t.Run("unknown change", func(t *testing.T) {
+		// Arrange
+		_, reviewer := newQueues(t, newFake())
+
+		// Act
+		_, err := reviewer.Approve(ctx, "20261019T120000Z-00000000")
+
+		// Assert
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
+
+func TestReject(t *testing.T) {
+	// Arrange
+	fake := newFake()
+	requester, reviewer := newQueues(t, fake)
+	id := queue(t, requester).ID
+
+	// Act
+	change, err := reviewer.Reject(id, "use the rotation runbook")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, approval.StatusRejected, change.Status)
+	assert.Equal(t, "use the rotation runbook", change.Reason)
+	assert.Empty(t, fake.Secrets.DeleteCalls())
+	_, err = reviewer.Approve(context.Background(), id)
+	assert.EqualError(t, err, "approval: change "+id+" is rejected, not pending")
+}
+
+func TestDirStore(t *testing.T) {
+	// Arrange
+	store := &approval.DirStore{Dir: filepath.Join(t.TempDir(), "changes")}
+	change := &approval.Change{ID: "20261019T120000Z-3f2a9c1b", Operation: "Secrets.Delete", Status: approval.StatusPending}
+
+	// Act
+	require.NoError(t, store.Put(change))
+	got, err := store.Get(change.ID)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, change.Operation, got.Operation)
+	info, err := os.Stat(filepath.Join(store.Dir, "20261019T120000Z-3f2a9c1b.json"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+	_, err = store.Get("../secrets")
+	assert.EqualError(t, err, `invalid change ID "../secrets"`)
+
+	t.Run("claim", func(t *testing.T) {
+		// Arrange
+		claimed := *change
+		claimed.Status = approval.StatusApproving
+
+		// Act
+		err := store.Claim(&claimed)
+		again := store.Claim(&claimed)
+
+		// Assert
+		require.NoError(t, err)
+		assert.EqualError(t, again, "approval: change 20261019T120000Z-3f2a9c1b is approving, not pending")
+		assert.ErrorIs(t, again, approval.ErrNotPending)
+		assert.NoFileExists(t, filepath.Join(store.Dir, "20261019T120000Z-3f2a9c1b.lock"))
+	})
+
+	t.Run("claim while locked", func(t *testing.T) {
+		// Arrange
+		locked := &approval.Change{ID: "20261019T120000Z-00000000", Operation: "Secrets.Delete", Status: approval.StatusPending}
+		require.NoError(t, store.Put(locked))
+		require.NoError(t, os.WriteFile(filepath.Join(store.Dir, locked.ID+".lock"), nil, 0o600))
+
+		// Act
+		err := store.Claim(locked)
+
+		// Assert
+		assert.ErrorIs(t, err, approval.ErrNotPending)
+	})
+}
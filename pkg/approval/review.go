@@ -0,0 +1,108 @@
+package approval
+
+import (
+	"context"
+	"strings"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/diff"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/internal/redact"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/environments"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/publictokens"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/rbacpolicy"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/sdk"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/secrets"
+)
+
+// reviewDiff returns the diff shown to the reviewer of a call to operation with body, with the
+// values of credential fields masked.
+func reviewDiff(ctx context.Context, client api.Interface, operation string, body any) ([]diff.Change, error) {
+	changes, err := computeDiff(ctx, client, body)
+	if err != nil {
+		return nil, err
+	}
+	for i, c := range changes {
+		if name := c.Path[strings.LastIndex(c.Path, ".")+1:]; redact.IsCredentialField(name) {
+			changes[i].Before, changes[i].After = maskLeaf(c.Before), maskLeaf(c.After)
+		}
+	}
+	return changes, nil
+}
+
+func maskLeaf(v any) any {
+	if s, ok := v.(string); ok {
+		return redact.Mask(s)
+	}
+	return v
+}
+
+// computeDiff returns the diff of a call with body. Deletions show the resource they delete, and
+// changes to the RBAC policy and SDK configuration show the current and requested values. Other
+// operations show the fields of their request.
+func computeDiff(ctx context.Context, client api.Interface, body any) ([]diff.Change, error) {
+	switch b := body.(type) {
+	case environments.DeleteRequest:
+		resp, err := client.EnvironmentsAPI().Get(ctx, environments.GetRequest(b))
+		if err != nil {
+			return nil, err
+		}
+		return diff.Compare(resp.Environment, nil), nil
+	case projects.DeleteRequest:
+		resp, err := client.ProjectsAPI().Get(ctx, projects.GetRequest(b))
+		if err != nil {
+			return nil, err
+		}
+		return diff.Compare(resp.Project, nil), nil
+	case publictokens.DeleteRequest:
+		resp, err := client.PublicTokensAPI().Get(ctx, publictokens.GetRequest(b))
+		if err != nil {
+			return nil, err
+		}
+		return diff.Compare(resp.PublicToken, nil), nil
+	case secrets.DeleteRequest:
+		resp, err := client.SecretsAPI().Get(ctx, secrets.GetRequest(b))
+		if err != nil {
+			return nil, err
+		}
+		return diff.Compare(resp.Secret, nil), nil
+	case rbacpolicy.SetRequest:
+		resp, err := client.RBACPolicyAPI().Get(ctx, rbacpolicy.GetRequest{
+			ProjectSlug:     b.ProjectSlug,
+			EnvironmentSlug: b.EnvironmentSlug,
+		})
+		if err != nil {
+			return nil, err
+		}
+		// The Stytch resources cannot be set, so they are left out of both sides.
+		before := resp.Policy
+		before.StytchResources = nil
+		return diff.Compare(before, rbacpolicy.Policy{
+			StytchMember:    b.StytchMember,
+			StytchAdmin:     b.StytchAdmin,
+			StytchUser:      b.StytchUser,
+			CustomRoles:     b.CustomRoles,
+			CustomResources: b.CustomResources,
+			CustomScopes:    b.CustomScopes,
+		}), nil
+	case sdk.SetB2BConfigRequest:
+		resp, err := client.SDKAPI().GetB2BConfig(ctx, sdk.GetB2BConfigRequest{
+			ProjectSlug:     b.ProjectSlug,
+			EnvironmentSlug: b.EnvironmentSlug,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return diff.Compare(&resp.Config, b.Config), nil
+	case sdk.SetConsumerConfigRequest:
+		resp, err := client.SDKAPI().GetConsumerConfig(ctx, sdk.GetConsumerConfigRequest{
+			ProjectSlug:     b.ProjectSlug,
+			EnvironmentSlug: b.EnvironmentSlug,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return diff.Compare(&resp.Config, b.Config), nil
+	}
+	return diff.Compare(nil, body), nil
+}
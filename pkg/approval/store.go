@@ -0,0 +1,145 @@
+package approval
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// Store holds changes.
+type Store interface {
+	// Put creates or replaces a change.
+	Put(change *Change) error
+	// Claim replaces a change with the given one if the stored change is still pending, as a
+	// single step that no other user of the store can interleave with. It returns an error
+	// wrapping ErrNotPending if the stored change is not pending.
+	Claim(change *Change) error
+	// Get returns the change with the given ID. It returns an error wrapping fs.ErrNotExist if
+	// there is none.
+	Get(id string) (*Change, error)
+	// List returns every change, oldest first.
+	List() ([]Change, error)
+}
+
+// DirStore is a Store that writes each change to a JSON file named after its ID in a directory,
+// such as changes/20261019T120000Z-3f2a9c1b.json, so that the queue can be shared through a
+// network file system or committed to a repository.
+//
+// Claim holds a lock file, such as changes/20261019T120000Z-3f2a9c1b.lock, while it checks and
+// replaces a change. If a process dies while it holds the lock, the change cannot be reviewed until
+// the lock file is removed.
+type DirStore struct {
+	// Dir is the directory of the store. It is created by the first Put, readable only by its
+	// owner.
+	Dir string
+}
+
+var _ Store = (*DirStore)(nil)
+
+func (s *DirStore) path(id string) string {
+	return filepath.Join(s.Dir, id+".json")
+}
+
+// Put implements Store.
+func (s *DirStore) Put(change *Change) error {
+	if err := validID(change.ID); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(s.Dir, 0o700); err != nil {
+		return err
+	}
+	b, err := json.MarshalIndent(change, "", "  ")
+	if err != nil {
+		return err
+	}
+	// Write to a temporary file first, so that an interrupted Put does not leave a truncated change.
+	// Only the owner can read the files, since requests may hold sensitive settings.
+	path := s.path(change.ID)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, append(b, '\n'), 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// Claim implements Store.
+func (s *DirStore) Claim(change *Change) error {
+	if err := validID(change.ID); err != nil {
+		return err
+	}
+	lock := filepath.Join(s.Dir, change.ID+".lock")
+	f, err := os.OpenFile(lock, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if errors.Is(err, fs.ErrExist) {
+		return fmt.Errorf("approval: change %s is being reviewed by someone else, %w", change.ID, ErrNotPending)
+	}
+	if err != nil {
+		return err
+	}
+	defer os.Remove(lock)
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	stored, err := s.Get(change.ID)
+	if err != nil {
+		return err
+	}
+	if stored.Status != StatusPending {
+		return fmt.Errorf("approval: change %s is %s, %w", change.ID, stored.Status, ErrNotPending)
+	}
+	return s.Put(change)
+}
+
+// Get implements Store.
+func (s *DirStore) Get(id string) (*Change, error) {
+	if err := validID(id); err != nil {
+		return nil, err
+	}
+	change, err := readChange(s.path(id))
+	if err != nil && os.IsNotExist(err) {
+		return nil, fmt.Errorf("change %s not found in %s: %w", id, s.Dir, fs.ErrNotExist)
+	}
+	return change, err
+}
+
+// List implements Store.
+func (s *DirStore) List() ([]Change, error) {
+	matches, err := filepath.Glob(s.path("*"))
+	if err != nil {
+		return nil, err
+	}
+	slices.Sort(matches)
+	changes := make([]Change, 0, len(matches))
+	for _, path := range matches {
+		change, err := readChange(path)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, *change)
+	}
+	return changes, nil
+}
+
+func readChange(path string) (*Change, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var change Change
+	if err := json.Unmarshal(b, &change); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &change, nil
+}
+
+func validID(id string) error {
+	if id == "" || strings.ContainsAny(id, `/\*?[`) {
+		return fmt.Errorf("invalid change ID %q", id)
+	}
+	return nil
+}
@@ -0,0 +1,782 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package approval
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/countrycodeallowlist"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/emailtemplates"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/environments"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/eventlogstreaming"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/jwttemplates"
+	migrationprojects "github.com/stytchauth/stytch-management-go/v3/pkg/models/migration/projects"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/passwordstrengthconfig"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/publictokens"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/rbacpolicy"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/redirecturls"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/sdk"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/secrets"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/trustedtokenprofiles"
+)
+
+// clients holds the resource clients of an API, which submit every call that changes the workspace
+// to the queue before passing it on.
+type clients struct {
+	countryCodeAllowlist   *countryCodeAllowlistClient
+	emailTemplates         *emailTemplatesClient
+	environments           *environmentsClient
+	eventLogStreaming      *eventLogStreamingClient
+	jwtTemplates           *jwtTemplatesClient
+	passwordStrengthConfig *passwordStrengthConfigClient
+	projects               *projectsClient
+	publicTokens           *publicTokensClient
+	rbacPolicy             *rbacPolicyClient
+	redirectURLs           *redirectURLsClient
+	sdk                    *sdkClient
+	secrets                *secretsClient
+	trustedTokenProfiles   *trustedTokenProfilesClient
+	v1ToV3MigrationClient  *v1ToV3MigrationClient
+}
+
+func newClients(a *API, client api.Interface) clients {
+	return clients{
+		countryCodeAllowlist:   &countryCodeAllowlistClient{api: a, client: client.CountryCodeAllowlistAPI()},
+		emailTemplates:         &emailTemplatesClient{api: a, client: client.EmailTemplatesAPI()},
+		environments:           &environmentsClient{api: a, client: client.EnvironmentsAPI()},
+		eventLogStreaming:      &eventLogStreamingClient{api: a, client: client.EventLogStreamingAPI()},
+		jwtTemplates:           &jwtTemplatesClient{api: a, client: client.JWTTemplatesAPI()},
+		passwordStrengthConfig: &passwordStrengthConfigClient{api: a, client: client.PasswordStrengthConfigAPI()},
+		projects:               &projectsClient{api: a, client: client.ProjectsAPI()},
+		publicTokens:           &publicTokensClient{api: a, client: client.PublicTokensAPI()},
+		rbacPolicy:             &rbacPolicyClient{api: a, client: client.RBACPolicyAPI()},
+		redirectURLs:           &redirectURLsClient{api: a, client: client.RedirectURLsAPI()},
+		sdk:                    &sdkClient{api: a, client: client.SDKAPI()},
+		secrets:                &secretsClient{api: a, client: client.SecretsAPI()},
+		trustedTokenProfiles:   &trustedTokenProfilesClient{api: a, client: client.TrustedTokenProfilesAPI()},
+		v1ToV3MigrationClient:  &v1ToV3MigrationClient{api: a, client: client.V1ToV3MigrationAPI()},
+	}
+}
+
+var _ api.Interface = (*API)(nil)
+
+// CountryCodeAllowlistAPI implements api.Interface.
+func (a *API) CountryCodeAllowlistAPI() api.CountryCodeAllowlistAPI {
+	return a.countryCodeAllowlist
+}
+
+// EmailTemplatesAPI implements api.Interface.
+func (a *API) EmailTemplatesAPI() api.EmailTemplatesAPI {
+	return a.emailTemplates
+}
+
+// EnvironmentsAPI implements api.Interface.
+func (a *API) EnvironmentsAPI() api.EnvironmentsAPI {
+	return a.environments
+}
+
+// EventLogStreamingAPI implements api.Interface.
+func (a *API) EventLogStreamingAPI() api.EventLogStreamingAPI {
+	return a.eventLogStreaming
+}
+
+// JWTTemplatesAPI implements api.Interface.
+func (a *API) JWTTemplatesAPI() api.JWTTemplatesAPI {
+	return a.jwtTemplates
+}
+
+// PasswordStrengthConfigAPI implements api.Interface.
+func (a *API) PasswordStrengthConfigAPI() api.PasswordStrengthConfigAPI {
+	return a.passwordStrengthConfig
+}
+
+// ProjectsAPI implements api.Interface.
+func (a *API) ProjectsAPI() api.ProjectsAPI {
+	return a.projects
+}
+
+// PublicTokensAPI implements api.Interface.
+func (a *API) PublicTokensAPI() api.PublicTokensAPI {
+	return a.publicTokens
+}
+
+// RBACPolicyAPI implements api.Interface.
+func (a *API) RBACPolicyAPI() api.RBACPolicyAPI {
+	return a.rbacPolicy
+}
+
+// RedirectURLsAPI implements api.Interface.
+func (a *API) RedirectURLsAPI() api.RedirectURLsAPI {
+	return a.redirectURLs
+}
+
+// SDKAPI implements api.Interface.
+func (a *API) SDKAPI() api.SDKAPI {
+	return a.sdk
+}
+
+// SecretsAPI implements api.Interface.
+func (a *API) SecretsAPI() api.SecretsAPI {
+	return a.secrets
+}
+
+// TrustedTokenProfilesAPI implements api.Interface.
+func (a *API) TrustedTokenProfilesAPI() api.TrustedTokenProfilesAPI {
+	return a.trustedTokenProfiles
+}
+
+// V1ToV3MigrationAPI implements api.Interface.
+func (a *API) V1ToV3MigrationAPI() api.V1ToV3MigrationAPI {
+	return a.v1ToV3MigrationClient
+}
+
+type countryCodeAllowlistClient struct {
+	api    *API
+	client api.CountryCodeAllowlistAPI
+}
+
+func (c *countryCodeAllowlistClient) GetAllowedSMSCountryCodes(ctx context.Context, body countrycodeallowlist.GetAllowedSMSCountryCodesRequest) (*countrycodeallowlist.GetAllowedSMSCountryCodesResponse, error) {
+	return c.client.GetAllowedSMSCountryCodes(ctx, body)
+}
+
+func (c *countryCodeAllowlistClient) GetAllowedWhatsAppCountryCodes(ctx context.Context, body countrycodeallowlist.GetAllowedWhatsAppCountryCodesRequest) (*countrycodeallowlist.GetAllowedWhatsAppCountryCodesResponse, error) {
+	return c.client.GetAllowedWhatsAppCountryCodes(ctx, body)
+}
+
+func (c *countryCodeAllowlistClient) SetAllowedSMSCountryCodes(ctx context.Context, body countrycodeallowlist.SetAllowedSMSCountryCodesRequest) (*countrycodeallowlist.SetAllowedSMSCountryCodesResponse, error) {
+	if err := c.api.submit(ctx, "CountryCodeAllowlist.SetAllowedSMSCountryCodes", body); err != nil {
+		return nil, err
+	}
+	return c.client.SetAllowedSMSCountryCodes(ctx, body)
+}
+
+func (c *countryCodeAllowlistClient) SetAllowedWhatsAppCountryCodes(ctx context.Context, body countrycodeallowlist.SetAllowedWhatsAppCountryCodesRequest) (*countrycodeallowlist.SetAllowedWhatsAppCountryCodesResponse, error) {
+	if err := c.api.submit(ctx, "CountryCodeAllowlist.SetAllowedWhatsAppCountryCodes", body); err != nil {
+		return nil, err
+	}
+	return c.client.SetAllowedWhatsAppCountryCodes(ctx, body)
+}
+
+type emailTemplatesClient struct {
+	api    *API
+	client api.EmailTemplatesAPI
+}
+
+func (c *emailTemplatesClient) Create(ctx context.Context, body emailtemplates.CreateRequest) (*emailtemplates.CreateResponse, error) {
+	if err := c.api.submit(ctx, "EmailTemplates.Create", body); err != nil {
+		return nil, err
+	}
+	return c.client.Create(ctx, body)
+}
+
+func (c *emailTemplatesClient) Delete(ctx context.Context, body emailtemplates.DeleteRequest) (*emailtemplates.DeleteResponse, error) {
+	if err := c.api.submit(ctx, "EmailTemplates.Delete", body); err != nil {
+		return nil, err
+	}
+	return c.client.Delete(ctx, body)
+}
+
+func (c *emailTemplatesClient) Get(ctx context.Context, body emailtemplates.GetRequest) (*emailtemplates.GetResponse, error) {
+	return c.client.Get(ctx, body)
+}
+
+func (c *emailTemplatesClient) GetAll(ctx context.Context, body emailtemplates.GetAllRequest) (*emailtemplates.GetAllResponse, error) {
+	return c.client.GetAll(ctx, body)
+}
+
+func (c *emailTemplatesClient) GetDefault(ctx context.Context, body emailtemplates.GetDefaultRequest) (*emailtemplates.GetDefaultResponse, error) {
+	return c.client.GetDefault(ctx, body)
+}
+
+func (c *emailTemplatesClient) SetDefault(ctx context.Context, body emailtemplates.SetDefaultRequest) (*emailtemplates.SetDefaultResponse, error) {
+	if err := c.api.submit(ctx, "EmailTemplates.SetDefault", body); err != nil {
+		return nil, err
+	}
+	return c.client.SetDefault(ctx, body)
+}
+
+func (c *emailTemplatesClient) UnsetDefault(ctx context.Context, body emailtemplates.UnsetDefaultRequest) (*emailtemplates.UnsetDefaultResponse, error) {
+	if err := c.api.submit(ctx, "EmailTemplates.UnsetDefault", body); err != nil {
+		return nil, err
+	}
+	return c.client.UnsetDefault(ctx, body)
+}
+
+func (c *emailTemplatesClient) Update(ctx context.Context, body emailtemplates.UpdateRequest) (*emailtemplates.UpdateResponse, error) {
+	if err := c.api.submit(ctx, "EmailTemplates.Update", body); err != nil {
+		return nil, err
+	}
+	return c.client.Update(ctx, body)
+}
+
+type environmentsClient struct {
+	api    *API
+	client api.EnvironmentsAPI
+}
+
+func (c *environmentsClient) Create(ctx context.Context, body environments.CreateRequest) (*environments.CreateResponse, error) {
+	if err := c.api.submit(ctx, "Environments.Create", body); err != nil {
+		return nil, err
+	}
+	return c.client.Create(ctx, body)
+}
+
+func (c *environmentsClient) Delete(ctx context.Context, body environments.DeleteRequest) (*environments.DeleteResponse, error) {
+	if err := c.api.submit(ctx, "Environments.Delete", body); err != nil {
+		return nil, err
+	}
+	return c.client.Delete(ctx, body)
+}
+
+func (c *environmentsClient) Get(ctx context.Context, body environments.GetRequest) (*environments.GetResponse, error) {
+	return c.client.Get(ctx, body)
+}
+
+func (c *environmentsClient) GetAll(ctx context.Context, body environments.GetAllRequest) (*environments.GetAllResponse, error) {
+	return c.client.GetAll(ctx, body)
+}
+
+func (c *environmentsClient) GetMetrics(ctx context.Context, body environments.GetMetricsRequest) (*environments.GetMetricsResponse, error) {
+	return c.client.GetMetrics(ctx, body)
+}
+
+func (c *environmentsClient) Update(ctx context.Context, body environments.UpdateRequest) (*environments.UpdateResponse, error) {
+	if err := c.api.submit(ctx, "Environments.Update", body); err != nil {
+		return nil, err
+	}
+	return c.client.Update(ctx, body)
+}
+
+type eventLogStreamingClient struct {
+	api    *API
+	client api.EventLogStreamingAPI
+}
+
+func (c *eventLogStreamingClient) Create(ctx context.Context, body eventlogstreaming.CreateRequest) (*eventlogstreaming.CreateResponse, error) {
+	if err := c.api.submit(ctx, "EventLogStreaming.Create", body); err != nil {
+		return nil, err
+	}
+	return c.client.Create(ctx, body)
+}
+
+func (c *eventLogStreamingClient) Delete(ctx context.Context, body eventlogstreaming.DeleteRequest) (*eventlogstreaming.DeleteResponse, error) {
+	if err := c.api.submit(ctx, "EventLogStreaming.Delete", body); err != nil {
+		return nil, err
+	}
+	return c.client.Delete(ctx, body)
+}
+
+func (c *eventLogStreamingClient) Disable(ctx context.Context, body eventlogstreaming.DisableRequest) (*eventlogstreaming.DisableResponse, error) {
+	if err := c.api.submit(ctx, "EventLogStreaming.Disable", body); err != nil {
+		return nil, err
+	}
+	return c.client.Disable(ctx, body)
+}
+
+func (c *eventLogStreamingClient) Enable(ctx context.Context, body eventlogstreaming.EnableRequest) (*eventlogstreaming.EnableResponse, error) {
+	if err := c.api.submit(ctx, "EventLogStreaming.Enable", body); err != nil {
+		return nil, err
+	}
+	return c.client.Enable(ctx, body)
+}
+
+func (c *eventLogStreamingClient) Get(ctx context.Context, body eventlogstreaming.GetRequest) (*eventlogstreaming.GetResponse, error) {
+	return c.client.Get(ctx, body)
+}
+
+func (c *eventLogStreamingClient) Update(ctx context.Context, body eventlogstreaming.UpdateRequest) (*eventlogstreaming.UpdateResponse, error) {
+	if err := c.api.submit(ctx, "EventLogStreaming.Update", body); err != nil {
+		return nil, err
+	}
+	return c.client.Update(ctx, body)
+}
+
+type jwtTemplatesClient struct {
+	api    *API
+	client api.JWTTemplatesAPI
+}
+
+func (c *jwtTemplatesClient) Get(ctx context.Context, body jwttemplates.GetRequest) (*jwttemplates.GetResponse, error) {
+	return c.client.Get(ctx, body)
+}
+
+func (c *jwtTemplatesClient) Set(ctx context.Context, body jwttemplates.SetRequest) (*jwttemplates.SetResponse, error) {
+	if err := c.api.submit(ctx, "JWTTemplates.Set", body); err != nil {
+		return nil, err
+	}
+	return c.client.Set(ctx, body)
+}
+
+type passwordStrengthConfigClient struct {
+	api    *API
+	client api.PasswordStrengthConfigAPI
+}
+
+func (c *passwordStrengthConfigClient) Get(ctx context.Context, body passwordstrengthconfig.GetRequest) (*passwordstrengthconfig.GetResponse, error) {
+	return c.client.Get(ctx, body)
+}
+
+func (c *passwordStrengthConfigClient) Set(ctx context.Context, body passwordstrengthconfig.SetRequest) (*passwordstrengthconfig.SetResponse, error) {
+	if err := c.api.submit(ctx, "PasswordStrengthConfig.Set", body); err != nil {
+		return nil, err
+	}
+	return c.client.Set(ctx, body)
+}
+
+type projectsClient struct {
+	api    *API
+	client api.ProjectsAPI
+}
+
+func (c *projectsClient) Create(ctx context.Context, body projects.CreateRequest) (*projects.CreateResponse, error) {
+	if err := c.api.submit(ctx, "Projects.Create", body); err != nil {
+		return nil, err
+	}
+	return c.client.Create(ctx, body)
+}
+
+func (c *projectsClient) Delete(ctx context.Context, body projects.DeleteRequest) (*projects.DeleteResponse, error) {
+	if err := c.api.submit(ctx, "Projects.Delete", body); err != nil {
+		return nil, err
+	}
+	return c.client.Delete(ctx, body)
+}
+
+func (c *projectsClient) Get(ctx context.Context, body projects.GetRequest) (*projects.GetResponse, error) {
+	return c.client.Get(ctx, body)
+}
+
+func (c *projectsClient) GetAll(ctx context.Context, body projects.GetAllRequest) (*projects.GetAllResponse, error) {
+	return c.client.GetAll(ctx, body)
+}
+
+func (c *projectsClient) Update(ctx context.Context, body projects.UpdateRequest) (*projects.UpdateResponse, error) {
+	if err := c.api.submit(ctx, "Projects.Update", body); err != nil {
+		return nil, err
+	}
+	return c.client.Update(ctx, body)
+}
+
+type publicTokensClient struct {
+	api    *API
+	client api.PublicTokensAPI
+}
+
+func (c *publicTokensClient) Create(ctx context.Context, body publictokens.CreateRequest) (*publictokens.CreateResponse, error) {
+	if err := c.api.submit(ctx, "PublicTokens.Create", body); err != nil {
+		return nil, err
+	}
+	return c.client.Create(ctx, body)
+}
+
+func (c *publicTokensClient) Delete(ctx context.Context, body publictokens.DeleteRequest) (*publictokens.DeleteResponse, error) {
+	if err := c.api.submit(ctx, "PublicTokens.Delete", body); err != nil {
+		return nil, err
+	}
+	return c.client.Delete(ctx, body)
+}
+
+func (c *publicTokensClient) Get(ctx context.Context, body publictokens.GetRequest) (*publictokens.GetResponse, error) {
+	return c.client.Get(ctx, body)
+}
+
+func (c *publicTokensClient) GetAll(ctx context.Context, body publictokens.GetAllRequest) (*publictokens.GetAllResponse, error) {
+	return c.client.GetAll(ctx, body)
+}
+
+type rbacPolicyClient struct {
+	api    *API
+	client api.RBACPolicyAPI
+}
+
+func (c *rbacPolicyClient) Get(ctx context.Context, body rbacpolicy.GetRequest) (*rbacpolicy.GetResponse, error) {
+	return c.client.Get(ctx, body)
+}
+
+func (c *rbacPolicyClient) Set(ctx context.Context, body rbacpolicy.SetRequest) (*rbacpolicy.SetResponse, error) {
+	if err := c.api.submit(ctx, "RBACPolicy.Set", body); err != nil {
+		return nil, err
+	}
+	return c.client.Set(ctx, body)
+}
+
+type redirectURLsClient struct {
+	api    *API
+	client api.RedirectURLsAPI
+}
+
+func (c *redirectURLsClient) Create(ctx context.Context, body redirecturls.CreateRequest) (*redirecturls.CreateResponse, error) {
+	if err := c.api.submit(ctx, "RedirectURLs.Create", body); err != nil {
+		return nil, err
+	}
+	return c.client.Create(ctx, body)
+}
+
+func (c *redirectURLsClient) Delete(ctx context.Context, body redirecturls.DeleteRequest) (*redirecturls.DeleteResponse, error) {
+	if err := c.api.submit(ctx, "RedirectURLs.Delete", body); err != nil {
+		return nil, err
+	}
+	return c.client.Delete(ctx, body)
+}
+
+func (c *redirectURLsClient) Get(ctx context.Context, body redirecturls.GetRequest) (*redirecturls.GetResponse, error) {
+	return c.client.Get(ctx, body)
+}
+
+func (c *redirectURLsClient) GetAll(ctx context.Context, body redirecturls.GetAllRequest) (*redirecturls.GetAllResponse, error) {
+	return c.client.GetAll(ctx, body)
+}
+
+func (c *redirectURLsClient) Update(ctx context.Context, body redirecturls.UpdateRequest) (*redirecturls.UpdateResponse, error) {
+	if err := c.api.submit(ctx, "RedirectURLs.Update", body); err != nil {
+		return nil, err
+	}
+	return c.client.Update(ctx, body)
+}
+
+type sdkClient struct {
+	api    *API
+	client api.SDKAPI
+}
+
+func (c *sdkClient) GetB2BConfig(ctx context.Context, body sdk.GetB2BConfigRequest) (*sdk.GetB2BConfigResponse, error) {
+	return c.client.GetB2BConfig(ctx, body)
+}
+
+func (c *sdkClient) GetConsumerConfig(ctx context.Context, body sdk.GetConsumerConfigRequest) (*sdk.GetConsumerConfigResponse, error) {
+	return c.client.GetConsumerConfig(ctx, body)
+}
+
+func (c *sdkClient) SetB2BConfig(ctx context.Context, body sdk.SetB2BConfigRequest) (*sdk.SetB2BConfigResponse, error) {
+	if err := c.api.submit(ctx, "SDK.SetB2BConfig", body); err != nil {
+		return nil, err
+	}
+	return c.client.SetB2BConfig(ctx, body)
+}
+
+func (c *sdkClient) SetConsumerConfig(ctx context.Context, body sdk.SetConsumerConfigRequest) (*sdk.SetConsumerConfigResponse, error) {
+	if err := c.api.submit(ctx, "SDK.SetConsumerConfig", body); err != nil {
+		return nil, err
+	}
+	return c.client.SetConsumerConfig(ctx, body)
+}
+
+type secretsClient struct {
+	api    *API
+	client api.SecretsAPI
+}
+
+func (c *secretsClient) Create(ctx context.Context, body secrets.CreateRequest) (*secrets.CreateResponse, error) {
+	if err := c.api.submit(ctx, "Secrets.Create", body); err != nil {
+		return nil, err
+	}
+	return c.client.Create(ctx, body)
+}
+
+func (c *secretsClient) Delete(ctx context.Context, body secrets.DeleteRequest) (*secrets.DeleteResponse, error) {
+	if err := c.api.submit(ctx, "Secrets.Delete", body); err != nil {
+		return nil, err
+	}
+	return c.client.Delete(ctx, body)
+}
+
+func (c *secretsClient) Get(ctx context.Context, body secrets.GetRequest) (*secrets.GetResponse, error) {
+	return c.client.Get(ctx, body)
+}
+
+func (c *secretsClient) GetAll(ctx context.Context, body secrets.GetAllRequest) (*secrets.GetAllResponse, error) {
+	return c.client.GetAll(ctx, body)
+}
+
+type trustedTokenProfilesClient struct {
+	api    *API
+	client api.TrustedTokenProfilesAPI
+}
+
+func (c *trustedTokenProfilesClient) Create(ctx context.Context, body trustedtokenprofiles.CreateRequest) (*trustedtokenprofiles.CreateResponse, error) {
+	if err := c.api.submit(ctx, "TrustedTokenProfiles.Create", body); err != nil {
+		return nil, err
+	}
+	return c.client.Create(ctx, body)
+}
+
+func (c *trustedTokenProfilesClient) CreatePEMFile(ctx context.Context, body trustedtokenprofiles.CreatePEMFileRequest) (*trustedtokenprofiles.CreatePEMFileResponse, error) {
+	if err := c.api.submit(ctx, "TrustedTokenProfiles.CreatePEMFile", body); err != nil {
+		return nil, err
+	}
+	return c.client.CreatePEMFile(ctx, body)
+}
+
+func (c *trustedTokenProfilesClient) Delete(ctx context.Context, body trustedtokenprofiles.DeleteRequest) (*trustedtokenprofiles.DeleteResponse, error) {
+	if err := c.api.submit(ctx, "TrustedTokenProfiles.Delete", body); err != nil {
+		return nil, err
+	}
+	return c.client.Delete(ctx, body)
+}
+
+func (c *trustedTokenProfilesClient) DeletePEMFile(ctx context.Context, body trustedtokenprofiles.DeletePEMFileRequest) (*trustedtokenprofiles.DeletePEMFileResponse, error) {
+	if err := c.api.submit(ctx, "TrustedTokenProfiles.DeletePEMFile", body); err != nil {
+		return nil, err
+	}
+	return c.client.DeletePEMFile(ctx, body)
+}
+
+func (c *trustedTokenProfilesClient) Get(ctx context.Context, body trustedtokenprofiles.GetRequest) (*trustedtokenprofiles.GetResponse, error) {
+	return c.client.Get(ctx, body)
+}
+
+func (c *trustedTokenProfilesClient) GetAll(ctx context.Context, body trustedtokenprofiles.GetAllRequest) (*trustedtokenprofiles.GetAllResponse, error) {
+	return c.client.GetAll(ctx, body)
+}
+
+func (c *trustedTokenProfilesClient) GetPEMFile(ctx context.Context, body trustedtokenprofiles.GetPEMFileRequest) (*trustedtokenprofiles.GetPEMFileResponse, error) {
+	return c.client.GetPEMFile(ctx, body)
+}
+
+func (c *trustedTokenProfilesClient) Update(ctx context.Context, body trustedtokenprofiles.UpdateRequest) (*trustedtokenprofiles.UpdateResponse, error) {
+	if err := c.api.submit(ctx, "TrustedTokenProfiles.Update", body); err != nil {
+		return nil, err
+	}
+	return c.client.Update(ctx, body)
+}
+
+type v1ToV3MigrationClient struct {
+	api    *API
+	client api.V1ToV3MigrationAPI
+}
+
+func (c *v1ToV3MigrationClient) GetProject(ctx context.Context, body migrationprojects.GetProjectRequest) (*migrationprojects.GetProjectResponse, error) {
+	return c.client.GetProject(ctx, body)
+}
+
+func (c *v1ToV3MigrationClient) GetProjects(ctx context.Context, body migrationprojects.GetProjectsRequest) (*migrationprojects.GetProjectsResponse, error) {
+	return c.client.GetProjects(ctx, body)
+}
+
+// decode decodes a request encoded by encodeRequest into the request type of operation.
+func decode(operation string, request json.RawMessage) (any, error) {
+	switch operation {
+	case "CountryCodeAllowlist.SetAllowedSMSCountryCodes":
+		var body countrycodeallowlist.SetAllowedSMSCountryCodesRequest
+		err := decodeRequest(request, &body)
+		return body, err
+	case "CountryCodeAllowlist.SetAllowedWhatsAppCountryCodes":
+		var body countrycodeallowlist.SetAllowedWhatsAppCountryCodesRequest
+		err := decodeRequest(request, &body)
+		return body, err
+	case "EmailTemplates.Create":
+		var body emailtemplates.CreateRequest
+		err := decodeRequest(request, &body)
+		return body, err
+	case "EmailTemplates.Delete":
+		var body emailtemplates.DeleteRequest
+		err := decodeRequest(request, &body)
+		return body, err
+	case "EmailTemplates.SetDefault":
+		var body emailtemplates.SetDefaultRequest
+		err := decodeRequest(request, &body)
+		return body, err
+	case "EmailTemplates.UnsetDefault":
+		var body emailtemplates.UnsetDefaultRequest
+		err := decodeRequest(request, &body)
+		return body, err
+	case "EmailTemplates.Update":
+		var body emailtemplates.UpdateRequest
+		err := decodeRequest(request, &body)
+		return body, err
+	case "Environments.Create":
+		var body environments.CreateRequest
+		err := decodeRequest(request, &body)
+		return body, err
+	case "Environments.Delete":
+		var body environments.DeleteRequest
+		err := decodeRequest(request, &body)
+		return body, err
+	case "Environments.Update":
+		var body environments.UpdateRequest
+		err := decodeRequest(request, &body)
+		return body, err
+	case "EventLogStreaming.Create":
+		var body eventlogstreaming.CreateRequest
+		err := decodeRequest(request, &body)
+		return body, err
+	case "EventLogStreaming.Delete":
+		var body eventlogstreaming.DeleteRequest
+		err := decodeRequest(request, &body)
+		return body, err
+	case "EventLogStreaming.Disable":
+		var body eventlogstreaming.DisableRequest
+		err := decodeRequest(request, &body)
+		return body, err
+	case "EventLogStreaming.Enable":
+		var body eventlogstreaming.EnableRequest
+		err := decodeRequest(request, &body)
+		return body, err
+	case "EventLogStreaming.Update":
+		var body eventlogstreaming.UpdateRequest
+		err := decodeRequest(request, &body)
+		return body, err
+	case "JWTTemplates.Set":
+		var body jwttemplates.SetRequest
+		err := decodeRequest(request, &body)
+		return body, err
+	case "PasswordStrengthConfig.Set":
+		var body passwordstrengthconfig.SetRequest
+		err := decodeRequest(request, &body)
+		return body, err
+	case "Projects.Create":
+		var body projects.CreateRequest
+		err := decodeRequest(request, &body)
+		return body, err
+	case "Projects.Delete":
+		var body projects.DeleteRequest
+		err := decodeRequest(request, &body)
+		return body, err
+	case "Projects.Update":
+		var body projects.UpdateRequest
+		err := decodeRequest(request, &body)
+		return body, err
+	case "PublicTokens.Create":
+		var body publictokens.CreateRequest
+		err := decodeRequest(request, &body)
+		return body, err
+	case "PublicTokens.Delete":
+		var body publictokens.DeleteRequest
+		err := decodeRequest(request, &body)
+		return body, err
+	case "RBACPolicy.Set":
+		var body rbacpolicy.SetRequest
+		err := decodeRequest(request, &body)
+		return body, err
+	case "RedirectURLs.Create":
+		var body redirecturls.CreateRequest
+		err := decodeRequest(request, &body)
+		return body, err
+	case "RedirectURLs.Delete":
+		var body redirecturls.DeleteRequest
+		err := decodeRequest(request, &body)
+		return body, err
+	case "RedirectURLs.Update":
+		var body redirecturls.UpdateRequest
+		err := decodeRequest(request, &body)
+		return body, err
+	case "SDK.SetB2BConfig":
+		var body sdk.SetB2BConfigRequest
+		err := decodeRequest(request, &body)
+		return body, err
+	case "SDK.SetConsumerConfig":
+		var body sdk.SetConsumerConfigRequest
+		err := decodeRequest(request, &body)
+		return body, err
+	case "Secrets.Create":
+		var body secrets.CreateRequest
+		err := decodeRequest(request, &body)
+		return body, err
+	case "Secrets.Delete":
+		var body secrets.DeleteRequest
+		err := decodeRequest(request, &body)
+		return body, err
+	case "TrustedTokenProfiles.Create":
+		var body trustedtokenprofiles.CreateRequest
+		err := decodeRequest(request, &body)
+		return body, err
+	case "TrustedTokenProfiles.CreatePEMFile":
+		var body trustedtokenprofiles.CreatePEMFileRequest
+		err := decodeRequest(request, &body)
+		return body, err
+	case "TrustedTokenProfiles.Delete":
+		var body trustedtokenprofiles.DeleteRequest
+		err := decodeRequest(request, &body)
+		return body, err
+	case "TrustedTokenProfiles.DeletePEMFile":
+		var body trustedtokenprofiles.DeletePEMFileRequest
+		err := decodeRequest(request, &body)
+		return body, err
+	case "TrustedTokenProfiles.Update":
+		var body trustedtokenprofiles.UpdateRequest
+		err := decodeRequest(request, &body)
+		return body, err
+	}
+	return nil, fmt.Errorf("unknown operation %s", operation)
+}
+
+// invoke passes a request returned by decode to the client method that sends operation.
+func invoke(ctx context.Context, client api.Interface, operation string, body any) (any, error) {
+	switch operation {
+	case "CountryCodeAllowlist.SetAllowedSMSCountryCodes":
+		return client.CountryCodeAllowlistAPI().SetAllowedSMSCountryCodes(ctx, body.(countrycodeallowlist.SetAllowedSMSCountryCodesRequest))
+	case "CountryCodeAllowlist.SetAllowedWhatsAppCountryCodes":
+		return client.CountryCodeAllowlistAPI().SetAllowedWhatsAppCountryCodes(ctx, body.(countrycodeallowlist.SetAllowedWhatsAppCountryCodesRequest))
+	case "EmailTemplates.Create":
+		return client.EmailTemplatesAPI().Create(ctx, body.(emailtemplates.CreateRequest))
+	case "EmailTemplates.Delete":
+		return client.EmailTemplatesAPI().Delete(ctx, body.(emailtemplates.DeleteRequest))
+	case "EmailTemplates.SetDefault":
+		return client.EmailTemplatesAPI().SetDefault(ctx, body.(emailtemplates.SetDefaultRequest))
+	case "EmailTemplates.UnsetDefault":
+		return client.EmailTemplatesAPI().UnsetDefault(ctx, body.(emailtemplates.UnsetDefaultRequest))
+	case "EmailTemplates.Update":
+		return client.EmailTemplatesAPI().Update(ctx, body.(emailtemplates.UpdateRequest))
+	case "Environments.Create":
+		return client.EnvironmentsAPI().Create(ctx, body.(environments.CreateRequest))
+	case "Environments.Delete":
+		return client.EnvironmentsAPI().Delete(ctx, body.(environments.DeleteRequest))
+	case "Environments.Update":
+		return client.EnvironmentsAPI().Update(ctx, body.(environments.UpdateRequest))
+	case "EventLogStreaming.Create":
+		return client.EventLogStreamingAPI().Create(ctx, body.(eventlogstreaming.CreateRequest))
+	case "EventLogStreaming.Delete":
+		return client.EventLogStreamingAPI().Delete(ctx, body.(eventlogstreaming.DeleteRequest))
+	case "EventLogStreaming.Disable":
+		return client.EventLogStreamingAPI().Disable(ctx, body.(eventlogstreaming.DisableRequest))
+	case "EventLogStreaming.Enable":
+		return client.EventLogStreamingAPI().Enable(ctx, body.(eventlogstreaming.EnableRequest))
+	case "EventLogStreaming.Update":
+		return client.EventLogStreamingAPI().Update(ctx, body.(eventlogstreaming.UpdateRequest))
+	case "JWTTemplates.Set":
+		return client.JWTTemplatesAPI().Set(ctx, body.(jwttemplates.SetRequest))
+	case "PasswordStrengthConfig.Set":
+		return client.PasswordStrengthConfigAPI().Set(ctx, body.(passwordstrengthconfig.SetRequest))
+	case "Projects.Create":
+		return client.ProjectsAPI().Create(ctx, body.(projects.CreateRequest))
+	case "Projects.Delete":
+		return client.ProjectsAPI().Delete(ctx, body.(projects.DeleteRequest))
+	case "Projects.Update":
+		return client.ProjectsAPI().Update(ctx, body.(projects.UpdateRequest))
+	case "PublicTokens.Create":
+		return client.PublicTokensAPI().Create(ctx, body.(publictokens.CreateRequest))
+	case "PublicTokens.Delete":
+		return client.PublicTokensAPI().Delete(ctx, body.(publictokens.DeleteRequest))
+	case "RBACPolicy.Set":
+		return client.RBACPolicyAPI().Set(ctx, body.(rbacpolicy.SetRequest))
+	case "RedirectURLs.Create":
+		return client.RedirectURLsAPI().Create(ctx, body.(redirecturls.CreateRequest))
+	case "RedirectURLs.Delete":
+		return client.RedirectURLsAPI().Delete(ctx, body.(redirecturls.DeleteRequest))
+	case "RedirectURLs.Update":
+		return client.RedirectURLsAPI().Update(ctx, body.(redirecturls.UpdateRequest))
+	case "SDK.SetB2BConfig":
+		return client.SDKAPI().SetB2BConfig(ctx, body.(sdk.SetB2BConfigRequest))
+	case "SDK.SetConsumerConfig":
+		return client.SDKAPI().SetConsumerConfig(ctx, body.(sdk.SetConsumerConfigRequest))
+	case "Secrets.Create":
+		return client.SecretsAPI().Create(ctx, body.(secrets.CreateRequest))
+	case "Secrets.Delete":
+		return client.SecretsAPI().Delete(ctx, body.(secrets.DeleteRequest))
+	case "TrustedTokenProfiles.Create":
+		return client.TrustedTokenProfilesAPI().Create(ctx, body.(trustedtokenprofiles.CreateRequest))
+	case "TrustedTokenProfiles.CreatePEMFile":
+		return client.TrustedTokenProfilesAPI().CreatePEMFile(ctx, body.(trustedtokenprofiles.CreatePEMFileRequest))
+	case "TrustedTokenProfiles.Delete":
+		return client.TrustedTokenProfilesAPI().Delete(ctx, body.(trustedtokenprofiles.DeleteRequest))
+	case "TrustedTokenProfiles.DeletePEMFile":
+		return client.TrustedTokenProfilesAPI().DeletePEMFile(ctx, body.(trustedtokenprofiles.DeletePEMFileRequest))
+	case "TrustedTokenProfiles.Update":
+		return client.TrustedTokenProfilesAPI().Update(ctx, body.(trustedtokenprofiles.UpdateRequest))
+	}
+	return nil, fmt.Errorf("unknown operation %s", operation)
+}
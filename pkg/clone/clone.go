@@ -0,0 +1,380 @@
+// Package clone copies the configuration of a project or an environment into a new one.
+//
+// Project creates a project with the same vertical as the source and replicates its email
+// templates and the configuration of every environment; Environment adds a copy of an environment
+// to the same project. Both are built on pkg/reconcile, and return a report of the IDs that were
+// generated for the copies and of everything that could not be copied:
+//
+//	report, err := clone.Project(ctx, client, "my-project", clone.ProjectTarget{Name: "My project (copy)"})
+//	if err != nil {
+//		return err
+//	}
+//	for _, s := range report.Skipped {
+//		fmt.Println(s) // secret[secret-test-...]: secret values cannot be read; create a new secret
+//	}
+//
+// Secrets, public tokens and event log streaming destinations are never copied, since they are
+// credentials or hold credentials.
+package clone
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/environments"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/publictokens"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/secrets"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/trustedtokenprofiles"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/reconcile"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/snapshot"
+)
+
+// ProjectTarget describes the project that Project creates.
+type ProjectTarget struct {
+	Name string
+	// ProjectSlug is optional. The API generates one if it is empty.
+	ProjectSlug string
+}
+
+// EnvironmentTarget describes the environment that Environment creates.
+type EnvironmentTarget struct {
+	// EnvironmentSlug is required, since the copy is created in the same project as its source.
+	EnvironmentSlug string
+	// Name defaults to the name of the source environment.
+	Name string
+}
+
+// Mapping records the ID generated for the copy of a resource.
+type Mapping struct {
+	Resource reconcile.Resource `json:"resource"`
+	Source   string             `json:"source"`
+	Target   string             `json:"target"`
+}
+
+// Skipped is a resource that was not copied.
+type Skipped struct {
+	Resource reconcile.Resource `json:"resource,omitempty"`
+	// Environment is the source environment of the resource, if it is environment-scoped.
+	Environment string `json:"environment,omitempty"`
+	Key         string `json:"key,omitempty"`
+	Reason      string `json:"reason"`
+}
+
+// String formats the skipped resource as "environment[slug].resource[key]: reason".
+func (s Skipped) String() string {
+	var prefix string
+	if s.Environment != "" {
+		prefix = fmt.Sprintf("environment[%s].", s.Environment)
+	}
+	if s.Resource != "" {
+		prefix += string(s.Resource)
+	}
+	if s.Key != "" {
+		prefix += "[" + s.Key + "]"
+	}
+	if prefix == "" {
+		return s.Reason
+	}
+	return prefix + ": " + s.Reason
+}
+
+// Report is the outcome of a clone.
+type Report struct {
+	// ProjectSlug is the project the copy was made in.
+	ProjectSlug string `json:"project_slug"`
+	// Mappings record the generated IDs of the copies, such as the slugs of the environments of a
+	// cloned project and the IDs of trusted token profiles. Email template IDs are chosen by the
+	// caller rather than generated, so templates keep their IDs.
+	Mappings []Mapping `json:"mappings"`
+	// Skipped lists what could not be copied.
+	Skipped []Skipped `json:"skipped"`
+	// Result is the outcome of applying the configuration to the copy.
+	Result *reconcile.Result `json:"-"`
+}
+
+// Err returns the errors of the configuration steps that failed, or nil.
+func (r *Report) Err() error {
+	return r.Result.Err()
+}
+
+// Lookup returns the ID generated for the copy of a resource, or "" if there is none.
+func (r *Report) Lookup(resource reconcile.Resource, source string) string {
+	for _, m := range r.Mappings {
+		if m.Resource == resource && m.Source == source {
+			return m.Target
+		}
+	}
+	return ""
+}
+
+// Project creates a project with the vertical of the source project and copies the source's
+// configuration into it. The source's environments are created in the copy with their own slugs,
+// the live environment first, since test environments can only be created once a project has one.
+// If the API created environments along with the project, the first source environment of each
+// type is copied into the one of that type instead.
+//
+// An error is returned only if the source cannot be read or the project cannot be created. Steps
+// that fail while the configuration is copied are reported by Report.Err.
+func Project(ctx context.Context, client api.Interface, sourceProjectSlug string, target ProjectTarget) (*Report, error) {
+	if target.Name == "" {
+		return nil, fmt.Errorf("Name cannot be empty")
+	}
+	source, err := snapshot.Take(ctx, client, sourceProjectSlug)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", sourceProjectSlug, err)
+	}
+
+	req := projects.CreateRequest{Name: target.Name, Vertical: source.Project.Vertical}
+	if target.ProjectSlug != "" {
+		req.ProjectSlug = &target.ProjectSlug
+	}
+	resp, err := client.ProjectsAPI().Create(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("creating project: %w", err)
+	}
+	projectSlug := resp.Project.ProjectSlug
+	current, err := snapshot.Take(ctx, client, projectSlug)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", projectSlug, err)
+	}
+
+	report := &Report{ProjectSlug: projectSlug}
+	desired := source.DeepCopy()
+	desired.Project = current.Project
+	slugs := environmentSlugs(source.Environments, current.Environments)
+	for i := range desired.Environments {
+		env := &desired.Environments[i]
+		sourceSlug := env.Settings.EnvironmentSlug
+		env.Settings.EnvironmentSlug = slugs[sourceSlug]
+		report.Mappings = append(report.Mappings, Mapping{
+			Resource: reconcile.ResourceEnvironment,
+			Source:   sourceSlug,
+			Target:   slugs[sourceSlug],
+		})
+	}
+
+	if err := report.apply(ctx, client, sourceProjectSlug, source, current, desired); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// environmentSlugs maps the slugs of the source environments to the slugs of their copies. The
+// first source environment of each type is mapped to the existing environment of that type, if
+// there is one, and every other environment keeps its slug.
+func environmentSlugs(source, existing []snapshot.Environment) map[string]string {
+	slugs := map[string]string{}
+	used := map[string]bool{}
+	for _, s := range source {
+		slug := s.Settings.EnvironmentSlug
+		for _, e := range existing {
+			if e.Settings.Type == s.Settings.Type && !used[e.Settings.EnvironmentSlug] {
+				slug = e.Settings.EnvironmentSlug
+				break
+			}
+		}
+		used[slug] = true
+		slugs[s.Settings.EnvironmentSlug] = slug
+	}
+	return slugs
+}
+
+// Environment creates a copy of an environment in the same project.
+//
+// An error is returned only if the source cannot be read or the request is invalid. Steps that
+// fail while the environment is created and configured are reported by Report.Err.
+func Environment(
+	ctx context.Context,
+	client api.Interface,
+	projectSlug string,
+	sourceEnvSlug string,
+	target EnvironmentTarget,
+) (*Report, error) {
+	if target.EnvironmentSlug == "" {
+		return nil, fmt.Errorf("EnvironmentSlug cannot be empty")
+	}
+	current, err := snapshot.Take(ctx, client, projectSlug, snapshot.WithEnvironments(sourceEnvSlug))
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", sourceEnvSlug, err)
+	}
+	envs, err := client.EnvironmentsAPI().GetAll(ctx, environments.GetAllRequest{ProjectSlug: projectSlug})
+	if err != nil {
+		return nil, fmt.Errorf("getting environments: %w", err)
+	}
+	if slices.ContainsFunc(envs.Environments, func(e environments.Environment) bool {
+		return e.EnvironmentSlug == target.EnvironmentSlug
+	}) {
+		return nil, fmt.Errorf("environment %s already exists in project %s", target.EnvironmentSlug, projectSlug)
+	}
+
+	desired := current.DeepCopy()
+	env := &desired.Environments[0]
+	env.Settings.EnvironmentSlug = target.EnvironmentSlug
+	if target.Name != "" {
+		env.Settings.Name = target.Name
+	}
+	report := &Report{
+		ProjectSlug: projectSlug,
+		Mappings: []Mapping{{
+			Resource: reconcile.ResourceEnvironment,
+			Source:   sourceEnvSlug,
+			Target:   target.EnvironmentSlug,
+		}},
+	}
+	source := current.DeepCopy()
+	if err := report.apply(ctx, client, projectSlug, source, current, desired); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// apply copies the configuration of the environments in desired, records what was skipped and
+// maps the IDs of the copied trusted token profiles.
+func (r *Report) apply(
+	ctx context.Context,
+	client api.Interface,
+	sourceProjectSlug string,
+	source, current, desired *snapshot.Snapshot,
+) error {
+	for i := range source.Environments {
+		if err := r.skip(ctx, client, sourceProjectSlug, &source.Environments[i]); err != nil {
+			return err
+		}
+	}
+	for i := range desired.Environments {
+		env := &desired.Environments[i]
+		// Event log streaming destinations need credentials, and generated IDs would not match.
+		env.EventLogStreaming = nil
+		for j := range env.TrustedTokenProfiles {
+			env.TrustedTokenProfiles[j].ProfileID = ""
+			for k := range env.TrustedTokenProfiles[j].PEMFiles {
+				env.TrustedTokenProfiles[j].PEMFiles[k].PEMFileID = ""
+			}
+		}
+	}
+
+	plan := reconcile.Compute(current, desired)
+	for _, warning := range plan.Warnings {
+		r.Skipped = append(r.Skipped, Skipped{Reason: warning})
+	}
+	r.Result = plan.Apply(ctx, client)
+
+	for i := range source.Environments {
+		src := &source.Environments[i]
+		if len(src.TrustedTokenProfiles) == 0 {
+			continue
+		}
+		targetSlug := r.Lookup(reconcile.ResourceEnvironment, src.Settings.EnvironmentSlug)
+		copied, err := snapshot.TakeEnvironment(ctx, client, r.ProjectSlug, targetSlug)
+		if err != nil && r.Err() != nil {
+			// The copy may not exist if creating it failed, which the result already reports.
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("reading %s: %w", targetSlug, err)
+		}
+		matched := map[string]bool{}
+		for _, p := range src.TrustedTokenProfiles {
+			c, ok := matchProfile(p, copied.TrustedTokenProfiles, matched)
+			if !ok {
+				continue
+			}
+			matched[c.ProfileID] = true
+			r.Mappings = append(r.Mappings, Mapping{
+				Resource: reconcile.ResourceTrustedTokenProfile,
+				Source:   p.ProfileID,
+				Target:   c.ProfileID,
+			})
+		}
+	}
+	return nil
+}
+
+// matchProfile returns the copy of a trusted token profile among the copies that are not matched
+// yet. Names are not unique, so a copy with the same settings is preferred to one that only has the
+// same name.
+func matchProfile(
+	p trustedtokenprofiles.TrustedTokenProfile,
+	copies []trustedtokenprofiles.TrustedTokenProfile,
+	matched map[string]bool,
+) (trustedtokenprofiles.TrustedTokenProfile, bool) {
+	for _, sameSettings := range []bool{true, false} {
+		for _, c := range copies {
+			if matched[c.ProfileID] || c.Name != p.Name {
+				continue
+			}
+			if !sameSettings || withoutIDs(c).Equal(withoutIDs(p)) {
+				return c, true
+			}
+		}
+	}
+	return trustedtokenprofiles.TrustedTokenProfile{}, false
+}
+
+// withoutIDs returns a copy of a trusted token profile without the IDs generated by the API.
+func withoutIDs(p trustedtokenprofiles.TrustedTokenProfile) trustedtokenprofiles.TrustedTokenProfile {
+	p = p.DeepCopy()
+	p.ProfileID = ""
+	for i := range p.PEMFiles {
+		p.PEMFiles[i].PEMFileID = ""
+	}
+	return p
+}
+
+// skip records the resources of a source environment that are not copied.
+func (r *Report) skip(ctx context.Context, client api.Interface, projectSlug string, env *snapshot.Environment) error {
+	envSlug := env.Settings.EnvironmentSlug
+	secretsResp, err := client.SecretsAPI().GetAll(ctx, secrets.GetAllRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+	})
+	if err != nil {
+		return fmt.Errorf("environment %s: getting secrets: %w", envSlug, err)
+	}
+	for _, s := range secretsResp.Secrets {
+		r.Skipped = append(r.Skipped, Skipped{
+			Resource:    "secret",
+			Environment: envSlug,
+			Key:         s.SecretID,
+			Reason:      "secret values cannot be read; create a new secret",
+		})
+	}
+	tokensResp, err := client.PublicTokensAPI().GetAll(ctx, publictokens.GetAllRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+	})
+	if err != nil {
+		return fmt.Errorf("environment %s: getting public tokens: %w", envSlug, err)
+	}
+	for _, t := range tokensResp.PublicTokens {
+		r.Skipped = append(r.Skipped, Skipped{
+			Resource:    "public_token",
+			Environment: envSlug,
+			Key:         t.PublicToken,
+			Reason:      "public tokens are generated per environment; use the copy's own tokens",
+		})
+	}
+	for _, d := range env.EventLogStreaming {
+		r.Skipped = append(r.Skipped, Skipped{
+			Resource:    reconcile.ResourceEventLogStreaming,
+			Environment: envSlug,
+			Key:         string(d.DestinationType),
+			Reason:      "destination credentials cannot be read; create the destination again",
+		})
+	}
+	for _, p := range env.TrustedTokenProfiles {
+		if len(p.PEMFiles) == 0 {
+			continue
+		}
+		r.Skipped = append(r.Skipped, Skipped{
+			Resource:    reconcile.ResourcePEMFile,
+			Environment: envSlug,
+			Key:         p.ProfileID,
+			Reason:      "only public keys are copied; the private keys are not held by Stytch",
+		})
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+package clone_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/apifake"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/clone"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/emailtemplates"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/environments"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/secrets"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/trustedtokenprofiles"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/reconcile"
+)
+
+// newFake returns a fake workspace with a source project, "src", whose test environment has a
+// user lock policy, a secret and a trusted token profile. Projects.Create creates "new" without
+// environments, and test environments can only be created once it has a live one, as
+// apitest.DisposableProject expects. Trusted token profiles in "new" appear once created.
+func newFake() *apifake.API {
+	fake := apifake.New()
+	fake.Projects.GetFunc = func(_ context.Context, body projects.GetRequest) (*projects.GetResponse, error) {
+		return &projects.GetResponse{Project: projects.Project{
+			ProjectSlug: body.ProjectSlug,
+			Vertical:    projects.VerticalB2B,
+		}}, nil
+	}
+	fake.Projects.CreateReturns(&projects.CreateResponse{Project: projects.Project{ProjectSlug: "new"}}, nil)
+	environmentsOf := map[string][]environments.Environment{
+		"src": {
+			{EnvironmentSlug: "test", Name: "Test", Type: environments.EnvironmentTypeTest, UserLockThreshold: 5},
+			{EnvironmentSlug: "production", Type: environments.EnvironmentTypeLive},
+		},
+	}
+	fake.Environments.GetAllFunc = func(
+		_ context.Context, body environments.GetAllRequest,
+	) (*environments.GetAllResponse, error) {
+		return &environments.GetAllResponse{Environments: environmentsOf[body.ProjectSlug]}, nil
+	}
+	fake.Environments.CreateFunc = func(
+		_ context.Context, body environments.CreateRequest,
+	) (*environments.CreateResponse, error) {
+		isLive := func(e environments.Environment) bool { return e.Type == environments.EnvironmentTypeLive }
+		if body.Type != environments.EnvironmentTypeLive && !slices.ContainsFunc(environmentsOf[body.ProjectSlug], isLive) {
+			return nil, errors.New("a live environment must be created first")
+		}
+		env := environments.Environment{EnvironmentSlug: *body.EnvironmentSlug, Name: body.Name, Type: body.Type}
+		environmentsOf[body.ProjectSlug] = append(environmentsOf[body.ProjectSlug], env)
+		return &environments.CreateResponse{Environment: env}, nil
+	}
+	fake.Environments.GetFunc = func(_ context.Context, body environments.GetRequest) (*environments.GetResponse, error) {
+		return &environments.GetResponse{Environment: environments.Environment{EnvironmentSlug: body.EnvironmentSlug}}, nil
+	}
+	fake.EmailTemplates.GetAllFunc = func(
+		_ context.Context, body emailtemplates.GetAllRequest,
+	) (*emailtemplates.GetAllResponse, error) {
+		if body.ProjectSlug == "src" {
+			return &emailtemplates.GetAllResponse{EmailTemplates: []emailtemplates.EmailTemplate{{TemplateID: "welcome"}}}, nil
+		}
+		return &emailtemplates.GetAllResponse{}, nil
+	}
+	fake.Secrets.GetAllFunc = func(_ context.Context, body secrets.GetAllRequest) (*secrets.GetAllResponse, error) {
+		if body.EnvironmentSlug == "test" {
+			return &secrets.GetAllResponse{Secrets: []secrets.MaskedSecret{{SecretID: "secret-test-1"}}}, nil
+		}
+		return &secrets.GetAllResponse{}, nil
+	}
+	fake.TrustedTokenProfiles.GetAllFunc = func(
+		_ context.Context, body trustedtokenprofiles.GetAllRequest,
+	) (*trustedtokenprofiles.GetAllResponse, error) {
+		switch {
+		case body.ProjectSlug == "src" && body.EnvironmentSlug == "test":
+			return &trustedtokenprofiles.GetAllResponse{Profiles: []trustedtokenprofiles.TrustedTokenProfile{{
+				ProfileID: "profile-src",
+				Name:      "Okta",
+				PEMFiles:  []trustedtokenprofiles.PEMFile{{PEMFileID: "pem-src", PublicKey: "public-key"}},
+			}}}, nil
+		case body.ProjectSlug == "new" && body.EnvironmentSlug == "test" && len(fake.TrustedTokenProfiles.CreateCalls()) > 0:
+			return &trustedtokenprofiles.GetAllResponse{Profiles: []trustedtokenprofiles.TrustedTokenProfile{{
+				ProfileID: "profile-new",
+				Name:      "Okta",
+			}}}, nil
+		}
+		return &trustedtokenprofiles.GetAllResponse{}, nil
+	}
+	return fake
+}
+
+func TestProject(t *testing.T) {
+	// Arrange
+	fake := newFake()
+
+	// Act
+	report, err := clone.Project(context.Background(), fake, "src", clone.ProjectTarget{Name: "Copy"})
+
+	// Assert
+	require.NoError(t, err)
+	require.NoError(t, report.Err())
+	assert.Equal(t, "new", report.ProjectSlug)
+
+	create := fake.Projects.CreateCalls()
+	require.Len(t, create, 1)
+	assert.Equal(t, projects.VerticalB2B, create[0].Vertical)
+
+	assert.Equal(t, "test", report.Lookup(reconcile.ResourceEnvironment, "test"))
+	assert.Equal(t, "production", report.Lookup(reconcile.ResourceEnvironment, "production"))
+	assert.Equal(t, "profile-new", report.Lookup(reconcile.ResourceTrustedTokenProfile, "profile-src"))
+
+	assert.Len(t, fake.EmailTemplates.CreateCalls(), 1)
+	envCreates := fake.Environments.CreateCalls()
+	require.Len(t, envCreates, 2)
+	assert.Equal(t, environments.EnvironmentTypeLive, envCreates[0].Type, "the live environment is created first")
+	assert.Equal(t, "test", *envCreates[1].EnvironmentSlug)
+	require.NotNil(t, envCreates[1].UserLockThreshold)
+	assert.Equal(t, 5, *envCreates[1].UserLockThreshold)
+	profiles := fake.TrustedTokenProfiles.CreateCalls()
+	require.Len(t, profiles, 1)
+	assert.Equal(t, []string{"public-key"}, profiles[0].PEMFiles)
+
+	var skipped []string
+	for _, s := range report.Skipped {
+		skipped = append(skipped, s.String())
+	}
+	assert.Equal(t, []string{
+		"environment[test].secret[secret-test-1]: secret values cannot be read; create a new secret",
+		"environment[test].pem_file[profile-src]: only public keys are copied; the private keys are not held by Stytch",
+	}, skipped)
+}
+
+func TestEnvironment(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("creates a copy", func(t *testing.T) {
+		// Arrange
+		fake := newFake()
+
+		// Act
+		report, err := clone.Environment(ctx, fake, "src", "test", clone.EnvironmentTarget{EnvironmentSlug: "qa"})
+
+		// Assert
+		require.NoError(t, err)
+		require.NoError(t, report.Err())
+		creates := fake.Environments.CreateCalls()
+		require.Len(t, creates, 1)
+		require.NotNil(t, creates[0].EnvironmentSlug)
+		assert.Equal(t, "qa", *creates[0].EnvironmentSlug)
+		assert.Equal(t, "Test", creates[0].Name)
+		assert.Equal(t, environments.EnvironmentTypeTest, creates[0].Type)
+		assert.Empty(t, fake.EmailTemplates.CreateCalls())
+	})
+
+	t.Run("maps profiles that share a name to different copies", func(t *testing.T) {
+		// Arrange
+		fake := newFake()
+		fake.TrustedTokenProfiles.GetAllFunc = func(
+			_ context.Context, body trustedtokenprofiles.GetAllRequest,
+		) (*trustedtokenprofiles.GetAllResponse, error) {
+			switch body.EnvironmentSlug {
+			case "test":
+				return &trustedtokenprofiles.GetAllResponse{Profiles: []trustedtokenprofiles.TrustedTokenProfile{
+					{ProfileID: "profile-web", Name: "Okta", Audience: "web"},
+					{ProfileID: "profile-mobile", Name: "Okta", Audience: "mobile"},
+				}}, nil
+			case "qa":
+				return &trustedtokenprofiles.GetAllResponse{Profiles: []trustedtokenprofiles.TrustedTokenProfile{
+					{ProfileID: "profile-a", Name: "Okta", Audience: "web"},
+					{ProfileID: "profile-b", Name: "Okta", Audience: "mobile"},
+				}}, nil
+			}
+			return &trustedtokenprofiles.GetAllResponse{}, nil
+		}
+
+		// Act
+		report, err := clone.Environment(ctx, fake, "src", "test", clone.EnvironmentTarget{EnvironmentSlug: "qa"})
+
+		// Assert
+		require.NoError(t, err)
+		require.NoError(t, report.Err())
+		assert.Len(t, fake.TrustedTokenProfiles.CreateCalls(), 2)
+		assert.Equal(t, "profile-a", report.Lookup(reconcile.ResourceTrustedTokenProfile, "profile-web"))
+		assert.Equal(t, "profile-b", report.Lookup(reconcile.ResourceTrustedTokenProfile, "profile-mobile"))
+	})
+
+	t.Run("refuses existing environments", func(t *testing.T) {
+		// Act
+		_, err := clone.Environment(ctx, newFake(), "src", "test", clone.EnvironmentTarget{EnvironmentSlug: "production"})
+
+		// Assert
+		assert.ErrorContains(t, err, "environment production already exists")
+	})
+}
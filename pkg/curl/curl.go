@@ -0,0 +1,259 @@
+// Package curl renders the HTTP requests sent by an api.API as curl commands, and their responses
+// as raw HTTP, for support tickets and for reproducing a problem outside of Go.
+//
+// Transport logs every request a client sends, along with the response:
+//
+//	transport := &curl.Transport{Out: os.Stderr}
+//	client := api.NewClient(keyID, keySecret, api.WithHTTPClient(&http.Client{Transport: transport}))
+//
+// Command renders the request a client method would send without sending it:
+//
+//	cmd, err := curl.Command(ctx, func(ctx context.Context, client api.Interface) error {
+//		_, err := client.ProjectsAPI().Create(ctx, projects.CreateRequest{Name: "My project", Vertical: projects.VerticalB2B})
+//		return err
+//	})
+//
+// Credentials are never rendered. The Authorization header is replaced by a reference to the
+// STYTCH_WORKSPACE_KEY_ID and STYTCH_WORKSPACE_KEY_SECRET environment variables, or to
+// STYTCH_ACCESS_TOKEN for access token clients, so that the command runs as it is once they are
+// set. The credentials in request and response bodies, such as the value of a new secret or the API
+// key of an event log streaming destination, are masked unless WithCredentials or
+// Transport.ShowCredentials asks for them.
+package curl
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/internal/redact"
+)
+
+// Placeholders for the credentials of a request, expanded by the shell.
+const (
+	BasicAuthPlaceholder  = `-u "$STYTCH_WORKSPACE_KEY_ID:$STYTCH_WORKSPACE_KEY_SECRET"`
+	BearerAuthPlaceholder = `-H "Authorization: Bearer $STYTCH_ACCESS_TOKEN"`
+)
+
+// Option configures FormatRequest and FormatResponse.
+type Option func(*options)
+
+type options struct {
+	showCredentials bool
+}
+
+// WithCredentials renders the credentials in request and response bodies as they are, instead of
+// masking them. Use it only when the output stays on your machine.
+func WithCredentials() Option {
+	return func(o *options) { o.showCredentials = true }
+}
+
+// renderBody returns body as it is rendered: with its credentials masked, unless the options ask
+// for them.
+func renderBody(body []byte, opts []Option) []byte {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.showCredentials {
+		return body
+	}
+	return redact.JSON(body)
+}
+
+// FormatRequest renders a request as a curl command, preceded by a comment with the name of the
+// api.Operation it sends, if any. basePath is the path of the base URI passed to api.WithBaseURI,
+// if it has one; it is removed from the request path before it is matched against operations. The
+// body of the request is read and replaced, so that the request can still be sent.
+func FormatRequest(req *http.Request, basePath string, opts ...Option) (string, error) {
+	body, err := readBody(&req.Body)
+	if err != nil {
+		return "", err
+	}
+	body = renderBody(body, opts)
+	var b strings.Builder
+	reqPath := strings.TrimPrefix(req.URL.Path, strings.TrimSuffix(basePath, "/"))
+	if op, _, ok := api.MatchOperation(req.Method, reqPath); ok {
+		fmt.Fprintf(&b, "# %s\n", op.Name)
+	}
+	b.WriteString("curl")
+	if req.Method != http.MethodGet {
+		b.WriteString(" -X " + req.Method)
+	}
+	b.WriteString(" " + quote(req.URL.String()))
+
+	auth := req.Header.Get("Authorization")
+	switch {
+	case auth == "":
+	case strings.HasPrefix(auth, "Basic "):
+		b.WriteString(" \\\n  " + BasicAuthPlaceholder)
+	case strings.HasPrefix(auth, "Bearer "):
+		b.WriteString(" \\\n  " + BearerAuthPlaceholder)
+	default:
+		b.WriteString(" \\\n  -H " + quote("Authorization: <redacted>"))
+	}
+	for _, name := range sortedHeaders(req.Header) {
+		if name == "Authorization" {
+			continue
+		}
+		for _, v := range req.Header[name] {
+			b.WriteString(" \\\n  -H " + quote(name+": "+v))
+		}
+	}
+	if len(body) > 0 {
+		b.WriteString(" \\\n  -d " + quote(string(body)))
+	}
+	return b.String(), nil
+}
+
+// FormatResponse renders a response as raw HTTP: the status line, the headers in sorted order, a
+// blank line and the body. The body of the response is read and replaced, so that it can still be
+// read by the caller.
+func FormatResponse(resp *http.Response, opts ...Option) (string, error) {
+	body, err := readBody(&resp.Body)
+	if err != nil {
+		return "", err
+	}
+	body = renderBody(body, opts)
+	proto := resp.Proto
+	if proto == "" {
+		proto = "HTTP/1.1"
+	}
+	status := resp.Status
+	if status == "" {
+		status = fmt.Sprintf("%d %s", resp.StatusCode, http.StatusText(resp.StatusCode))
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %s\n", proto, status)
+	for _, name := range sortedHeaders(resp.Header) {
+		for _, v := range resp.Header[name] {
+			fmt.Fprintf(&b, "%s: %s\n", name, v)
+		}
+	}
+	b.WriteString("\n")
+	b.Write(body)
+	if len(body) > 0 && body[len(body)-1] != '\n' {
+		b.WriteString("\n")
+	}
+	return b.String(), nil
+}
+
+// Transport is an http.RoundTripper that writes every request it sends to Out as a curl command,
+// followed by the raw response, or by the error if the request could not be sent.
+type Transport struct {
+	// Base sends the requests. If nil, http.DefaultTransport is used.
+	Base http.RoundTripper
+	// Out receives the commands and responses. Writes are serialized, so requests sent concurrently
+	// are not interleaved.
+	Out io.Writer
+	// BasePath is the path of the base URI passed to api.WithBaseURI, if it has one. See
+	// FormatRequest.
+	BasePath string
+	// ShowCredentials writes the credentials in request and response bodies as they are, instead
+	// of masking them. See WithCredentials.
+	ShowCredentials bool
+
+	mu sync.Mutex
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A RoundTripper must not modify the request, so render and send a clone, whose body
+	// FormatRequest replaces once it has read it.
+	req = req.Clone(req.Context())
+	var opts []Option
+	if t.ShowCredentials {
+		opts = append(opts, WithCredentials())
+	}
+	cmd, err := FormatRequest(req, t.BasePath, opts...)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.base().RoundTrip(req)
+	var out string
+	if err != nil {
+		out = fmt.Sprintf("# error: %v\n", err)
+	} else if out, err = FormatResponse(resp, opts...); err != nil {
+		return nil, err
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	fmt.Fprintf(t.Out, "%s\n\n%s\n", cmd, out)
+	return resp, err
+}
+
+func (t *Transport) base() http.RoundTripper {
+	if t.Base != nil {
+		return t.Base
+	}
+	return http.DefaultTransport
+}
+
+// errCaptured stops the request captured by Command from being sent.
+var errCaptured = errors.New("curl: request captured")
+
+// Command renders the request that call sends with the client it is given as a curl command,
+// without sending it. opts configure the client, for example with api.WithBaseURI; an
+// api.WithHTTPClient option is overridden. call should make a single request; only the first one
+// is rendered. Credentials in the request body are masked.
+func Command(ctx context.Context, call func(ctx context.Context, client api.Interface) error, opts ...api.APIOption) (string, error) {
+	var (
+		cmd string
+		err error
+	)
+	capture := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if cmd == "" && err == nil {
+			cmd, err = FormatRequest(req, "")
+		}
+		return nil, errCaptured
+	})
+	opts = append(opts, api.WithHTTPClient(&http.Client{Transport: capture}))
+	// The credentials are never rendered, so any will do.
+	client := api.NewClient("workspace-key-id", "workspace-key-secret", opts...)
+	callErr := call(ctx, client)
+	switch {
+	case err != nil:
+		return "", err
+	case cmd == "" && callErr != nil:
+		return "", callErr
+	case cmd == "":
+		return "", errors.New("curl: the call did not send a request")
+	}
+	return cmd, nil
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+// readBody reads a request or response body and replaces it with a reader of the same bytes.
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+	data, err := io.ReadAll(*body)
+	_ = (*body).Close()
+	*body = io.NopCloser(bytes.NewReader(data))
+	return data, err
+}
+
+func sortedHeaders(h http.Header) []string {
+	names := make([]string, 0, len(h))
+	for name := range h {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// quote quotes s for a POSIX shell, in single quotes, so that nothing in it is expanded.
+func quote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,164 @@
+package curl_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/curl"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/eventlogstreaming"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/redirecturls"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/secrets"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/version"
+)
+
+func TestCommand(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("renders the body", func(t *testing.T) {
+		// Act
+		cmd, err := curl.Command(ctx, func(ctx context.Context, client api.Interface) error {
+			_, err := client.ProjectsAPI().Create(ctx, projects.CreateRequest{Name: "Bob's project", Vertical: projects.VerticalB2B})
+			return err
+		})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "# Projects.Create\n"+
+			"curl -X POST 'https://management.stytch.com/pwa/v3/projects' \\\n"+
+			`  -u "$STYTCH_WORKSPACE_KEY_ID:$STYTCH_WORKSPACE_KEY_SECRET" \`+"\n"+
+			"  -H 'Content-Type: application/json' \\\n"+
+			"  -H 'User-Agent: stytch-management-go/"+version.Version+"' \\\n"+
+			`  -d '{"name":"Bob'\''s project","vertical":"B2B"}'`, cmd)
+	})
+
+	t.Run("renders query parameters", func(t *testing.T) {
+		// Act
+		cmd, err := curl.Command(ctx, func(ctx context.Context, client api.Interface) error {
+			promote := false
+			_, err := client.RedirectURLsAPI().Delete(ctx, redirecturls.DeleteRequest{
+				ProjectSlug:          "my-project",
+				EnvironmentSlug:      "test",
+				URL:                  "https://example.com/callback?next=/home",
+				DoNotPromoteDefaults: &promote,
+			})
+			return err
+		}, api.WithBaseURI("https://example.com"))
+
+		// Assert
+		require.NoError(t, err)
+		first, _, _ := strings.Cut(cmd, " \\\n")
+		assert.Equal(t, "# RedirectURLs.Delete\n"+
+			"curl -X DELETE 'https://example.com/pwa/v3/projects/my-project/environments/test/redirect_urls"+
+			"?do_not_promote_defaults=false&url=https%3A%2F%2Fexample.com%2Fcallback%3Fnext%3D%2Fhome'", first)
+		assert.NotContains(t, cmd, "-d ")
+	})
+
+	t.Run("masks credentials", func(t *testing.T) {
+		// Act
+		cmd, err := curl.Command(ctx, func(ctx context.Context, client api.Interface) error {
+			_, err := client.EventLogStreamingAPI().Create(ctx, eventlogstreaming.CreateRequest{
+				ProjectSlug:     "my-project",
+				EnvironmentSlug: "test",
+				DestinationType: eventlogstreaming.DestinationTypeDatadog,
+				DestinationConfig: &eventlogstreaming.DestinationConfig{
+					Datadog: &eventlogstreaming.DatadogConfig{APIKey: "datadog-api-key-1234", Site: "US"},
+				},
+			})
+			return err
+		})
+
+		// Assert
+		require.NoError(t, err)
+		assert.NotContains(t, cmd, "datadog-api-key")
+		assert.Contains(t, cmd, `"api_key":"...1234"`)
+	})
+
+	t.Run("no request", func(t *testing.T) {
+		// Act
+		_, err := curl.Command(ctx, func(context.Context, api.Interface) error { return nil })
+
+		// Assert
+		assert.EqualError(t, err, "curl: the call did not send a request")
+	})
+}
+
+func TestTransport(t *testing.T) {
+	// Arrange
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "request-id-test")
+		_, _ = w.Write([]byte(`{"request_id":"request-id-test","project":{"project_slug":"my-project"}}`))
+	}))
+	t.Cleanup(server.Close)
+	var out bytes.Buffer
+	transport := &curl.Transport{Base: server.Client().Transport, Out: &out}
+	client := api.NewAccessTokenClient("access-token-secret",
+		api.WithBaseURI(server.URL),
+		api.WithHTTPClient(&http.Client{Transport: transport}))
+
+	// Act
+	resp, err := client.Projects.Get(context.Background(), projects.GetRequest{ProjectSlug: "my-project"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "my-project", resp.Project.ProjectSlug)
+	assert.NotContains(t, out.String(), "access-token-secret")
+	assert.Equal(t, "# Projects.Get\n"+
+		"curl '"+server.URL+"/pwa/v3/projects/my-project' \\\n"+
+		`  -H "Authorization: Bearer $STYTCH_ACCESS_TOKEN" \`+"\n"+
+		"  -H 'Content-Type: application/json' \\\n"+
+		"  -H 'User-Agent: stytch-management-go/"+version.Version+"'\n"+
+		"\n"+
+		"HTTP/1.1 200 OK\n", out.String()[:strings.Index(out.String(), "Content-Length")])
+	assert.True(t, strings.HasSuffix(out.String(), "X-Request-Id: request-id-test\n"+
+		"\n"+
+		`{"request_id":"request-id-test","project":{"project_slug":"my-project"}}`+"\n\n"), out.String())
+}
+
+func TestTransportCredentials(t *testing.T) {
+	// Arrange
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"secret":{"secret_id":"secret-test-1","secret":"secret-value-abcd"}}`))
+	}))
+	t.Cleanup(server.Close)
+	create := func(showCredentials bool) string {
+		var out bytes.Buffer
+		transport := &curl.Transport{Base: server.Client().Transport, Out: &out, ShowCredentials: showCredentials}
+		client := api.NewClient("workspace-key-id", "workspace-key-secret",
+			api.WithBaseURI(server.URL),
+			api.WithHTTPClient(&http.Client{Transport: transport}))
+		resp, err := client.Secrets.Create(context.Background(), secrets.CreateRequest{
+			ProjectSlug:     "my-project",
+			EnvironmentSlug: "test",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "secret-value-abcd", resp.Secret.Reveal(), "the caller gets the response as it is")
+		return out.String()
+	}
+
+	t.Run("masked by default", func(t *testing.T) {
+		// Act
+		out := create(false)
+
+		// Assert
+		assert.NotContains(t, out, "secret-value")
+		assert.Contains(t, out, `{"secret":{"secret":"...abcd","secret_id":"secret-test-1"}}`)
+	})
+
+	t.Run("shown on request", func(t *testing.T) {
+		// Act
+		out := create(true)
+
+		// Assert
+		assert.Contains(t, out, `{"secret":{"secret_id":"secret-test-1","secret":"secret-value-abcd"}}`)
+	})
+}
@@ -0,0 +1,298 @@
+// Package diff compares values of the pkg/models types field by field.
+//
+// Compare walks two values of the same type and reports every leaf that differs, addressed by a
+// path made of the JSON field names of the models:
+//
+//	changes := diff.Compare(before, after)
+//	for _, c := range changes {
+//		fmt.Println(c) // oauth.pkce_required: false -> true
+//	}
+//
+// Nil pointers are compared as the zero value of the type they point to, and nil and empty slices
+// and maps are equal, so a missing section and an empty one do not produce changes. Slices of
+// structs are compared element by element, slices of scalars as a whole, and maps key by key.
+package diff
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Change is a single leaf value that differs between two values.
+type Change struct {
+	// Path addresses the leaf, such as "oauth.pkce_required", "domains[1].domain" or
+	// "attribute_mapping[email]". It is empty when the compared values are themselves leaves.
+	Path string `json:"path"`
+	// Before and After are the leaf values. A leaf that is missing on one side, such as a map entry
+	// that only one of the values has, is nil on that side.
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+// String formats the change as "path: before -> after".
+func (c Change) String() string {
+	values := FormatValue(c.Before) + " -> " + FormatValue(c.After)
+	if c.Path == "" {
+		return values
+	}
+	return c.Path + ": " + values
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// Compare returns the leaves that differ between before and after, in field order. Either value
+// may be nil, in which case it is compared as the zero value of the other's type.
+func Compare(before, after any) []Change {
+	b, a := reflect.ValueOf(before), reflect.ValueOf(after)
+	switch {
+	case !b.IsValid() && !a.IsValid():
+		return nil
+	case !b.IsValid():
+		b = reflect.Zero(a.Type())
+	case !a.IsValid():
+		a = reflect.Zero(b.Type())
+	}
+	var w walker
+	w.compare("", b, a)
+	return w.changes
+}
+
+type walker struct {
+	changes []Change
+}
+
+func (w *walker) add(path string, before, after reflect.Value) {
+	w.changes = append(w.changes, Change{Path: path, Before: leaf(before), After: leaf(after)})
+}
+
+// compare records the differences between b and a. Either may be invalid, meaning the value is
+// missing on that side.
+func (w *walker) compare(path string, b, a reflect.Value) {
+	b, a = indirect(b), indirect(a)
+	if !b.IsValid() && !a.IsValid() {
+		return
+	}
+	if b.IsValid() && a.IsValid() && b.Type() != a.Type() {
+		if !reflect.DeepEqual(b.Interface(), a.Interface()) {
+			w.add(path, b, a)
+		}
+		return
+	}
+	var typ reflect.Type
+	if a.IsValid() {
+		typ = a.Type()
+	} else {
+		typ = b.Type()
+	}
+
+	switch {
+	case typ == timeType:
+		if !b.IsValid() || !a.IsValid() || !b.Interface().(time.Time).Equal(a.Interface().(time.Time)) {
+			w.add(path, b, a)
+		}
+	case typ.Kind() == reflect.Struct:
+		b, a = orZero(b, typ), orZero(a, typ)
+		for i := 0; i < typ.NumField(); i++ {
+			name, ok := fieldName(typ.Field(i))
+			if !ok {
+				continue
+			}
+			w.compare(join(path, name), b.Field(i), a.Field(i))
+		}
+	case typ.Kind() == reflect.Map:
+		for _, key := range mapKeys(b, a) {
+			w.compare(fmt.Sprintf("%s[%v]", path, key.Interface()), mapIndex(b, key), mapIndex(a, key))
+		}
+	case typ.Kind() == reflect.Slice && isComposite(typ.Elem()):
+		n := max(length(b), length(a))
+		for i := 0; i < n; i++ {
+			w.compare(fmt.Sprintf("%s[%d]", path, i), sliceIndex(b, i), sliceIndex(a, i))
+		}
+	case typ.Kind() == reflect.Slice:
+		if length(b) != length(a) || (length(a) > 0 && !reflect.DeepEqual(b.Interface(), a.Interface())) {
+			w.add(path, b, a)
+		}
+	default:
+		if !b.IsValid() || !a.IsValid() || !reflect.DeepEqual(b.Interface(), a.Interface()) {
+			w.add(path, b, a)
+		}
+	}
+}
+
+// indirect follows pointers and interfaces. A nil pointer is replaced by the zero value of its
+// element type, and a nil interface by an invalid value.
+func indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() {
+		switch v.Kind() {
+		case reflect.Pointer:
+			if v.IsNil() {
+				return reflect.Zero(v.Type().Elem())
+			}
+			v = v.Elem()
+		case reflect.Interface:
+			if v.IsNil() {
+				return reflect.Value{}
+			}
+			v = v.Elem()
+		default:
+			return v
+		}
+	}
+	return v
+}
+
+func orZero(v reflect.Value, typ reflect.Type) reflect.Value {
+	if v.IsValid() {
+		return v
+	}
+	return reflect.Zero(typ)
+}
+
+// isComposite reports whether values of typ are compared field by field rather than as a whole.
+func isComposite(typ reflect.Type) bool {
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	switch typ.Kind() {
+	case reflect.Struct:
+		return typ != timeType
+	case reflect.Map, reflect.Interface:
+		return true
+	}
+	return false
+}
+
+// fieldName returns the name of a field in paths: its JSON name, or its Go name if it has none.
+// Unexported fields and fields excluded from JSON are skipped.
+func fieldName(f reflect.StructField) (string, bool) {
+	if !f.IsExported() {
+		return "", false
+	}
+	name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+	switch name {
+	case "-":
+		return "", false
+	case "":
+		return f.Name, true
+	}
+	return name, true
+}
+
+func join(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func length(v reflect.Value) int {
+	if !v.IsValid() {
+		return 0
+	}
+	return v.Len()
+}
+
+func sliceIndex(v reflect.Value, i int) reflect.Value {
+	if !v.IsValid() || i >= v.Len() {
+		return reflect.Value{}
+	}
+	return v.Index(i)
+}
+
+func mapIndex(v reflect.Value, key reflect.Value) reflect.Value {
+	if !v.IsValid() || v.IsNil() {
+		return reflect.Value{}
+	}
+	return v.MapIndex(key)
+}
+
+// mapKeys returns the keys of both maps, sorted by their formatted value.
+func mapKeys(maps ...reflect.Value) []reflect.Value {
+	seen := map[any]bool{}
+	var keys []reflect.Value
+	for _, m := range maps {
+		if !m.IsValid() {
+			continue
+		}
+		for _, k := range m.MapKeys() {
+			if !seen[k.Interface()] {
+				seen[k.Interface()] = true
+				keys = append(keys, k)
+			}
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+	})
+	return keys
+}
+
+// leaf returns the value of a leaf, or nil if it is missing.
+func leaf(v reflect.Value) any {
+	if !v.IsValid() {
+		return nil
+	}
+	return v.Interface()
+}
+
+// FormatValue formats a leaf value for display. Strings are quoted, slices of scalars are written
+// as lists, and missing values as "(none)".
+func FormatValue(v any) string {
+	if v == nil {
+		return "(none)"
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.String:
+		return strconv.Quote(rv.String())
+	case reflect.Slice:
+		if rv.Len() == 0 {
+			return "[]"
+		}
+		items := make([]string, rv.Len())
+		for i := range items {
+			items[i] = FormatValue(leaf(indirect(rv.Index(i))))
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	}
+	if t, ok := v.(time.Time); ok {
+		return t.Format(time.RFC3339)
+	}
+	return fmt.Sprint(v)
+}
+
+// Matcher matches paths against patterns. In a pattern, * matches any sequence of characters, and
+// a pattern matches a path if it matches the path as a whole or the start of it up to a "." or "[".
+// For example, "redirect_url[*localhost*]" matches every redirect URL on localhost and
+// "sdk_b2b.cookies" every field below sdk_b2b.cookies.
+type Matcher struct {
+	patterns []*regexp.Regexp
+}
+
+// NewMatcher returns a Matcher for the given patterns.
+func NewMatcher(patterns ...string) *Matcher {
+	m := &Matcher{}
+	for _, pattern := range patterns {
+		parts := strings.Split(pattern, "*")
+		for i, p := range parts {
+			parts[i] = regexp.QuoteMeta(p)
+		}
+		m.patterns = append(m.patterns, regexp.MustCompile(`^`+strings.Join(parts, `.*`)+`($|[.\[])`))
+	}
+	return m
+}
+
+// Match reports whether path matches any of the patterns.
+func (m *Matcher) Match(path string) bool {
+	for _, re := range m.patterns {
+		if re.MatchString(path) {
+			return true
+		}
+	}
+	return false
+}
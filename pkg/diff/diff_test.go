@@ -0,0 +1,110 @@
+package diff_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/diff"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/sdk"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/trustedtokenprofiles"
+)
+
+func TestCompare(t *testing.T) {
+	t.Run("nested pointers", func(t *testing.T) {
+		// Arrange
+		before := sdk.B2BConfig{OAuth: &sdk.B2BOAuthConfig{Enabled: true}}
+		after := sdk.B2BConfig{OAuth: &sdk.B2BOAuthConfig{Enabled: true, PKCERequired: true}}
+
+		// Act
+		changes := diff.Compare(before, after)
+
+		// Assert
+		assert.Equal(t, []diff.Change{{Path: "oauth.pkce_required", Before: false, After: true}}, changes)
+		assert.Equal(t, "oauth.pkce_required: false -> true", changes[0].String())
+	})
+
+	t.Run("nil and empty are equal", func(t *testing.T) {
+		// Arrange
+		before := sdk.B2BConfig{Basic: &sdk.B2BBasicConfig{}}
+		after := sdk.B2BConfig{Basic: &sdk.B2BBasicConfig{BundleIDs: []string{}}}
+
+		// Act
+		changes := diff.Compare(before, after)
+
+		// Assert
+		assert.Empty(t, changes)
+	})
+
+	t.Run("slices", func(t *testing.T) {
+		// Arrange
+		before := sdk.B2BBasicConfig{
+			Domains:   []sdk.AuthorizedB2BDomain{{Domain: "a.example.com"}},
+			BundleIDs: []string{"com.example"},
+		}
+		after := sdk.B2BBasicConfig{
+			Domains:   []sdk.AuthorizedB2BDomain{{Domain: "a.example.com"}, {Domain: "b.example.com"}},
+			BundleIDs: []string{"com.example", "com.example.beta"},
+		}
+
+		// Act
+		changes := diff.Compare(before, after)
+
+		// Assert
+		assert.Equal(t, []string{
+			`domains[1].domain: "" -> "b.example.com"`,
+			`bundle_ids: ["com.example"] -> ["com.example", "com.example.beta"]`,
+		}, strings(changes))
+	})
+
+	t.Run("maps", func(t *testing.T) {
+		// Arrange
+		before := trustedtokenprofiles.TrustedTokenProfile{AttributeMapping: &map[string]any{"email": "email"}}
+		after := trustedtokenprofiles.TrustedTokenProfile{AttributeMapping: &map[string]any{"name": "name"}}
+
+		// Act
+		changes := diff.Compare(before, after)
+
+		// Assert
+		assert.Equal(t, []string{
+			`attribute_mapping[email]: "email" -> (none)`,
+			`attribute_mapping[name]: (none) -> "name"`,
+		}, strings(changes))
+	})
+
+	t.Run("missing values", func(t *testing.T) {
+		// Act
+		changes := diff.Compare(nil, "LOGIN")
+
+		// Assert
+		assert.Equal(t, []diff.Change{{Before: "", After: "LOGIN"}}, changes)
+	})
+}
+
+func TestMatcher(t *testing.T) {
+	m := diff.NewMatcher("settings.name", "redirect_url[*localhost*]", "trusted_token_profile[*].profile_id")
+
+	for path, want := range map[string]bool{
+		"settings.name":        true,
+		"settings.name_suffix": false,
+		"redirect_url[http://localhost:3000/callback]": true,
+		"redirect_url[https://example.com/callback]":   false,
+		"trusted_token_profile[Okta].profile_id":       true,
+		"trusted_token_profile[Okta].audience":         false,
+	} {
+		t.Run(path, func(t *testing.T) {
+			// Act
+			got := m.Match(path)
+
+			// Assert
+			assert.Equal(t, want, got)
+		})
+	}
+}
+
+func strings(changes []diff.Change) []string {
+	out := make([]string, len(changes))
+	for i, c := range changes {
+		out[i] = c.String()
+	}
+	return out
+}
@@ -0,0 +1,258 @@
+// Package drift detects changes made to a project's live configuration since a baseline snapshot
+// was taken, such as redirect URLs or SDK domains edited in the dashboard.
+//
+// A Detector compares the live configuration with the baseline and sends one Event per drifted
+// value to its sinks:
+//
+//	baseline, err := snapshot.ReadFile("stytch.yaml")
+//	detector := &drift.Detector{
+//		Client:   client,
+//		Baseline: baseline,
+//		Sinks:    []drift.Sink{drift.NewTextSink(os.Stdout)},
+//		Ignore:   []string{"redirect_url[*localhost*]"},
+//	}
+//	report, err := detector.Check(ctx)
+//	os.Exit(drift.ExitCode(report, err))
+//
+// Run repeats the check on an interval for long-running processes.
+package drift
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/diff"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/envdiff"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/emailtemplates"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/snapshot"
+)
+
+// Exit statuses returned by ExitCode. They follow the convention of diff(1) and
+// `terraform plan -detailed-exitcode`, so that cron jobs and CI can tell drift from failures.
+const (
+	ExitOK    = 0
+	ExitError = 1
+	ExitDrift = 2
+)
+
+// Event is a single value that differs from the baseline.
+type Event struct {
+	DetectedAt  time.Time `json:"detected_at"`
+	ProjectSlug string    `json:"project_slug"`
+	// Environment is empty for project-level resources.
+	Environment string `json:"environment,omitempty"`
+	// Resource identifies the resource that drifted, such as "sdk_b2b" or
+	// "redirect_url[https://example.com/callback]".
+	Resource string `json:"resource"`
+	// Field is the path of the drifted value within the resource, such as "oauth.pkce_required". It
+	// is empty when the whole resource was added or removed.
+	Field string `json:"field,omitempty"`
+	// Expected is the value in the baseline and Actual the live value. Either is nil if the value
+	// does not exist on that side.
+	Expected any `json:"expected"`
+	Actual   any `json:"actual"`
+}
+
+// String formats the event as "environment[test].resource.field: expected -> actual".
+func (e Event) String() string {
+	path := e.Resource
+	if e.Environment != "" {
+		path = fmt.Sprintf("environment[%s].%s", e.Environment, path)
+	}
+	if e.Field != "" {
+		path += "." + e.Field
+	}
+	return diff.Change{Path: path, Before: e.Expected, After: e.Actual}.String()
+}
+
+// Report is the outcome of a check.
+type Report struct {
+	CheckedAt time.Time `json:"checked_at"`
+	Events    []Event   `json:"events"`
+	// Ignored is the number of drifted values that matched an ignore rule.
+	Ignored int `json:"ignored"`
+}
+
+// Drifted reports whether any drift was detected.
+func (r *Report) Drifted() bool {
+	return len(r.Events) > 0
+}
+
+// ExitCode returns the exit status for the outcome of a check: ExitError if err is not nil,
+// ExitDrift if the report has events, and ExitOK otherwise.
+func ExitCode(report *Report, err error) int {
+	switch {
+	case err != nil:
+		return ExitError
+	case report != nil && report.Drifted():
+		return ExitDrift
+	}
+	return ExitOK
+}
+
+// Detector compares live configuration with a baseline snapshot.
+type Detector struct {
+	Client api.Interface
+	// Baseline is the expected configuration. Only the environments it contains are checked; an
+	// environment that no longer exists is reported as drift.
+	Baseline *snapshot.Snapshot
+	// Sinks receive the events of every check that finds drift.
+	Sinks []Sink
+	// Ignore lists paths to leave out of the comparison, using the syntax of diff.Matcher. Paths
+	// start with the resource, such as "sdk_b2b.cookies" or "redirect_url[*localhost*]", and apply
+	// to every environment.
+	Ignore []string
+	// Now returns the current time. It defaults to time.Now.
+	Now func() time.Time
+}
+
+func (d *Detector) now() time.Time {
+	if d.Now != nil {
+		return d.Now()
+	}
+	return time.Now()
+}
+
+// Check reads the live configuration once, compares it with the baseline and sends any events to
+// the sinks. Sink errors are returned together with the report.
+func (d *Detector) Check(ctx context.Context) (*Report, error) {
+	baseline := d.Baseline.DeepCopy()
+	baseline.Normalize()
+	projectSlug := baseline.Project.ProjectSlug
+
+	live, err := snapshot.Take(ctx, d.Client, projectSlug)
+	if err != nil {
+		return nil, fmt.Errorf("reading live configuration: %w", err)
+	}
+
+	report := &Report{CheckedAt: d.now()}
+	ignore := diff.NewMatcher(d.Ignore...)
+	add := func(env, path string, expected, actual any) {
+		if ignore.Match(path) {
+			report.Ignored++
+			return
+		}
+		resource, field := splitPath(path)
+		report.Events = append(report.Events, Event{
+			DetectedAt:  report.CheckedAt,
+			ProjectSlug: projectSlug,
+			Environment: env,
+			Resource:    resource,
+			Field:       field,
+			Expected:    expected,
+			Actual:      actual,
+		})
+	}
+
+	compareProject(baseline, live, func(path string, expected, actual any) {
+		add("", path, expected, actual)
+	})
+	for i := range baseline.Environments {
+		expected := &baseline.Environments[i]
+		envSlug := expected.Settings.EnvironmentSlug
+		actual := live.Environment(envSlug)
+		if actual == nil {
+			add("", fmt.Sprintf("environment[%s]", envSlug), envSlug, nil)
+			continue
+		}
+		result := envdiff.Compare(expected, actual, envdiff.WithoutDefaultIgnores(),
+			envdiff.Ignore("settings.oauth_callback_id", "settings.project_id", "settings.created_at"))
+		for _, c := range result.Differences {
+			add(envSlug, c.Path, c.Left, c.Right)
+		}
+	}
+
+	if !report.Drifted() {
+		return report, nil
+	}
+	var errs []error
+	for _, sink := range d.Sinks {
+		if err := sink.Send(ctx, report.Events); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return report, errors.Join(errs...)
+}
+
+// Run checks for drift every interval until ctx is canceled, starting immediately. Errors are
+// passed to onError, which may be nil, and do not stop the loop. Run returns the context's error.
+func (d *Detector) Run(ctx context.Context, interval time.Duration, onError func(error)) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := d.Check(ctx); err != nil && onError != nil && ctx.Err() == nil {
+			onError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// compareProject reports the differences in project-level configuration.
+func compareProject(baseline, live *snapshot.Snapshot, add func(path string, expected, actual any)) {
+	for _, c := range diff.Compare(baseline.Project.Name, live.Project.Name) {
+		add("project.name", c.Before, c.After)
+	}
+
+	templates := map[string]emailtemplates.EmailTemplate{}
+	for _, t := range live.EmailTemplates {
+		templates[t.TemplateID] = t
+	}
+	seen := map[string]bool{}
+	for _, expected := range baseline.EmailTemplates {
+		path := fmt.Sprintf("email_template[%s]", expected.TemplateID)
+		seen[expected.TemplateID] = true
+		actual, ok := templates[expected.TemplateID]
+		if !ok {
+			add(path, expected, nil)
+			continue
+		}
+		for _, c := range diff.Compare(expected, actual) {
+			add(path+"."+c.Path, c.Before, c.After)
+		}
+	}
+	for _, actual := range live.EmailTemplates {
+		if !seen[actual.TemplateID] {
+			add(fmt.Sprintf("email_template[%s]", actual.TemplateID), nil, actual)
+		}
+	}
+
+	for _, typ := range emailtemplates.TemplateTypes() {
+		expected, actual := baseline.DefaultEmailTemplates[typ], live.DefaultEmailTemplates[typ]
+		if expected != actual {
+			add(fmt.Sprintf("default_email_template[%s]", typ), orNil(expected), orNil(actual))
+		}
+	}
+}
+
+func orNil(s string) any {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+// splitPath splits a path into the resource and the field within it, at the first "." that is not
+// inside brackets.
+func splitPath(path string) (resource, field string) {
+	depth := 0
+	for i, r := range path {
+		switch r {
+		case '[':
+			depth++
+		case ']':
+			depth--
+		case '.':
+			if depth == 0 {
+				return path[:i], path[i+1:]
+			}
+		}
+	}
+	return path, ""
+}
@@ -0,0 +1,272 @@
+package drift_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/apifake"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/drift"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/environments"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/redirecturls"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/sdk"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/snapshot"
+)
+
+var now = time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+// newFake returns a fake B2B project, "my-project", with a test environment that has one redirect
+// URL and one authorized SDK domain.
+func newFake() *apifake.API {
+	fake := apifake.New()
+	fake.Projects.GetReturns(&projects.GetResponse{Project: projects.Project{
+		ProjectSlug: "my-project",
+		Name:        "My project",
+		Vertical:    projects.VerticalB2B,
+	}}, nil)
+	fake.Environments.GetAllReturns(&environments.GetAllResponse{Environments: []environments.Environment{
+		{EnvironmentSlug: "test", Name: "Test", Type: environments.EnvironmentTypeTest},
+	}}, nil)
+	setRedirectURL(fake, "https://example.com/callback")
+	setSDKDomain(fake, "https://example.com")
+	return fake
+}
+
+func setRedirectURL(fake *apifake.API, url string) {
+	fake.RedirectURLs.GetAllReturns(&redirecturls.GetAllResponse{RedirectURLs: []redirecturls.RedirectURL{
+		{URL: url, ValidTypes: []redirecturls.URLType{{Type: redirecturls.RedirectURLTypeLogin, IsDefault: true}}},
+	}}, nil)
+}
+
+func setSDKDomain(fake *apifake.API, domain string) {
+	fake.SDK.GetB2BConfigReturns(&sdk.GetB2BConfigResponse{Config: sdk.B2BConfig{
+		Basic: &sdk.B2BBasicConfig{Enabled: true, Domains: []sdk.AuthorizedB2BDomain{{Domain: domain}}},
+	}}, nil)
+}
+
+func baseline(t *testing.T, fake *apifake.API) *snapshot.Snapshot {
+	t.Helper()
+	s, err := snapshot.Take(context.Background(), fake, "my-project")
+	require.NoError(t, err)
+	return s
+}
+
+func TestCheck(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("no drift", func(t *testing.T) {
+		// Arrange
+		fake := newFake()
+		var sent int
+		detector := &drift.Detector{
+			Client:   fake,
+			Baseline: baseline(t, fake),
+			Sinks: []drift.Sink{drift.SinkFunc(func(context.Context, []drift.Event) error {
+				sent++
+				return nil
+			})},
+		}
+
+		// Act
+		report, err := detector.Check(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		assert.False(t, report.Drifted())
+		assert.Zero(t, sent)
+		assert.Equal(t, drift.ExitOK, drift.ExitCode(report, err))
+	})
+
+	t.Run("reports changed redirect URLs and SDK domains", func(t *testing.T) {
+		// Arrange
+		fake := newFake()
+		detector := &drift.Detector{Client: fake, Baseline: baseline(t, fake), Now: func() time.Time { return now }}
+		setRedirectURL(fake, "https://evil.example.com/callback")
+		setSDKDomain(fake, "https://evil.example.com")
+
+		// Act
+		report, err := detector.Check(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, drift.ExitDrift, drift.ExitCode(report, err))
+		require.Len(t, report.Events, 3)
+		assert.Equal(t, drift.Event{
+			DetectedAt:  now,
+			ProjectSlug: "my-project",
+			Environment: "test",
+			Resource:    "sdk_b2b",
+			Field:       "basic.domains[0].domain",
+			Expected:    "https://example.com",
+			Actual:      "https://evil.example.com",
+		}, report.Events[2])
+
+		var lines []string
+		for _, e := range report.Events {
+			lines = append(lines, e.String())
+		}
+		assert.Equal(t, []string{
+			`environment[test].redirect_url[https://evil.example.com/callback]: (none) -> {https://evil.example.com/callback [{true LOGIN}]}`,
+			`environment[test].redirect_url[https://example.com/callback]: {https://example.com/callback [{true LOGIN}]} -> (none)`,
+			`environment[test].sdk_b2b.basic.domains[0].domain: "https://example.com" -> "https://evil.example.com"`,
+		}, lines)
+	})
+
+	t.Run("ignore rules", func(t *testing.T) {
+		// Arrange
+		fake := newFake()
+		detector := &drift.Detector{
+			Client:   fake,
+			Baseline: baseline(t, fake),
+			Ignore:   []string{"redirect_url[*]", "sdk_b2b.basic.domains"},
+		}
+		setRedirectURL(fake, "http://localhost:3000/callback")
+		setSDKDomain(fake, "http://localhost:3000")
+
+		// Act
+		report, err := detector.Check(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Empty(t, report.Events)
+		assert.Equal(t, 3, report.Ignored)
+	})
+
+	t.Run("deleted environments", func(t *testing.T) {
+		// Arrange
+		fake := newFake()
+		detector := &drift.Detector{Client: fake, Baseline: baseline(t, fake)}
+		fake.Environments.GetAllReturns(&environments.GetAllResponse{}, nil)
+
+		// Act
+		report, err := detector.Check(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, report.Events, 1)
+		assert.Equal(t, `environment[test]: "test" -> (none)`, report.Events[0].String())
+	})
+
+	t.Run("sink errors", func(t *testing.T) {
+		// Arrange
+		fake := newFake()
+		detector := &drift.Detector{
+			Client:   fake,
+			Baseline: baseline(t, fake),
+			Sinks: []drift.Sink{drift.SinkFunc(func(context.Context, []drift.Event) error {
+				return errors.New("sink unavailable")
+			})},
+		}
+		setSDKDomain(fake, "https://evil.example.com")
+
+		// Act
+		report, err := detector.Check(ctx)
+
+		// Assert
+		assert.ErrorContains(t, err, "sink unavailable")
+		assert.True(t, report.Drifted())
+		assert.Equal(t, drift.ExitError, drift.ExitCode(report, err))
+	})
+}
+
+func TestSinks(t *testing.T) {
+	ctx := context.Background()
+	events := []drift.Event{{
+		DetectedAt:  now,
+		ProjectSlug: "my-project",
+		Environment: "test",
+		Resource:    "sdk_b2b",
+		Field:       "basic.domains[0].domain",
+		Expected:    "https://example.com",
+		Actual:      "https://evil.example.com",
+	}}
+
+	t.Run("text", func(t *testing.T) {
+		// Arrange
+		var buf bytes.Buffer
+
+		// Act
+		err := drift.NewTextSink(&buf).Send(ctx, events)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t,
+			"drift: environment[test].sdk_b2b.basic.domains[0].domain: \"https://example.com\" -> \"https://evil.example.com\"\n",
+			buf.String())
+	})
+
+	t.Run("JSONL", func(t *testing.T) {
+		// Arrange
+		var buf bytes.Buffer
+
+		// Act
+		err := drift.NewJSONLSink(&buf).Send(ctx, append(events, events...))
+
+		// Assert
+		require.NoError(t, err)
+		scanner := bufio.NewScanner(&buf)
+		var n int
+		for scanner.Scan() {
+			n++
+			assert.JSONEq(t, `{
+				"detected_at": "2026-10-19T12:00:00Z",
+				"project_slug": "my-project",
+				"environment": "test",
+				"resource": "sdk_b2b",
+				"field": "basic.domains[0].domain",
+				"expected": "https://example.com",
+				"actual": "https://evil.example.com"
+			}`, scanner.Text())
+		}
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("webhook", func(t *testing.T) {
+		// Arrange
+		var body struct {
+			Events []map[string]any `json:"events"`
+		}
+		var auth string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			auth = r.Header.Get("Authorization")
+			b, _ := io.ReadAll(r.Body)
+			_ = json.Unmarshal(b, &body)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+		sink := &drift.WebhookSink{URL: server.URL, Header: http.Header{"Authorization": {"Bearer token"}}}
+
+		// Act
+		err := sink.Send(ctx, events)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "Bearer token", auth)
+		require.Len(t, body.Events, 1)
+		assert.Equal(t, "sdk_b2b", body.Events[0]["resource"])
+	})
+
+	t.Run("webhook failure", func(t *testing.T) {
+		// Arrange
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer server.Close()
+
+		// Act
+		err := (&drift.WebhookSink{URL: server.URL}).Send(ctx, events)
+
+		// Assert
+		assert.ErrorContains(t, err, "webhook returned 502 Bad Gateway")
+	})
+}
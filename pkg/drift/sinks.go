@@ -0,0 +1,110 @@
+package drift
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// Sink receives the events of a check that found drift.
+type Sink interface {
+	Send(ctx context.Context, events []Event) error
+}
+
+// SinkFunc adapts a function to the Sink interface.
+type SinkFunc func(ctx context.Context, events []Event) error
+
+// Send calls f.
+func (f SinkFunc) Send(ctx context.Context, events []Event) error {
+	return f(ctx, events)
+}
+
+type textSink struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewTextSink returns a sink that writes one line per event to w, such as os.Stdout.
+func NewTextSink(w io.Writer) Sink {
+	return &textSink{w: w}
+}
+
+func (s *textSink) Send(_ context.Context, events []Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, e := range events {
+		if _, err := fmt.Fprintf(s.w, "drift: %s\n", e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type jsonlSink struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewJSONLSink returns a sink that writes each event to w as a line of JSON.
+func NewJSONLSink(w io.Writer) Sink {
+	return &jsonlSink{w: w}
+}
+
+func (s *jsonlSink) Send(_ context.Context, events []Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	enc := json.NewEncoder(s.w)
+	enc.SetEscapeHTML(false)
+	for _, e := range events {
+		if err := enc.Encode(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WebhookSink posts the events of each check as a JSON document, {"events": [...]}, to a URL.
+type WebhookSink struct {
+	URL string
+	// Header is added to every request, such as an Authorization header.
+	Header http.Header
+	// Client sends the requests. It defaults to http.DefaultClient.
+	Client *http.Client
+}
+
+// Send posts the events and fails if the response status is not 2xx.
+func (s *WebhookSink) Send(ctx context.Context, events []Event) error {
+	body, err := json.Marshal(struct {
+		Events []Event `json:"events"`
+	}{events})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for k, v := range s.Header {
+		req.Header[k] = v
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("sending drift events: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("sending drift events: webhook returned %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,270 @@
+// Package envdiff compares the configuration of two environments, such as the TEST and LIVE
+// environments of a project or the environments of two different projects.
+//
+// Environments reads both environments, each through its own client, and reports every
+// difference across their environment-scoped resources:
+//
+//	result, err := envdiff.Environments(ctx,
+//		envdiff.Target{Client: client, ProjectSlug: "my-project", EnvironmentSlug: "test"},
+//		envdiff.Target{Client: client, ProjectSlug: "my-project", EnvironmentSlug: "production"},
+//	)
+//	for _, d := range result.Differences {
+//		fmt.Println(d) // redirect_url[https://localhost:3000/callback]: {...} -> (none)
+//	}
+//
+// Values that are expected to differ between environments, such as OAuth callback IDs and public
+// tokens, are ignored by default. See DefaultIgnores and Ignore.
+package envdiff
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/diff"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/publictokens"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/snapshot"
+)
+
+// Target is an environment to compare.
+type Target struct {
+	// Client reads the environment. The two targets of a comparison may use different clients, such
+	// as clients for different workspaces.
+	Client          api.Interface
+	ProjectSlug     string
+	EnvironmentSlug string
+}
+
+// String returns the target as "project/environment".
+func (t Target) String() string {
+	return t.ProjectSlug + "/" + t.EnvironmentSlug
+}
+
+// Difference is a single value that differs between two environments.
+type Difference struct {
+	// Path addresses the value, starting with the resource it belongs to, such as "settings.name",
+	// "sdk_b2b.oauth.pkce_required" or "redirect_url[https://example.com/callback]". Resources that
+	// an environment has several of are keyed by URL, template type, profile name or destination
+	// type.
+	Path string `json:"path"`
+	// Left and Right are the values in the two environments. A resource that only one environment
+	// has is reported as a single difference, with the whole resource on one side and nil on the
+	// other.
+	Left  any `json:"left"`
+	Right any `json:"right"`
+}
+
+// String formats the difference as "path: left -> right".
+func (d Difference) String() string {
+	return diff.Change{Path: d.Path, Before: d.Left, After: d.Right}.String()
+}
+
+// Result is the outcome of a comparison.
+type Result struct {
+	// Left and Right identify the compared environments.
+	Left  string `json:"left"`
+	Right string `json:"right"`
+	// Differences are grouped by resource, in the same order for every comparison.
+	Differences []Difference `json:"differences"`
+	// Ignored is the number of differences that matched an ignore rule.
+	Ignored int `json:"ignored"`
+}
+
+// Equal reports whether no differences were found.
+func (r *Result) Equal() bool {
+	return len(r.Differences) == 0
+}
+
+// DefaultIgnores returns the ignore rules applied unless WithoutDefaultIgnores is given. They cover
+// the values that identify an environment or are assigned by the API, and so always differ.
+func DefaultIgnores() []string {
+	return []string{
+		"settings.environment_slug",
+		"settings.project_slug",
+		"settings.project_id",
+		"settings.name",
+		"settings.type",
+		"settings.oauth_callback_id",
+		"settings.created_at",
+		"trusted_token_profile[*].profile_id",
+		"trusted_token_profile[*].pem_files[*].pem_file_id",
+		"public_tokens",
+	}
+}
+
+type options struct {
+	ignores          []string
+	noDefaultIgnores bool
+}
+
+// Option configures a comparison.
+type Option func(*options)
+
+// Ignore skips the differences whose path matches one of the patterns, using the syntax of
+// diff.Matcher. For example, "redirect_url[*localhost*]" ignores every redirect URL on localhost and
+// "sdk_b2b.cookies" every SDK cookie setting.
+func Ignore(patterns ...string) Option {
+	return func(o *options) {
+		o.ignores = append(o.ignores, patterns...)
+	}
+}
+
+// WithoutDefaultIgnores reports the differences that DefaultIgnores would skip.
+func WithoutDefaultIgnores() Option {
+	return func(o *options) {
+		o.noDefaultIgnores = true
+	}
+}
+
+// Environments reads both environments and compares them. Public tokens are compared as well as
+// the resources in a snapshot environment, though they are ignored by default.
+func Environments(ctx context.Context, left, right Target, opts ...Option) (*Result, error) {
+	l, err := read(ctx, left)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", left, err)
+	}
+	r, err := read(ctx, right)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", right, err)
+	}
+	c := newComparer(opts)
+	c.compareEnvironments(l.env, r.env)
+	c.compare("public_tokens", l.publicTokens, r.publicTokens)
+	return c.result(left.String(), right.String()), nil
+}
+
+// Compare compares two environments that have already been read, such as the environments of two
+// snapshot files.
+func Compare(left, right *snapshot.Environment, opts ...Option) *Result {
+	c := newComparer(opts)
+	c.compareEnvironments(left, right)
+	return c.result(left.Settings.EnvironmentSlug, right.Settings.EnvironmentSlug)
+}
+
+type state struct {
+	env          *snapshot.Environment
+	publicTokens []string
+}
+
+func read(ctx context.Context, t Target) (*state, error) {
+	env, err := snapshot.TakeEnvironment(ctx, t.Client, t.ProjectSlug, t.EnvironmentSlug)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.Client.PublicTokensAPI().GetAll(ctx, publictokens.GetAllRequest{
+		ProjectSlug:     t.ProjectSlug,
+		EnvironmentSlug: t.EnvironmentSlug,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getting public tokens: %w", err)
+	}
+	s := &state{env: env}
+	for _, token := range resp.PublicTokens {
+		s.publicTokens = append(s.publicTokens, token.PublicToken)
+	}
+	slices.Sort(s.publicTokens)
+	return s, nil
+}
+
+type comparer struct {
+	ignores     *diff.Matcher
+	differences []Difference
+	ignored     int
+}
+
+func newComparer(opts []Option) *comparer {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	patterns := o.ignores
+	if !o.noDefaultIgnores {
+		patterns = append(DefaultIgnores(), patterns...)
+	}
+	return &comparer{ignores: diff.NewMatcher(patterns...)}
+}
+
+func (c *comparer) add(d Difference) {
+	if c.ignores.Match(d.Path) {
+		c.ignored++
+		return
+	}
+	c.differences = append(c.differences, d)
+}
+
+// compare records the differences between two values of a resource.
+func (c *comparer) compare(resource string, left, right any) {
+	for _, change := range diff.Compare(left, right) {
+		path := resource
+		switch {
+		case change.Path == "":
+		case strings.HasPrefix(change.Path, "["):
+			path += change.Path
+		default:
+			path += "." + change.Path
+		}
+		c.add(Difference{Path: path, Left: change.Before, Right: change.After})
+	}
+}
+
+// compareKeyed compares the resources that an environment has several of, matching them by key.
+// Keys need not be unique: the second resource with a key is matched as "key#2", and so on, the
+// same way pkg/reconcile names the steps that create them.
+func compareKeyed[T any](c *comparer, resource string, left, right []T, key func(T) string) {
+	rights := byKey(right, key)
+	lefts := byKey(left, key)
+	var keys []string
+	for k := range rights {
+		keys = append(keys, k)
+	}
+	for k := range lefts {
+		if _, ok := rights[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+	for _, k := range keys {
+		path := fmt.Sprintf("%s[%s]", resource, k)
+		l, inLeft := lefts[k]
+		r, inRight := rights[k]
+		switch {
+		case inLeft && inRight:
+			c.compare(path, l, r)
+		case inLeft:
+			c.add(Difference{Path: path, Left: l})
+		default:
+			c.add(Difference{Path: path, Right: r})
+		}
+	}
+}
+
+// byKey indexes resources by key, numbering the ones whose key is already taken.
+func byKey[T any](items []T, key func(T) string) map[string]T {
+	m := map[string]T{}
+	for _, item := range items {
+		name := key(item)
+		k := name
+		_, taken := m[k]
+		for n := 2; taken; n++ {
+			k = fmt.Sprintf("%s#%d", name, n)
+			_, taken = m[k]
+		}
+		m[k] = item
+	}
+	return m
+}
+
+func (c *comparer) result(left, right string) *Result {
+	differences := c.differences
+	if differences == nil {
+		differences = []Difference{}
+	}
+	return &Result{
+		Left:        left,
+		Right:       right,
+		Differences: differences,
+		Ignored:     c.ignored,
+	}
+}
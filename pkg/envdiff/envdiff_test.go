@@ -0,0 +1,141 @@
+package envdiff_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/apifake"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/envdiff"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/environments"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/publictokens"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/redirecturls"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/sdk"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/trustedtokenprofiles"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/snapshot"
+)
+
+// newFake returns a fake B2B environment with the given slug, redirect URL and PKCE setting.
+// Everything that identifies the environment differs between calls.
+func newFake(envSlug, redirectURL string, pkceRequired bool) *apifake.API {
+	fake := apifake.New()
+	fake.Projects.GetReturns(&projects.GetResponse{Project: projects.Project{Vertical: projects.VerticalB2B}}, nil)
+	fake.Environments.GetReturns(&environments.GetResponse{Environment: environments.Environment{
+		EnvironmentSlug: envSlug,
+		Name:            envSlug,
+		OAuthCallbackID: "callback-" + envSlug,
+	}}, nil)
+	fake.RedirectURLs.GetAllReturns(&redirecturls.GetAllResponse{RedirectURLs: []redirecturls.RedirectURL{
+		{URL: redirectURL, ValidTypes: []redirecturls.URLType{{Type: redirecturls.RedirectURLTypeLogin}}},
+	}}, nil)
+	fake.SDK.GetB2BConfigReturns(&sdk.GetB2BConfigResponse{Config: sdk.B2BConfig{
+		OAuth: &sdk.B2BOAuthConfig{Enabled: true, PKCERequired: pkceRequired},
+	}}, nil)
+	fake.TrustedTokenProfiles.GetAllReturns(&trustedtokenprofiles.GetAllResponse{
+		Profiles: []trustedtokenprofiles.TrustedTokenProfile{{ProfileID: "profile-" + envSlug, Name: "Okta"}},
+	}, nil)
+	fake.PublicTokens.GetAllReturns(&publictokens.GetAllResponse{PublicTokens: []publictokens.PublicToken{
+		{PublicToken: "public-token-" + envSlug},
+	}}, nil)
+	return fake
+}
+
+func TestEnvironments(t *testing.T) {
+	ctx := context.Background()
+	test := envdiff.Target{
+		Client:          newFake("test", "http://localhost:3000/callback", false),
+		ProjectSlug:     "my-project",
+		EnvironmentSlug: "test",
+	}
+	live := envdiff.Target{
+		Client:          newFake("production", "https://example.com/callback", true),
+		ProjectSlug:     "other-project",
+		EnvironmentSlug: "production",
+	}
+
+	t.Run("reports differences", func(t *testing.T) {
+		// Act
+		result, err := envdiff.Environments(ctx, test, live)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "my-project/test", result.Left)
+		assert.Equal(t, "other-project/production", result.Right)
+		var paths []string
+		for _, d := range result.Differences {
+			paths = append(paths, d.Path)
+		}
+		assert.Equal(t, []string{
+			"redirect_url[http://localhost:3000/callback]",
+			"redirect_url[https://example.com/callback]",
+			"sdk_b2b.oauth.pkce_required",
+		}, paths)
+		assert.Nil(t, result.Differences[0].Right)
+		assert.Nil(t, result.Differences[1].Left)
+		assert.Equal(t, "sdk_b2b.oauth.pkce_required: false -> true", result.Differences[2].String())
+		assert.Equal(t, 5, result.Ignored)
+	})
+
+	t.Run("applies ignore rules", func(t *testing.T) {
+		// Act
+		result, err := envdiff.Environments(ctx, test, live, envdiff.Ignore("redirect_url[*]", "sdk_b2b.oauth"))
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, result.Equal())
+		assert.Equal(t, 8, result.Ignored)
+	})
+
+	t.Run("without default ignores", func(t *testing.T) {
+		// Act
+		result, err := envdiff.Environments(ctx, test, live, envdiff.WithoutDefaultIgnores())
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 0, result.Ignored)
+		assert.Contains(t, result.Differences, envdiff.Difference{
+			Path:  "settings.oauth_callback_id",
+			Left:  "callback-test",
+			Right: "callback-production",
+		})
+		assert.Contains(t, result.Differences, envdiff.Difference{
+			Path:  "public_tokens",
+			Left:  []string{"public-token-test"},
+			Right: []string{"public-token-production"},
+		})
+	})
+}
+
+func TestCompare(t *testing.T) {
+	// Arrange
+	left := &snapshot.Environment{SMSCountryCodes: []string{"CA", "US"}}
+	right := &snapshot.Environment{SMSCountryCodes: []string{"US"}}
+
+	// Act
+	result := envdiff.Compare(left, right)
+
+	// Assert
+	require.Len(t, result.Differences, 1)
+	assert.Equal(t, `sms_country_codes: ["CA", "US"] -> ["US"]`, result.Differences[0].String())
+}
+
+func TestCompareProfilesWithTheSameName(t *testing.T) {
+	// Arrange
+	left := &snapshot.Environment{TrustedTokenProfiles: []trustedtokenprofiles.TrustedTokenProfile{
+		{ProfileID: "profile-1", Name: "Okta", Audience: "web"},
+		{ProfileID: "profile-2", Name: "Okta", Audience: "mobile"},
+	}}
+	right := &snapshot.Environment{TrustedTokenProfiles: []trustedtokenprofiles.TrustedTokenProfile{
+		{ProfileID: "profile-3", Name: "Okta", Audience: "web"},
+		{ProfileID: "profile-4", Name: "Okta", Audience: "desktop"},
+	}}
+
+	// Act
+	result := envdiff.Compare(left, right)
+
+	// Assert
+	require.Len(t, result.Differences, 1)
+	assert.Equal(t, `trusted_token_profile[Okta#2].audience: "mobile" -> "desktop"`, result.Differences[0].String())
+}
@@ -0,0 +1,31 @@
+package envdiff
+
+import (
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/eventlogstreaming"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/jwttemplates"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/redirecturls"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/trustedtokenprofiles"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/snapshot"
+)
+
+// compareEnvironments compares every environment-scoped resource of a snapshot environment. The
+// resource names in paths match the ones used by pkg/reconcile.
+func (c *comparer) compareEnvironments(left, right *snapshot.Environment) {
+	c.compare("settings", left.Settings, right.Settings)
+	compareKeyed(c, "redirect_url", left.RedirectURLs, right.RedirectURLs,
+		func(u redirecturls.RedirectURL) string { return u.URL })
+	c.compare("sdk_b2b", left.B2BSDKConfig, right.B2BSDKConfig)
+	c.compare("sdk_consumer", left.ConsumerSDKConfig, right.ConsumerSDKConfig)
+	c.compare("rbac_policy", left.RBACPolicy, right.RBACPolicy)
+	compareKeyed(c, "jwt_template", left.JWTTemplates, right.JWTTemplates,
+		func(t jwttemplates.JWTTemplate) string { return string(t.JWTTemplateType) })
+	c.compare("password_strength_config", left.PasswordStrengthConfig, right.PasswordStrengthConfig)
+	c.compare("sms_country_codes", left.SMSCountryCodes, right.SMSCountryCodes)
+	c.compare("whatsapp_country_codes", left.WhatsAppCountryCodes, right.WhatsAppCountryCodes)
+	// Profile IDs are assigned by the API, so profiles are matched by name across environments, and
+	// profiles that share a name by the order they are listed in.
+	compareKeyed(c, "trusted_token_profile", left.TrustedTokenProfiles, right.TrustedTokenProfiles,
+		func(p trustedtokenprofiles.TrustedTokenProfile) string { return p.Name })
+	compareKeyed(c, "event_log_streaming", left.EventLogStreaming, right.EventLogStreaming,
+		func(e eventlogstreaming.EventLogStreamingMasked) string { return string(e.DestinationType) })
+}
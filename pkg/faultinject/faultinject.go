@@ -0,0 +1,145 @@
+// Package faultinject provides an http.RoundTripper that injects failures into requests sent by an
+// api.API, for testing how code built on the client behaves when the Management API is slow,
+// rate limited or unavailable, without depending on a flaky live service.
+//
+//	transport := &faultinject.Transport{
+//		Rules: []faultinject.Rule{
+//			{Operation: "Projects.Create", Fault: faultinject.TooManyRequests(2 * time.Second), Times: 1},
+//			{Probability: 0.1, Fault: faultinject.ServerError(http.StatusServiceUnavailable)},
+//		},
+//	}
+//	client := api.NewClient(keyID, keySecret, api.WithHTTPClient(&http.Client{Transport: transport}))
+//
+// The client does not retry failed requests itself, so every injected fault is returned to the
+// caller: a 429 or 5xx as a stytcherror.Error with the injected status code, and transport-level
+// faults as the error returned by the http.Client.
+package faultinject
+
+import (
+	"math/rand"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
+)
+
+// Transport is an http.RoundTripper that checks each request against its rules and either injects
+// the fault of the first rule that fires or sends the request with Base. A Transport must not be
+// copied after first use.
+type Transport struct {
+	// Base sends the requests that are not faulted, and the requests of faults such as SlowBody that
+	// wrap a real response. If nil, http.DefaultTransport is used.
+	Base http.RoundTripper
+	// Rules are checked in order. The first rule that matches the request and fires, according to
+	// its probability, injects its fault.
+	Rules []Rule
+	// BasePath is the path of the base URI passed to api.WithBaseURI, if it has one. It is removed
+	// from request paths before they are matched against operations.
+	BasePath string
+	// Rand is the source of randomness for rule probabilities. If nil, the randomly seeded default
+	// source of math/rand is used. Set it to a seeded source for reproducible runs.
+	Rand *rand.Rand
+
+	mu    sync.Mutex
+	fired map[int]int
+	log   []Injection
+}
+
+// Rule selects the requests a fault is injected into. A zero Rule matches every request.
+type Rule struct {
+	// Operation matches the name of the api.Operation sent by the request, such as
+	// "Projects.Create". It may be a pattern as accepted by path.Match, such as "Secrets.*". If
+	// empty, every request matches.
+	Operation string
+	// Method matches the HTTP method of the request. If empty, every method matches.
+	Method string
+	// Probability is the chance, between 0 and 1, that the rule fires for a matching request. Zero
+	// is treated as 1, so that a rule without a probability always fires.
+	Probability float64
+	// Times is the maximum number of times the rule fires. If zero, there is no limit.
+	Times int
+	// Fault is injected when the rule fires.
+	Fault Fault
+}
+
+// Injection records a fault injected by a Transport.
+type Injection struct {
+	// Operation is the name of the api.Operation the request matched, or empty if it matched none.
+	Operation string
+	Method    string
+	Path      string
+	// Fault is the name of the injected fault, such as "TooManyRequests".
+	Fault string
+}
+
+// Injections returns the faults injected so far, in order.
+func (t *Transport) Injections() []Injection {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]Injection(nil), t.log...)
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqPath := strings.TrimPrefix(req.URL.Path, strings.TrimSuffix(t.BasePath, "/"))
+	op, _, _ := api.MatchOperation(req.Method, reqPath)
+
+	if fault, ok := t.pick(req.Method, op.Name); ok {
+		t.mu.Lock()
+		t.log = append(t.log, Injection{Operation: op.Name, Method: req.Method, Path: reqPath, Fault: fault.name})
+		t.mu.Unlock()
+		return fault.inject(req, t.base())
+	}
+	return t.base().RoundTrip(req)
+}
+
+// pick returns the fault of the first rule that matches and fires.
+func (t *Transport) pick(method, operation string) (Fault, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for i, rule := range t.Rules {
+		if !rule.matches(method, operation) {
+			continue
+		}
+		if rule.Times > 0 && t.fired[i] >= rule.Times {
+			continue
+		}
+		if rule.Probability > 0 && rule.Probability < 1 && t.float64() >= rule.Probability {
+			continue
+		}
+		if t.fired == nil {
+			t.fired = map[int]int{}
+		}
+		t.fired[i]++
+		return rule.Fault, true
+	}
+	return Fault{}, false
+}
+
+func (r Rule) matches(method, operation string) bool {
+	if r.Method != "" && !strings.EqualFold(r.Method, method) {
+		return false
+	}
+	if r.Operation == "" {
+		return true
+	}
+	ok, err := path.Match(r.Operation, operation)
+	return ok && err == nil
+}
+
+// float64 must be called with t.mu held, since a rand.Rand is not safe for concurrent use.
+func (t *Transport) float64() float64 {
+	if t.Rand != nil {
+		return t.Rand.Float64()
+	}
+	return rand.Float64()
+}
+
+func (t *Transport) base() http.RoundTripper {
+	if t.Base != nil {
+		return t.Base
+	}
+	return http.DefaultTransport
+}
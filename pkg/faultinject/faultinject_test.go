@@ -0,0 +1,217 @@
+package faultinject_test
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/faultinject"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/stytcherror"
+)
+
+// newClient returns a client for a server that answers every request with an empty project, sending
+// requests through transport.
+func newClient(t *testing.T, transport *faultinject.Transport) *api.API {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"request_id": "request-id-test", "project": {"project_slug": "my-project"}}`))
+	}))
+	t.Cleanup(server.Close)
+	transport.Base = server.Client().Transport
+	return api.NewClient("key-id", "key-secret",
+		api.WithBaseURI(server.URL),
+		api.WithHTTPClient(&http.Client{Transport: transport}))
+}
+
+func TestTransport(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("passes through unmatched requests", func(t *testing.T) {
+		// Arrange
+		transport := &faultinject.Transport{Rules: []faultinject.Rule{
+			{Operation: "Projects.Create", Fault: faultinject.ServerError(http.StatusInternalServerError)},
+		}}
+		client := newClient(t, transport)
+
+		// Act
+		resp, err := client.Projects.Get(ctx, projects.GetRequest{ProjectSlug: "my-project"})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "my-project", resp.Project.ProjectSlug)
+		assert.Empty(t, transport.Injections())
+	})
+
+	t.Run("injects 429 with Retry-After", func(t *testing.T) {
+		// Arrange
+		transport := &faultinject.Transport{Rules: []faultinject.Rule{
+			{Operation: "Projects.Get", Fault: faultinject.TooManyRequests(1500 * time.Millisecond)},
+		}}
+		client := newClient(t, transport)
+		req := httptest.NewRequest(http.MethodGet, "/pwa/v3/projects/other-project", nil)
+
+		// Act
+		_, err := client.Projects.Get(ctx, projects.GetRequest{ProjectSlug: "my-project"})
+		resp, rtErr := transport.RoundTrip(req)
+
+		// Assert
+		var stytchErr stytcherror.Error
+		require.ErrorAs(t, err, &stytchErr)
+		assert.Equal(t, http.StatusTooManyRequests, stytchErr.StatusCode)
+		require.NoError(t, rtErr)
+		assert.Equal(t, "2", resp.Header.Get("Retry-After"))
+		assert.Equal(t, faultinject.Injection{
+			Operation: "Projects.Get",
+			Method:    http.MethodGet,
+			Path:      "/pwa/v3/projects/my-project",
+			Fault:     "TooManyRequests",
+		}, transport.Injections()[0])
+	})
+
+	t.Run("injects server errors", func(t *testing.T) {
+		// Arrange
+		transport := &faultinject.Transport{Rules: []faultinject.Rule{
+			{Fault: faultinject.ServerError(http.StatusServiceUnavailable)},
+		}}
+		client := newClient(t, transport)
+
+		// Act
+		_, err := client.Projects.GetAll(ctx, projects.GetAllRequest{})
+
+		// Assert
+		var stytchErr stytcherror.Error
+		require.ErrorAs(t, err, &stytchErr)
+		assert.Equal(t, http.StatusServiceUnavailable, stytchErr.StatusCode)
+	})
+
+	t.Run("injects timeouts", func(t *testing.T) {
+		// Arrange
+		transport := &faultinject.Transport{Rules: []faultinject.Rule{
+			{Fault: faultinject.Timeout(time.Millisecond)},
+		}}
+		client := newClient(t, transport)
+
+		// Act
+		_, err := client.Projects.GetAll(ctx, projects.GetAllRequest{})
+
+		// Assert
+		var netErr net.Error
+		require.ErrorAs(t, err, &netErr)
+		assert.True(t, netErr.Timeout())
+	})
+
+	t.Run("timeouts respect the request context", func(t *testing.T) {
+		// Arrange
+		transport := &faultinject.Transport{Rules: []faultinject.Rule{
+			{Fault: faultinject.Timeout(time.Hour)},
+		}}
+		client := newClient(t, transport)
+		ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+
+		// Act
+		_, err := client.Projects.GetAll(ctx, projects.GetAllRequest{})
+
+		// Assert
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("injects connection resets", func(t *testing.T) {
+		// Arrange
+		transport := &faultinject.Transport{Rules: []faultinject.Rule{
+			{Method: http.MethodPost, Fault: faultinject.ConnectionReset()},
+		}}
+		client := newClient(t, transport)
+
+		// Act
+		_, err := client.Projects.Create(ctx, projects.CreateRequest{Name: "My project", Vertical: projects.VerticalB2B})
+
+		// Assert
+		assert.True(t, errors.Is(err, syscall.ECONNRESET), "got %v", err)
+	})
+
+	t.Run("injects malformed JSON", func(t *testing.T) {
+		// Arrange
+		transport := &faultinject.Transport{Rules: []faultinject.Rule{
+			{Operation: "Projects.*", Fault: faultinject.MalformedJSON()},
+		}}
+		client := newClient(t, transport)
+
+		// Act
+		_, err := client.Projects.Get(ctx, projects.GetRequest{ProjectSlug: "my-project"})
+
+		// Assert
+		assert.ErrorContains(t, err, "error decoding http request")
+	})
+
+	t.Run("slows down bodies", func(t *testing.T) {
+		// Arrange
+		transport := &faultinject.Transport{Rules: []faultinject.Rule{
+			{Fault: faultinject.SlowBody(5 * time.Millisecond)},
+		}}
+		client := newClient(t, transport)
+		start := time.Now()
+
+		// Act
+		resp, err := client.Projects.Get(ctx, projects.GetRequest{ProjectSlug: "my-project"})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "my-project", resp.Project.ProjectSlug)
+		assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+	})
+
+	t.Run("stops after Times injections", func(t *testing.T) {
+		// Arrange
+		transport := &faultinject.Transport{Rules: []faultinject.Rule{
+			{Times: 2, Fault: faultinject.ServerError(http.StatusBadGateway)},
+		}}
+		client := newClient(t, transport)
+
+		// Act
+		var errs []error
+		for i := 0; i < 3; i++ {
+			_, err := client.Projects.GetAll(ctx, projects.GetAllRequest{})
+			errs = append(errs, err)
+		}
+
+		// Assert
+		assert.Error(t, errs[0])
+		assert.Error(t, errs[1])
+		assert.NoError(t, errs[2])
+	})
+
+	t.Run("injects by probability", func(t *testing.T) {
+		// Arrange
+		transport := &faultinject.Transport{
+			Rand: rand.New(rand.NewSource(1)),
+			Rules: []faultinject.Rule{
+				{Probability: 0.5, Fault: faultinject.ServerError(http.StatusInternalServerError)},
+			},
+		}
+		client := newClient(t, transport)
+
+		// Act
+		failures := 0
+		for i := 0; i < 200; i++ {
+			if _, err := client.Projects.GetAll(ctx, projects.GetAllRequest{}); err != nil {
+				failures++
+			}
+		}
+
+		// Assert
+		assert.InDelta(t, 100, failures, 30)
+		assert.Len(t, transport.Injections(), failures)
+	})
+}
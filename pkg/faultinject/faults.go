@@ -0,0 +1,162 @@
+package faultinject
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/stytcherror"
+)
+
+// Fault is a failure injected into a request. Create one with TooManyRequests, ServerError,
+// Timeout, ConnectionReset, MalformedJSON or SlowBody.
+type Fault struct {
+	name   string
+	inject func(req *http.Request, base http.RoundTripper) (*http.Response, error)
+}
+
+// String returns the name of the fault, such as "TooManyRequests".
+func (f Fault) String() string {
+	return f.name
+}
+
+// TooManyRequests responds with a 429 status, a Retry-After header of retryAfter rounded up to
+// whole seconds, and a Stytch error body. The request is not sent.
+func TooManyRequests(retryAfter time.Duration) Fault {
+	return Fault{name: "TooManyRequests", inject: func(req *http.Request, _ http.RoundTripper) (*http.Response, error) {
+		resp := errorResponse(req, http.StatusTooManyRequests, "too_many_requests",
+			"Too many requests have been made.")
+		resp.Header.Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+		return resp, nil
+	}}
+}
+
+// ServerError responds with statusCode, which should be a 5xx status, and a Stytch error body. The
+// request is not sent.
+func ServerError(statusCode int) Fault {
+	return Fault{name: "ServerError", inject: func(req *http.Request, _ http.RoundTripper) (*http.Response, error) {
+		return errorResponse(req, statusCode, "internal_server_error",
+			"Oops, something seems to have gone wrong."), nil
+	}}
+}
+
+// Timeout holds the request for after and then fails it with a net.Error whose Timeout method
+// returns true, as a dialer or read deadline would. If the request's context ends first, its error
+// is returned instead. The request is not sent.
+func Timeout(after time.Duration) Fault {
+	return Fault{name: "Timeout", inject: func(req *http.Request, _ http.RoundTripper) (*http.Response, error) {
+		closeBody(req)
+		timer := time.NewTimer(after)
+		defer timer.Stop()
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-timer.C:
+			return nil, &net.OpError{Op: "read", Net: "tcp", Err: timeoutError{}}
+		}
+	}}
+}
+
+// ConnectionReset fails the request with an error wrapping syscall.ECONNRESET, as if the server
+// closed the connection. The request is not sent.
+func ConnectionReset() Fault {
+	return Fault{name: "ConnectionReset", inject: func(req *http.Request, _ http.RoundTripper) (*http.Response, error) {
+		closeBody(req)
+		return nil, &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}
+	}}
+}
+
+// MalformedJSON responds with a 200 status and a truncated JSON body, so that decoding the response
+// fails. The request is not sent.
+func MalformedJSON() Fault {
+	return Fault{name: "MalformedJSON", inject: func(req *http.Request, _ http.RoundTripper) (*http.Response, error) {
+		return response(req, http.StatusOK, []byte(`{"request_id": "request-id-faultinject", "status_code": 200, "`)), nil
+	}}
+}
+
+// SlowBody sends the request and delivers the real response body in small chunks, waiting delay
+// before each one. Reads fail with the request context's error if it ends while waiting.
+func SlowBody(delay time.Duration) Fault {
+	return Fault{name: "SlowBody", inject: func(req *http.Request, base http.RoundTripper) (*http.Response, error) {
+		resp, err := base.RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+		resp.Body = &slowBody{ctx: req.Context(), body: resp.Body, delay: delay}
+		return resp, nil
+	}}
+}
+
+// slowChunkSize is the most a slowBody returns from a single Read.
+const slowChunkSize = 16
+
+type slowBody struct {
+	ctx   context.Context
+	body  io.ReadCloser
+	delay time.Duration
+}
+
+func (b *slowBody) Read(p []byte) (int, error) {
+	timer := time.NewTimer(b.delay)
+	defer timer.Stop()
+	select {
+	case <-b.ctx.Done():
+		return 0, b.ctx.Err()
+	case <-timer.C:
+	}
+	if len(p) > slowChunkSize {
+		p = p[:slowChunkSize]
+	}
+	return b.body.Read(p)
+}
+
+func (b *slowBody) Close() error {
+	return b.body.Close()
+}
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout (injected)" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func errorResponse(req *http.Request, statusCode int, errorType, message string) *http.Response {
+	body, _ := json.Marshal(stytcherror.Error{
+		StatusCode:   statusCode,
+		RequestID:    "request-id-faultinject",
+		ErrorType:    stytcherror.Type(errorType),
+		ErrorMessage: stytcherror.Message(message),
+	})
+	return response(req, statusCode, body)
+}
+
+// response builds a synthetic response to req. A RoundTripper must close the request body even
+// when it does not send the request.
+func response(req *http.Request, statusCode int, body []byte) *http.Response {
+	closeBody(req)
+	return &http.Response{
+		Status:        strconv.Itoa(statusCode) + " " + http.StatusText(statusCode),
+		StatusCode:    statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+func closeBody(req *http.Request) {
+	if req.Body != nil {
+		_ = req.Body.Close()
+	}
+}
@@ -0,0 +1,185 @@
+package freeze
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Calendar is a list of freeze windows. Create one with Parse or ReadFile.
+type Calendar struct {
+	// Timezone is the IANA name of the time zone of the windows that do not set their own, such
+	// as "America/New_York". It defaults to UTC.
+	Timezone string   `yaml:"timezone,omitempty"`
+	Windows  []Window `yaml:"windows"`
+}
+
+// Window is a freeze window: either recurring, with Cron and Duration, or one-off, with Start and
+// End.
+type Window struct {
+	// Name identifies the window in errors and audit events, such as "Holidays".
+	Name string `yaml:"name"`
+	// Cron is a five-field cron expression for the start of each occurrence of a recurring window,
+	// such as "0 16 * * FRI" for Fridays at 4pm.
+	Cron string `yaml:"cron,omitempty"`
+	// Duration is the length of each occurrence of a recurring window, as accepted by
+	// time.ParseDuration, such as "64h".
+	Duration string `yaml:"duration,omitempty"`
+	// Start and End bound a one-off window. They are dates such as "2026-12-20", local times such
+	// as "2026-12-20T17:00", or RFC 3339 times with an offset. The window includes Start and ends
+	// just before End; an End date includes that whole day.
+	Start string `yaml:"start,omitempty"`
+	End   string `yaml:"end,omitempty"`
+	// Timezone is the IANA name of the time zone of Cron, Start and End. It defaults to the
+	// timezone of the calendar.
+	Timezone string `yaml:"timezone,omitempty"`
+
+	schedule   *schedule
+	duration   time.Duration
+	start, end time.Time
+	loc        *time.Location
+}
+
+// Period is an occurrence of a freeze window.
+type Period struct {
+	Window string
+	Start  time.Time
+	End    time.Time
+}
+
+// Parse decodes a calendar from YAML or JSON and checks its windows. Unknown fields are rejected,
+// so that a typo does not silently disable a freeze.
+func Parse(data []byte) (*Calendar, error) {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	var c Calendar
+	if err := dec.Decode(&c); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("parsing freeze calendar: %w", err)
+	}
+	defaultLoc, err := time.LoadLocation(c.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("parsing freeze calendar: %w", err)
+	}
+	for i := range c.Windows {
+		if err := c.Windows[i].compile(defaultLoc); err != nil {
+			return nil, fmt.Errorf("parsing freeze calendar: window %d (%s): %w", i+1, c.Windows[i].Name, err)
+		}
+	}
+	return &c, nil
+}
+
+// ReadFile reads a calendar from a YAML or JSON file.
+func ReadFile(path string) (*Calendar, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	c, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return c, nil
+}
+
+func (w *Window) compile(defaultLoc *time.Location) error {
+	w.loc = defaultLoc
+	if w.Timezone != "" {
+		var err error
+		if w.loc, err = time.LoadLocation(w.Timezone); err != nil {
+			return err
+		}
+	}
+	switch {
+	case w.Name == "":
+		return errors.New("missing name")
+	case w.Cron != "" && (w.Start != "" || w.End != ""):
+		return errors.New("cron cannot be combined with start and end")
+	case w.Cron != "":
+		var err error
+		if w.schedule, err = parseCron(w.Cron); err != nil {
+			return err
+		}
+		if w.duration, err = time.ParseDuration(w.Duration); err != nil {
+			return fmt.Errorf("duration: %w", err)
+		}
+		if w.duration <= 0 {
+			return errors.New("duration must be positive")
+		}
+	case w.Start == "" || w.End == "":
+		return errors.New("expected cron and duration, or start and end")
+	case w.Duration != "":
+		return errors.New("duration cannot be combined with start and end")
+	default:
+		var (
+			endIsDate bool
+			err       error
+		)
+		if w.start, _, err = parseTime(w.Start, w.loc); err != nil {
+			return fmt.Errorf("start: %w", err)
+		}
+		if w.end, endIsDate, err = parseTime(w.End, w.loc); err != nil {
+			return fmt.Errorf("end: %w", err)
+		}
+		if endIsDate {
+			w.end = w.end.AddDate(0, 0, 1)
+		}
+		if !w.end.After(w.start) {
+			return errors.New("end must be after start")
+		}
+	}
+	return nil
+}
+
+// parseTime parses a date, a local time or an RFC 3339 time, and reports whether it was a date.
+func parseTime(s string, loc *time.Location) (time.Time, bool, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, false, nil
+	}
+	for _, layout := range []string{"2006-01-02T15:04:05", "2006-01-02T15:04", "2006-01-02 15:04"} {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, false, nil
+		}
+	}
+	t, err := time.ParseInLocation(time.DateOnly, s, loc)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("invalid time %q", s)
+	}
+	return t, true, nil
+}
+
+// At returns the freeze period in effect at t, if any. When several are, the one that ends last
+// is returned.
+func (c *Calendar) At(t time.Time) (Period, bool) {
+	var (
+		found Period
+		ok    bool
+	)
+	for i := range c.Windows {
+		if p, active := c.Windows[i].at(t); active && (!ok || p.End.After(found.End)) {
+			found, ok = p, true
+		}
+	}
+	return found, ok
+}
+
+func (w *Window) at(t time.Time) (Period, bool) {
+	if w.schedule == nil {
+		if t.Before(w.start) || !t.Before(w.end) {
+			return Period{}, false
+		}
+		return Period{Window: w.Name, Start: w.start.In(w.loc), End: w.end.In(w.loc)}, true
+	}
+	// Look for the latest start within the duration before t, minute by minute.
+	first := t.Add(-w.duration)
+	for start := t.Truncate(time.Minute); start.After(first); start = start.Add(-time.Minute) {
+		if w.schedule.matches(start.In(w.loc)) {
+			return Period{Window: w.Name, Start: start.In(w.loc), End: start.Add(w.duration).In(w.loc)}, true
+		}
+	}
+	return Period{}, false
+}
@@ -0,0 +1,181 @@
+package freeze_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/freeze"
+)
+
+const calendarYAML = `
+timezone: America/New_York
+windows:
+  - name: Weekend
+    cron: "0 16 * * FRI"
+    duration: 64h
+  - name: Holidays
+    start: 2026-12-21
+    end: 2027-01-01
+  - name: Launch
+    start: 2026-11-03T09:00
+    end: 2026-11-03T17:00
+    timezone: Europe/London
+`
+
+func TestCalendarAt(t *testing.T) {
+	// Arrange
+	calendar, err := freeze.Parse([]byte(calendarYAML))
+	require.NoError(t, err)
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	london, err := time.LoadLocation("Europe/London")
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		name      string
+		at        time.Time
+		want      string
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{
+			name: "weekday",
+			at:   time.Date(2026, 10, 21, 12, 0, 0, 0, newYork),
+		},
+		{
+			name:      "recurring window",
+			at:        time.Date(2026, 10, 24, 12, 0, 0, 0, newYork),
+			want:      "Weekend",
+			wantStart: time.Date(2026, 10, 23, 16, 0, 0, 0, newYork),
+			wantEnd:   time.Date(2026, 10, 26, 8, 0, 0, 0, newYork),
+		},
+		{
+			name:      "start of a recurring window",
+			at:        time.Date(2026, 10, 23, 16, 0, 0, 0, newYork),
+			want:      "Weekend",
+			wantStart: time.Date(2026, 10, 23, 16, 0, 0, 0, newYork),
+			wantEnd:   time.Date(2026, 10, 26, 8, 0, 0, 0, newYork),
+		},
+		{
+			name: "end of a recurring window",
+			at:   time.Date(2026, 10, 26, 8, 0, 0, 0, newYork),
+		},
+		{
+			name: "recurring window in another time zone",
+			at:   time.Date(2026, 10, 23, 19, 59, 0, 0, time.UTC),
+		},
+		{
+			name:      "end date includes the day",
+			at:        time.Date(2026, 12, 31, 23, 59, 0, 0, newYork),
+			want:      "Holidays",
+			wantStart: time.Date(2026, 12, 21, 0, 0, 0, 0, newYork),
+			wantEnd:   time.Date(2027, 1, 2, 0, 0, 0, 0, newYork),
+		},
+		{
+			name:      "window time zone",
+			at:        time.Date(2026, 11, 3, 9, 0, 0, 0, london),
+			want:      "Launch",
+			wantStart: time.Date(2026, 11, 3, 9, 0, 0, 0, london),
+			wantEnd:   time.Date(2026, 11, 3, 17, 0, 0, 0, london),
+		},
+		{
+			name: "before a window in its time zone",
+			at:   time.Date(2026, 11, 3, 8, 59, 0, 0, london),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			period, ok := calendar.At(tc.at)
+
+			// Assert
+			assert.Equal(t, tc.want != "", ok)
+			assert.Equal(t, tc.want, period.Window)
+			assert.True(t, tc.wantStart.Equal(period.Start), period.Start)
+			assert.True(t, tc.wantEnd.Equal(period.End), period.End)
+		})
+	}
+}
+
+func TestCalendarAtCron(t *testing.T) {
+	// Arrange
+	calendar, err := freeze.Parse([]byte(`
+windows:
+  - name: Month end
+    cron: "*/30 22-23 28-31 JAN-MAR,DEC *"
+    duration: 30m
+  - name: Quiet days
+    cron: "0 0 1 * 7"
+    duration: 24h
+`))
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		at   time.Time
+		want string
+	}{
+		{at: time.Date(2027, 1, 30, 22, 45, 0, 0, time.UTC), want: "Month end"},
+		{at: time.Date(2027, 1, 30, 21, 45, 0, 0, time.UTC)},
+		{at: time.Date(2027, 4, 30, 22, 15, 0, 0, time.UTC)},
+		// Both days are restricted, so either matches: Quiet days, and the first of the month.
+		{at: time.Date(2026, 10, 25, 12, 0, 0, 0, time.UTC), want: "Quiet days"},
+		{at: time.Date(2026, 12, 1, 12, 0, 0, 0, time.UTC), want: "Quiet days"},
+		{at: time.Date(2026, 10, 26, 12, 0, 0, 0, time.UTC)},
+	} {
+		t.Run(tc.at.Format(time.RFC3339), func(t *testing.T) {
+			// Act
+			period, _ := calendar.At(tc.at)
+
+			// Assert
+			assert.Equal(t, tc.want, period.Window)
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		yaml    string
+		wantErr string
+	}{
+		{
+			name:    "unknown field",
+			yaml:    "windows:\n  - name: Weekend\n    cron: '0 16 * * FRI'\n    duraton: 64h\n",
+			wantErr: "field duraton not found",
+		},
+		{
+			name:    "bad cron",
+			yaml:    "windows:\n  - name: Weekend\n    cron: '0 16 * * FRI'\n    duration: 64h\n  - name: Quarter end\n    cron: '0 0 L-31 3,6,9,12 *'\n    duration: 1h\n",
+			wantErr: `window 2 (Quarter end): cron expression "0 0 L-31 3,6,9,12 *": invalid value "L"`,
+		},
+		{
+			name:    "missing duration",
+			yaml:    "windows:\n  - name: Weekend\n    cron: '0 16 * * FRI'\n",
+			wantErr: "window 1 (Weekend): duration",
+		},
+		{
+			name:    "end before start",
+			yaml:    "windows:\n  - name: Holidays\n    start: 2027-01-01\n    end: 2026-12-21\n",
+			wantErr: "window 1 (Holidays): end must be after start",
+		},
+		{
+			name:    "unknown time zone",
+			yaml:    "timezone: Mars/Olympus_Mons\n",
+			wantErr: "unknown time zone Mars/Olympus_Mons",
+		},
+		{
+			name:    "neither kind",
+			yaml:    "windows:\n  - name: Someday\n",
+			wantErr: "window 1 (Someday): expected cron and duration, or start and end",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			_, err := freeze.Parse([]byte(tc.yaml))
+
+			// Assert
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
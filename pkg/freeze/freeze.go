@@ -0,0 +1,172 @@
+// Package freeze provides an http.RoundTripper that stops an api.API from changing a workspace
+// during change freezes, such as holidays or the weekend, unless the call carries an emergency
+// override with a justification.
+//
+// Freeze windows are read from a calendar file:
+//
+//	timezone: America/New_York
+//	windows:
+//	  - name: Weekend
+//	    cron: "0 16 * * FRI"
+//	    duration: 64h
+//	  - name: Holidays
+//	    start: 2026-12-21
+//	    end: 2027-01-01
+//
+// and enforced by a Transport:
+//
+//	calendar, err := freeze.ReadFile("freeze.yaml")
+//	transport := &freeze.Transport{Calendar: calendar, Audit: &freeze.JSONAuditSink{W: auditLog}}
+//	client := api.NewClient(keyID, keySecret, api.WithHTTPClient(&http.Client{Transport: transport}))
+//
+//	_, err = client.Secrets.Delete(ctx, req) // a *freeze.FrozenError during a freeze
+//	_, err = client.Secrets.Delete(freeze.Override(ctx, "INC-123: revoking a leaked secret"), req)
+//
+// Only requests that change the workspace are frozen. Every override is recorded to the audit sink
+// before the request is sent; if it cannot be recorded, the request is not sent.
+package freeze
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
+)
+
+// FrozenError is the error returned, wrapped by the client, for a request refused during a freeze.
+type FrozenError struct {
+	// Operation is the name of the operation, such as "Secrets.Delete", or the method and path of
+	// the request if it is not a known operation.
+	Operation string
+	Period    Period
+}
+
+func (e *FrozenError) Error() string {
+	return fmt.Sprintf("freeze: %s refused during change freeze %q until %s; use freeze.Override with a justification for emergencies",
+		e.Operation, e.Period.Window, e.Period.End.Format(time.RFC3339))
+}
+
+type overrideKey struct{}
+
+// Override returns a context that lets requests sent with it through during a freeze. The
+// justification is recorded to the audit sink and must not be empty.
+func Override(ctx context.Context, justification string) context.Context {
+	return context.WithValue(ctx, overrideKey{}, justification)
+}
+
+// Justification returns the justification of the override carried by ctx, or "" if it carries
+// none.
+func Justification(ctx context.Context) string {
+	justification, _ := ctx.Value(overrideKey{}).(string)
+	return strings.TrimSpace(justification)
+}
+
+// Transport is an http.RoundTripper that refuses requests that change the workspace during a
+// freeze, and sends every other request with Base.
+type Transport struct {
+	// Base sends the requests that are not refused. If nil, http.DefaultTransport is used.
+	Base http.RoundTripper
+	// Calendar holds the freeze windows.
+	Calendar *Calendar
+	// Audit records overrides. Overrides are refused if it is nil.
+	Audit AuditSink
+	// BasePath is the path of the base URI passed to api.WithBaseURI, if it has one. It is removed
+	// from request paths before they are matched against operations.
+	BasePath string
+	// Now returns the current time. It defaults to time.Now.
+	Now func() time.Time
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method == http.MethodGet || t.Calendar == nil {
+		return t.base().RoundTrip(req)
+	}
+	now := time.Now()
+	if t.Now != nil {
+		now = t.Now()
+	}
+	period, frozen := t.Calendar.At(now)
+	if !frozen {
+		return t.base().RoundTrip(req)
+	}
+
+	event := AuditEvent{
+		Time:      now.UTC(),
+		Operation: req.Method + " " + req.URL.Path,
+		Window:    period.Window,
+	}
+	reqPath := strings.TrimPrefix(req.URL.Path, strings.TrimSuffix(t.BasePath, "/"))
+	if op, params, ok := api.MatchOperation(req.Method, reqPath); ok {
+		event.Operation = op.Name
+		event.ProjectSlug, event.EnvironmentSlug = params["ProjectSlug"], params["EnvironmentSlug"]
+	}
+	ctx := req.Context()
+	if event.Justification = Justification(ctx); event.Justification == "" {
+		return nil, &FrozenError{Operation: event.Operation, Period: period}
+	}
+	if t.Audit == nil {
+		return nil, errors.New("freeze: overrides need an audit sink")
+	}
+	if err := t.Audit.Record(ctx, event); err != nil {
+		return nil, fmt.Errorf("freeze: recording the override: %w", err)
+	}
+	return t.base().RoundTrip(req)
+}
+
+func (t *Transport) base() http.RoundTripper {
+	if t.Base != nil {
+		return t.Base
+	}
+	return http.DefaultTransport
+}
+
+// AuditEvent records a request sent during a freeze with an override.
+type AuditEvent struct {
+	Time time.Time `json:"time"`
+	// Operation is the name of the operation, such as "Secrets.Delete", or the method and path of
+	// the request if it is not a known operation.
+	Operation       string `json:"operation"`
+	ProjectSlug     string `json:"project_slug,omitempty"`
+	EnvironmentSlug string `json:"environment_slug,omitempty"`
+	// Window is the name of the freeze window in effect.
+	Window        string `json:"window"`
+	Justification string `json:"justification"`
+}
+
+// AuditSink records overrides.
+type AuditSink interface {
+	Record(ctx context.Context, event AuditEvent) error
+}
+
+// AuditFunc adapts a function to an AuditSink.
+type AuditFunc func(ctx context.Context, event AuditEvent) error
+
+// Record implements AuditSink.
+func (f AuditFunc) Record(ctx context.Context, event AuditEvent) error { return f(ctx, event) }
+
+// JSONAuditSink is an AuditSink that writes each event to W as a line of JSON.
+type JSONAuditSink struct {
+	W io.Writer
+
+	mu sync.Mutex
+}
+
+// Record implements AuditSink.
+func (s *JSONAuditSink) Record(_ context.Context, event AuditEvent) error {
+	b, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.W.Write(append(b, '\n'))
+	return err
+}
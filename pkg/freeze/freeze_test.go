@@ -0,0 +1,152 @@
+package freeze_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/freeze"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/secrets"
+)
+
+// during is a time in the Holidays window of calendarYAML; outside is a time in no window.
+var (
+	during  = time.Date(2026, 12, 24, 17, 0, 0, 0, time.UTC)
+	outside = time.Date(2026, 10, 21, 17, 0, 0, 0, time.UTC)
+)
+
+var deleteSecret = secrets.DeleteRequest{ProjectSlug: "my-project", EnvironmentSlug: "production", SecretID: "secret-1"}
+
+// newClient returns a client that sends requests through transport, configured with the
+// calendar of calendarYAML and a clock set to now, to a server that records the requests it
+// receives.
+func newClient(t *testing.T, transport *freeze.Transport, now time.Time) (*api.API, *[]string) {
+	t.Helper()
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+	calendar, err := freeze.Parse([]byte(calendarYAML))
+	require.NoError(t, err)
+	transport.Base = server.Client().Transport
+	transport.Calendar = calendar
+	transport.Now = func() time.Time { return now }
+	client := api.NewClient("key-id", "key-secret",
+		api.WithBaseURI(server.URL),
+		api.WithHTTPClient(&http.Client{Transport: transport}))
+	return client, &requests
+}
+
+func TestTransport(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("refuses changes during a freeze", func(t *testing.T) {
+		// Arrange
+		client, requests := newClient(t, &freeze.Transport{}, during)
+
+		// Act
+		_, err := client.Secrets.Delete(ctx, deleteSecret)
+
+		// Assert
+		var frozen *freeze.FrozenError
+		require.ErrorAs(t, err, &frozen)
+		assert.Equal(t, "Secrets.Delete", frozen.Operation)
+		assert.Equal(t, "Holidays", frozen.Period.Window)
+		assert.ErrorContains(t, err, `freeze: Secrets.Delete refused during change freeze "Holidays" until 2027-01-02T00:00:00-05:00`)
+		assert.Empty(t, *requests)
+	})
+
+	t.Run("sends reads during a freeze", func(t *testing.T) {
+		// Arrange
+		client, requests := newClient(t, &freeze.Transport{}, during)
+
+		// Act
+		_, err := client.Secrets.GetAll(ctx, secrets.GetAllRequest{ProjectSlug: "my-project", EnvironmentSlug: "production"})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Len(t, *requests, 1)
+	})
+
+	t.Run("sends changes outside a freeze", func(t *testing.T) {
+		// Arrange
+		client, requests := newClient(t, &freeze.Transport{}, outside)
+
+		// Act
+		_, err := client.Secrets.Delete(ctx, deleteSecret)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []string{"DELETE /pwa/v3/projects/my-project/environments/production/secrets/secret-1"}, *requests)
+	})
+
+	t.Run("records overrides", func(t *testing.T) {
+		// Arrange
+		var audit bytes.Buffer
+		client, requests := newClient(t, &freeze.Transport{Audit: &freeze.JSONAuditSink{W: &audit}}, during)
+
+		// Act
+		_, err := client.Secrets.Delete(freeze.Override(ctx, "INC-123: revoking a leaked secret"), deleteSecret)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Len(t, *requests, 1)
+		assert.JSONEq(t, `{
+			"time": "2026-12-24T17:00:00Z",
+			"operation": "Secrets.Delete",
+			"project_slug": "my-project",
+			"environment_slug": "production",
+			"window": "Holidays",
+			"justification": "INC-123: revoking a leaked secret"
+		}`, audit.String())
+	})
+
+	t.Run("overrides need a justification", func(t *testing.T) {
+		// Arrange
+		client, requests := newClient(t, &freeze.Transport{Audit: freeze.AuditFunc(func(context.Context, freeze.AuditEvent) error {
+			return nil
+		})}, during)
+
+		// Act
+		_, err := client.Secrets.Delete(freeze.Override(ctx, " "), deleteSecret)
+
+		// Assert
+		assert.ErrorAs(t, err, new(*freeze.FrozenError))
+		assert.Empty(t, *requests)
+	})
+
+	t.Run("overrides need an audit sink", func(t *testing.T) {
+		// Arrange
+		client, requests := newClient(t, &freeze.Transport{}, during)
+
+		// Act
+		_, err := client.Secrets.Delete(freeze.Override(ctx, "INC-123"), deleteSecret)
+
+		// Assert
+		assert.ErrorContains(t, err, "freeze: overrides need an audit sink")
+		assert.Empty(t, *requests)
+	})
+
+	t.Run("overrides are not sent if they cannot be recorded", func(t *testing.T) {
+		// Arrange
+		client, requests := newClient(t, &freeze.Transport{Audit: freeze.AuditFunc(func(context.Context, freeze.AuditEvent) error {
+			return errors.New("disk full")
+		})}, during)
+
+		// Act
+		_, err := client.Secrets.Delete(freeze.Override(ctx, "INC-123"), deleteSecret)
+
+		// Assert
+		assert.ErrorContains(t, err, "freeze: recording the override: disk full")
+		assert.Empty(t, *requests)
+	})
+}
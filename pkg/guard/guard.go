@@ -0,0 +1,217 @@
+// Package guard provides an http.RoundTripper that stops an api.API from running destructive or
+// high-risk operations against LIVE environments, unless the call explicitly overrides the guard
+// with a reason.
+//
+//	transport := &guard.Transport{}
+//	client := api.NewClient(keyID, keySecret, api.WithHTTPClient(&http.Client{Transport: transport}))
+//
+//	_, err := client.Secrets.Delete(ctx, req) // blocked if the environment is LIVE
+//	var blocked *guard.BlockedError
+//	if errors.As(err, &blocked) {
+//		...
+//	}
+//	_, err = client.Secrets.Delete(guard.Override(ctx, "rotating leaked secret, INC-123"), req)
+//
+// The type of an environment is looked up with Environments.Get, using the credentials of the
+// guarded request, the first time an operation on it is guarded, and cached. Projects.Delete is
+// always guarded, since every project has a LIVE environment. Every decision on a guarded
+// operation is logged.
+package guard
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/internal/envtype"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/environments"
+)
+
+// DefaultOperations are the operations guarded when Transport.Operations is empty: deletions that
+// cannot be undone, and changes to the SDK configuration and RBAC policy, which can lock every user
+// out of an application.
+var DefaultOperations = []string{
+	"Environments.Delete",
+	"Projects.Delete",
+	"PublicTokens.Delete",
+	"RBACPolicy.Set",
+	"RedirectURLs.Delete",
+	"SDK.SetB2BConfig",
+	"SDK.SetConsumerConfig",
+	"Secrets.Delete",
+}
+
+// Decisions logged by a Transport.
+const (
+	// DecisionAllowed is logged for guarded operations on environments that are not LIVE.
+	DecisionAllowed = "allowed"
+	// DecisionOverridden is logged for guarded operations on LIVE environments that were sent
+	// because the call carried an override.
+	DecisionOverridden = "overridden"
+	// DecisionBlocked is logged for guarded operations on LIVE environments that were not sent.
+	DecisionBlocked = "blocked"
+)
+
+// Transport is an http.RoundTripper that blocks guarded operations on LIVE environments and sends
+// every other request with Base. A Transport must not be copied after first use.
+type Transport struct {
+	// Base sends the requests that are not blocked, and the requests that look up environment
+	// types. If nil, http.DefaultTransport is used.
+	Base http.RoundTripper
+	// Operations are the names of the guarded operations, such as "Secrets.Delete". They may be
+	// patterns as accepted by path.Match, such as "Secrets.*". If empty, DefaultOperations are
+	// guarded.
+	Operations []string
+	// BasePath is the path of the base URI passed to api.WithBaseURI, if it has one. It is removed
+	// from request paths before they are matched against operations.
+	BasePath string
+	// Logger receives the decisions. If nil, slog.Default() is used.
+	Logger *slog.Logger
+
+	// types caches the types of the environments the requests are on.
+	types envtype.Cache
+}
+
+// BlockedError is the error returned, wrapped by the client, for a blocked request.
+type BlockedError struct {
+	Operation       string
+	ProjectSlug     string
+	EnvironmentSlug string
+}
+
+func (e *BlockedError) Error() string {
+	target := "project " + e.ProjectSlug
+	if e.EnvironmentSlug != "" {
+		target = fmt.Sprintf("LIVE environment %s of project %s", e.EnvironmentSlug, e.ProjectSlug)
+	}
+	return fmt.Sprintf("guard: %s on %s blocked; use guard.Override with a reason to proceed", e.Operation, target)
+}
+
+type overrideKey struct{}
+
+// Override returns a context that lets guarded operations sent with it run against LIVE
+// environments. The reason is logged with the decision and must not be empty.
+func Override(ctx context.Context, reason string) context.Context {
+	return context.WithValue(ctx, overrideKey{}, reason)
+}
+
+// OverrideReason returns the reason of the override carried by ctx, or "" if it carries none.
+func OverrideReason(ctx context.Context) string {
+	reason, _ := ctx.Value(overrideKey{}).(string)
+	return strings.TrimSpace(reason)
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqPath := strings.TrimPrefix(req.URL.Path, strings.TrimSuffix(t.BasePath, "/"))
+	op, params, ok := api.MatchOperation(req.Method, reqPath)
+	if !ok || !t.guarded(op.Name) {
+		return t.base().RoundTrip(req)
+	}
+
+	ctx := req.Context()
+	projectSlug, envSlug := params["ProjectSlug"], params["EnvironmentSlug"]
+	attrs := []any{
+		slog.String("operation", op.Name),
+		slog.String("project_slug", projectSlug),
+	}
+	envType := environments.EnvironmentTypeLive
+	if envSlug != "" {
+		attrs = append(attrs, slog.String("environment_slug", envSlug))
+		var err error
+		if envType, err = t.environmentType(req, projectSlug, envSlug); err != nil {
+			// Fail closed: an operation that cannot be shown to be safe is not sent.
+			t.logger().ErrorContext(ctx, "guard: cannot resolve environment type",
+				append(attrs, slog.String("decision", DecisionBlocked), slog.Any("error", err))...)
+			return nil, fmt.Errorf("guard: resolving the type of environment %s: %w", envSlug, err)
+		}
+	}
+	attrs = append(attrs, slog.String("environment_type", string(envType)))
+
+	switch reason := OverrideReason(ctx); {
+	case envType != environments.EnvironmentTypeLive:
+		t.logger().InfoContext(ctx, "guard: operation allowed", append(attrs, slog.String("decision", DecisionAllowed))...)
+	case reason != "":
+		t.logger().WarnContext(ctx, "guard: operation on LIVE overridden",
+			append(attrs, slog.String("decision", DecisionOverridden), slog.String("reason", reason))...)
+	default:
+		t.logger().WarnContext(ctx, "guard: operation on LIVE blocked", append(attrs, slog.String("decision", DecisionBlocked))...)
+		return nil, &BlockedError{Operation: op.Name, ProjectSlug: projectSlug, EnvironmentSlug: envSlug}
+	}
+
+	resp, err := t.base().RoundTrip(req)
+	if err == nil && resp.StatusCode < 300 {
+		t.types.Done(op.Name, projectSlug, envSlug)
+	}
+	return resp, err
+}
+
+func (t *Transport) guarded(operation string) bool {
+	patterns := t.Operations
+	if len(patterns) == 0 {
+		patterns = DefaultOperations
+	}
+	for _, pattern := range patterns {
+		if ok, err := path.Match(pattern, operation); ok && err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// environmentType returns the type of an environment, looking it up with the credentials of req
+// unless it is cached.
+func (t *Transport) environmentType(req *http.Request, projectSlug, envSlug string) (environments.EnvironmentType, error) {
+	return t.types.Get(req.Context(), projectSlug, envSlug, func(
+		ctx context.Context, projectSlug, envSlug string,
+	) (environments.EnvironmentType, error) {
+		return t.lookUpEnvironmentType(req.WithContext(ctx), projectSlug, envSlug)
+	})
+}
+
+// lookUpEnvironmentType looks up the type of an environment with the credentials of req.
+func (t *Transport) lookUpEnvironmentType(req *http.Request, projectSlug, envSlug string) (environments.EnvironmentType, error) {
+	lookup := req.Clone(req.Context())
+	lookup.Method = http.MethodGet
+	lookup.URL.Path = strings.TrimSuffix(t.BasePath, "/") + "/pwa/v3/projects/" + projectSlug + "/environments/" + envSlug
+	lookup.URL.RawPath = ""
+	lookup.URL.RawQuery = ""
+	lookup.Body, lookup.GetBody, lookup.ContentLength = http.NoBody, nil, 0
+	resp, err := t.base().RoundTrip(lookup)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return "", fmt.Errorf("looking up the environment: %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	var env environments.GetResponse
+	if err := json.NewDecoder(resp.Body).Decode(&env); err != nil {
+		return "", err
+	}
+	if env.Environment.Type == "" {
+		return "", fmt.Errorf("looking up the environment: no type in the response")
+	}
+	return env.Environment.Type, nil
+}
+
+func (t *Transport) logger() *slog.Logger {
+	if t.Logger != nil {
+		return t.Logger
+	}
+	return slog.Default()
+}
+
+func (t *Transport) base() http.RoundTripper {
+	if t.Base != nil {
+		return t.Base
+	}
+	return http.DefaultTransport
+}
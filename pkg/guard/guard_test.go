@@ -0,0 +1,230 @@
+package guard_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/guard"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/rbacpolicy"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/secrets"
+)
+
+// server is a Management API stand-in with a LIVE environment "production" and a TEST environment
+// "test". It records the requests it receives.
+type server struct {
+	mu       sync.Mutex
+	requests []string
+}
+
+func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+	s.mu.Unlock()
+	switch {
+	case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/environments/production"):
+		_, _ = w.Write([]byte(`{"environment": {"environment_slug": "production", "type": "LIVE"}}`))
+	case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/environments/test"):
+		_, _ = w.Write([]byte(`{"environment": {"environment_slug": "test", "type": "TEST"}}`))
+	case r.Method == http.MethodGet && strings.Contains(r.URL.Path, "/environments/"):
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error_type": "environment_not_found"}`))
+	default:
+		_, _ = w.Write([]byte(`{}`))
+	}
+}
+
+func (s *server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.requests...)
+}
+
+// newClient returns a client for a new server, sending requests through transport, and the buffer
+// its decisions are logged to as JSON lines.
+func newClient(t *testing.T, transport *guard.Transport) (*api.API, *server, *bytes.Buffer) {
+	t.Helper()
+	srv := &server{}
+	httpServer := httptest.NewServer(srv)
+	t.Cleanup(httpServer.Close)
+	var logs bytes.Buffer
+	transport.Base = httpServer.Client().Transport
+	transport.Logger = slog.New(slog.NewJSONHandler(&logs, nil))
+	client := api.NewClient("key-id", "key-secret",
+		api.WithBaseURI(httpServer.URL),
+		api.WithHTTPClient(&http.Client{Transport: transport}))
+	return client, srv, &logs
+}
+
+func decisions(t *testing.T, logs *bytes.Buffer) []map[string]any {
+	t.Helper()
+	var out []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(logs.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		delete(entry, "time")
+		out = append(out, entry)
+	}
+	return out
+}
+
+func deleteSecret(env string) secrets.DeleteRequest {
+	return secrets.DeleteRequest{ProjectSlug: "my-project", EnvironmentSlug: env, SecretID: "secret-1"}
+}
+
+func TestTransport(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("allows guarded operations on other environments", func(t *testing.T) {
+		// Arrange
+		client, srv, logs := newClient(t, &guard.Transport{})
+
+		// Act
+		_, err := client.Secrets.Delete(ctx, deleteSecret("test"))
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"GET /pwa/v3/projects/my-project/environments/test",
+			"DELETE /pwa/v3/projects/my-project/environments/test/secrets/secret-1",
+		}, srv.Requests())
+		assert.Equal(t, []map[string]any{{
+			"level":            "INFO",
+			"msg":              "guard: operation allowed",
+			"operation":        "Secrets.Delete",
+			"project_slug":     "my-project",
+			"environment_slug": "test",
+			"environment_type": "TEST",
+			"decision":         guard.DecisionAllowed,
+		}}, decisions(t, logs))
+	})
+
+	t.Run("blocks guarded operations on LIVE", func(t *testing.T) {
+		// Arrange
+		client, srv, logs := newClient(t, &guard.Transport{})
+
+		// Act
+		_, err := client.RBACPolicy.Set(ctx, rbacpolicy.SetRequest{ProjectSlug: "my-project", EnvironmentSlug: "production"})
+
+		// Assert
+		var blocked *guard.BlockedError
+		require.ErrorAs(t, err, &blocked)
+		assert.Equal(t, guard.BlockedError{
+			Operation:       "RBACPolicy.Set",
+			ProjectSlug:     "my-project",
+			EnvironmentSlug: "production",
+		}, *blocked)
+		assert.Equal(t, []string{"GET /pwa/v3/projects/my-project/environments/production"}, srv.Requests())
+		require.Len(t, decisions(t, logs), 1)
+		assert.Equal(t, "WARN", decisions(t, logs)[0]["level"])
+		assert.Equal(t, guard.DecisionBlocked, decisions(t, logs)[0]["decision"])
+	})
+
+	t.Run("sends overridden operations and logs the reason", func(t *testing.T) {
+		// Arrange
+		client, srv, logs := newClient(t, &guard.Transport{})
+
+		// Act
+		_, err := client.Secrets.Delete(guard.Override(ctx, "INC-123: leaked secret"), deleteSecret("production"))
+
+		// Assert
+		require.NoError(t, err)
+		assert.Contains(t, srv.Requests(), "DELETE /pwa/v3/projects/my-project/environments/production/secrets/secret-1")
+		entries := decisions(t, logs)
+		require.Len(t, entries, 1)
+		assert.Equal(t, guard.DecisionOverridden, entries[0]["decision"])
+		assert.Equal(t, "INC-123: leaked secret", entries[0]["reason"])
+	})
+
+	t.Run("a blank reason is not an override", func(t *testing.T) {
+		// Arrange
+		client, _, _ := newClient(t, &guard.Transport{})
+
+		// Act
+		_, err := client.Secrets.Delete(guard.Override(ctx, "  "), deleteSecret("production"))
+
+		// Assert
+		var blocked *guard.BlockedError
+		assert.ErrorAs(t, err, &blocked)
+	})
+
+	t.Run("caches environment types", func(t *testing.T) {
+		// Arrange
+		client, srv, _ := newClient(t, &guard.Transport{})
+
+		// Act
+		_, err1 := client.Secrets.Delete(ctx, deleteSecret("test"))
+		_, err2 := client.Secrets.Delete(ctx, deleteSecret("test"))
+
+		// Assert
+		require.NoError(t, err1)
+		require.NoError(t, err2)
+		assert.Len(t, srv.Requests(), 3)
+	})
+
+	t.Run("passes other operations through", func(t *testing.T) {
+		// Arrange
+		client, srv, logs := newClient(t, &guard.Transport{})
+
+		// Act
+		_, err := client.Secrets.Create(ctx, secrets.CreateRequest{ProjectSlug: "my-project", EnvironmentSlug: "production"})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []string{"POST /pwa/v3/projects/my-project/environments/production/secrets"}, srv.Requests())
+		assert.Empty(t, logs.String())
+	})
+
+	t.Run("always guards project deletion", func(t *testing.T) {
+		// Arrange
+		client, srv, _ := newClient(t, &guard.Transport{})
+
+		// Act
+		_, err := client.Projects.Delete(ctx, projects.DeleteRequest{ProjectSlug: "my-project"})
+
+		// Assert
+		var blocked *guard.BlockedError
+		require.ErrorAs(t, err, &blocked)
+		assert.Contains(t, err.Error(), "Projects.Delete on project my-project blocked")
+		assert.Empty(t, srv.Requests())
+	})
+
+	t.Run("fails closed when the type cannot be resolved", func(t *testing.T) {
+		// Arrange
+		client, srv, logs := newClient(t, &guard.Transport{})
+
+		// Act
+		_, err := client.Secrets.Delete(ctx, deleteSecret("unknown"))
+
+		// Assert
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "404")
+		assert.Equal(t, []string{"GET /pwa/v3/projects/my-project/environments/unknown"}, srv.Requests())
+		assert.Equal(t, "ERROR", decisions(t, logs)[0]["level"])
+	})
+
+	t.Run("custom operations", func(t *testing.T) {
+		// Arrange
+		client, _, _ := newClient(t, &guard.Transport{Operations: []string{"Secrets.*"}})
+
+		// Act
+		_, err := client.Secrets.Create(ctx, secrets.CreateRequest{ProjectSlug: "my-project", EnvironmentSlug: "production"})
+
+		// Assert
+		assert.True(t, errors.As(err, new(*guard.BlockedError)))
+	})
+}
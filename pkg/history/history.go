@@ -0,0 +1,263 @@
+// Package history keeps a local history of environment snapshots and restores an environment to
+// any of them.
+//
+// A Store is a directory with one subdirectory per project and environment. Save takes a snapshot
+// of an environment and writes it as a file named after the time it was taken and a digest of its
+// content, such as my-project/test/20261019T120000Z-3f2a9c1b7d4e.json. Saving an environment that
+// has not changed since its latest entry writes nothing, so Save can run on every deploy:
+//
+//	store := &history.Store{Client: client, Dir: ".stytch/history"}
+//	entry, err := store.Save(ctx, "my-project", "test")
+//
+// Restore reverts the environment to a saved entry through pkg/reconcile:
+//
+//	report, err := store.Restore(ctx, "20261019T120000Z-3f2a9c1b7d4e")
+//	for _, w := range report.Plan.Warnings {
+//		fmt.Println(w)
+//	}
+//
+// Secret values are never stored. Entries record the IDs of the environment's secrets and its
+// public tokens, so that Restore can warn about those that were deleted since, which cannot be
+// recreated with the same value.
+package history
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/publictokens"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/secrets"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/snapshot"
+)
+
+// timeFormat is the layout of the timestamp in entry IDs. It sorts lexically in time order.
+const timeFormat = "20060102T150405Z"
+
+// Entry is a snapshot of a single environment in the history.
+type Entry struct {
+	// ID identifies the entry in the store, such as "20261019T120000Z-3f2a9c1b7d4e": the time it was
+	// taken, in UTC, and the first 12 characters of its digest.
+	ID              string    `json:"id"`
+	ProjectSlug     string    `json:"project_slug"`
+	EnvironmentSlug string    `json:"environment_slug"`
+	TakenAt         time.Time `json:"taken_at"`
+	// Digest is the hex-encoded SHA-256 hash of the snapshot, secret IDs and public tokens.
+	Digest string `json:"digest"`
+	// SecretIDs and PublicTokens are the environment's credentials when the entry was taken, sorted.
+	SecretIDs    []string `json:"secret_ids,omitempty"`
+	PublicTokens []string `json:"public_tokens,omitempty"`
+	// Snapshot holds the project's slug and vertical and the one environment. Project-level
+	// configuration such as email templates is not part of an environment's history.
+	Snapshot *snapshot.Snapshot `json:"snapshot"`
+}
+
+// content is the part of an entry that its digest covers.
+type content struct {
+	SecretIDs    []string        `json:"secret_ids"`
+	PublicTokens []string        `json:"public_tokens"`
+	Snapshot     json.RawMessage `json:"snapshot"`
+}
+
+// Store is a directory of environment snapshots.
+type Store struct {
+	// Client reads the environments to save and applies restores.
+	Client api.Interface
+	// Dir is the root directory of the store. It is created by the first Save.
+	Dir string
+	// Now returns the current time. It defaults to time.Now.
+	Now func() time.Time
+}
+
+func (s *Store) now() time.Time {
+	if s.Now != nil {
+		return s.Now().UTC()
+	}
+	return time.Now().UTC()
+}
+
+// Save takes a snapshot of an environment and adds it to the history. If the environment has not
+// changed since its latest entry, nothing is written and that entry is returned.
+func (s *Store) Save(ctx context.Context, projectSlug, envSlug string) (*Entry, error) {
+	if envSlug == "" {
+		return nil, fmt.Errorf("EnvironmentSlug cannot be empty")
+	}
+	snap, err := snapshot.Take(ctx, s.Client, projectSlug, snapshot.WithEnvironments(envSlug))
+	if err != nil {
+		return nil, err
+	}
+	snap.Project = projects.Project{ProjectSlug: snap.Project.ProjectSlug, Vertical: snap.Project.Vertical}
+	snap.EmailTemplates, snap.DefaultEmailTemplates = nil, nil
+
+	secretIDs, publicTokens, err := credentials(ctx, s.Client, projectSlug, envSlug)
+	if err != nil {
+		return nil, err
+	}
+	entry := &Entry{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+		TakenAt:         s.now().Truncate(time.Second),
+		SecretIDs:       secretIDs,
+		PublicTokens:    publicTokens,
+		Snapshot:        snap,
+	}
+	if entry.Digest, err = entry.digest(); err != nil {
+		return nil, err
+	}
+	entry.ID = entry.TakenAt.Format(timeFormat) + "-" + entry.Digest[:12]
+
+	entries, err := s.List(projectSlug, envSlug)
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) > 0 && entries[0].Digest == entry.Digest {
+		return &entries[0], nil
+	}
+	if err := s.write(entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// credentials returns the sorted IDs of an environment's secrets and its public tokens.
+func credentials(ctx context.Context, client api.Interface, projectSlug, envSlug string) ([]string, []string, error) {
+	secretsResp, err := client.SecretsAPI().GetAll(ctx, secrets.GetAllRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("getting secrets: %w", err)
+	}
+	var secretIDs []string
+	for _, secret := range secretsResp.Secrets {
+		secretIDs = append(secretIDs, secret.SecretID)
+	}
+	tokensResp, err := client.PublicTokensAPI().GetAll(ctx, publictokens.GetAllRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("getting public tokens: %w", err)
+	}
+	var publicTokens []string
+	for _, token := range tokensResp.PublicTokens {
+		publicTokens = append(publicTokens, token.PublicToken)
+	}
+	slices.Sort(secretIDs)
+	slices.Sort(publicTokens)
+	return secretIDs, publicTokens, nil
+}
+
+func (e *Entry) digest() (string, error) {
+	snap, err := e.Snapshot.JSON()
+	if err != nil {
+		return "", err
+	}
+	b, err := json.Marshal(content{SecretIDs: e.SecretIDs, PublicTokens: e.PublicTokens, Snapshot: snap})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func (s *Store) path(projectSlug, envSlug, id string) string {
+	return filepath.Join(s.Dir, projectSlug, envSlug, id+".json")
+}
+
+func (s *Store) write(e *Entry) error {
+	path := s.path(e.ProjectSlug, e.EnvironmentSlug, e.ID)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	snap, err := e.Snapshot.JSON()
+	if err != nil {
+		return err
+	}
+	b, err := json.MarshalIndent(struct {
+		*Entry
+		Snapshot json.RawMessage `json:"snapshot"`
+	}{e, snap}, "", "  ")
+	if err != nil {
+		return err
+	}
+	// Write to a temporary file first, so that an interrupted Save does not leave a truncated entry.
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, append(b, '\n'), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// List returns the entries of an environment, newest first.
+func (s *Store) List(projectSlug, envSlug string) ([]Entry, error) {
+	matches, err := filepath.Glob(s.path(projectSlug, envSlug, "*"))
+	if err != nil {
+		return nil, err
+	}
+	slices.Sort(matches)
+	slices.Reverse(matches)
+	entries := make([]Entry, 0, len(matches))
+	for _, path := range matches {
+		e, err := readEntry(path)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, *e)
+	}
+	return entries, nil
+}
+
+// Get returns the entry with the given ID, in any project and environment of the store.
+func (s *Store) Get(id string) (*Entry, error) {
+	if id == "" || strings.ContainsAny(id, `/\*?[`) {
+		return nil, fmt.Errorf("invalid snapshot ID %q", id)
+	}
+	matches, err := filepath.Glob(s.path("*", "*", id))
+	if err != nil {
+		return nil, err
+	}
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("snapshot %s not found in %s: %w", id, s.Dir, fs.ErrNotExist)
+	case 1:
+		return readEntry(matches[0])
+	}
+	return nil, fmt.Errorf("snapshot ID %s is ambiguous: %s", id, strings.Join(matches, ", "))
+}
+
+func readEntry(path string) (*Entry, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var raw struct {
+		Entry
+		Snapshot json.RawMessage `json:"snapshot"`
+	}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	e := raw.Entry
+	if e.Snapshot, err = snapshot.Parse(raw.Snapshot); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	digest, err := e.digest()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if digest != e.Digest {
+		return nil, fmt.Errorf("%s: content does not match its digest", path)
+	}
+	return &e, nil
+}
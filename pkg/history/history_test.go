@@ -0,0 +1,201 @@
+package history_test
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/apifake"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/history"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/environments"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/rbacpolicy"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/secrets"
+)
+
+// newFake returns a fake B2B project, "my-project", with a test environment that has one secret
+// and an RBAC policy with the given custom role.
+func newFake(roleID string) *apifake.API {
+	fake := apifake.New()
+	fake.Projects.GetReturns(&projects.GetResponse{Project: projects.Project{
+		ProjectSlug: "my-project",
+		Name:        "My project",
+		Vertical:    projects.VerticalB2B,
+	}}, nil)
+	fake.Environments.GetAllReturns(&environments.GetAllResponse{Environments: []environments.Environment{
+		{EnvironmentSlug: "test", Name: "Test", Type: environments.EnvironmentTypeTest},
+	}}, nil)
+	fake.Secrets.GetAllReturns(&secrets.GetAllResponse{Secrets: []secrets.MaskedSecret{{SecretID: "secret-1"}}}, nil)
+	setRole(fake, roleID)
+	return fake
+}
+
+func setRole(fake *apifake.API, roleID string) {
+	fake.RBACPolicy.GetReturns(&rbacpolicy.GetResponse{Policy: rbacpolicy.Policy{
+		CustomRoles: []rbacpolicy.Role{{RoleID: roleID}},
+	}}, nil)
+}
+
+// newStore returns a store in a temporary directory whose clock advances a minute on every call.
+func newStore(t *testing.T, fake *apifake.API) *history.Store {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	return &history.Store{
+		Client: fake,
+		Dir:    t.TempDir(),
+		Now: func() time.Time {
+			now = now.Add(time.Minute)
+			return now
+		},
+	}
+}
+
+func TestSave(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("content addressed", func(t *testing.T) {
+		// Arrange
+		fake := newFake("editor")
+		store := newStore(t, fake)
+
+		// Act
+		first, err := store.Save(ctx, "my-project", "test")
+		require.NoError(t, err)
+		unchanged, err := store.Save(ctx, "my-project", "test")
+		require.NoError(t, err)
+		setRole(fake, "viewer")
+		changed, err := store.Save(ctx, "my-project", "test")
+		require.NoError(t, err)
+
+		// Assert
+		assert.Regexp(t, `^20261019T120100Z-[0-9a-f]{12}$`, first.ID)
+		assert.Equal(t, first.ID, unchanged.ID)
+		assert.NotEqual(t, first.Digest, changed.Digest)
+		assert.Equal(t, []string{"secret-1"}, first.SecretIDs)
+		assert.Empty(t, first.Snapshot.Project.Name)
+		assert.FileExists(t, filepath.Join(store.Dir, "my-project", "test", first.ID+".json"))
+
+		entries, err := store.List("my-project", "test")
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		assert.Equal(t, changed.ID, entries[0].ID)
+		assert.Equal(t, first.ID, entries[1].ID)
+		assert.Equal(t, "editor", entries[1].Snapshot.Environments[0].RBACPolicy.CustomRoles[0].RoleID)
+	})
+
+	t.Run("rejects modified entries", func(t *testing.T) {
+		// Arrange
+		store := newStore(t, newFake("editor"))
+		entry, err := store.Save(ctx, "my-project", "test")
+		require.NoError(t, err)
+		path := filepath.Join(store.Dir, "my-project", "test", entry.ID+".json")
+		b, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(path, []byte(strings.Replace(string(b), "editor", "admin", 1)), 0o644))
+
+		// Act
+		_, err = store.Get(entry.ID)
+
+		// Assert
+		assert.ErrorContains(t, err, "content does not match its digest")
+	})
+}
+
+func TestGet(t *testing.T) {
+	store := newStore(t, newFake("editor"))
+
+	t.Run("not found", func(t *testing.T) {
+		// Act
+		_, err := store.Get("20261019T120000Z-000000000000")
+
+		// Assert
+		assert.ErrorIs(t, err, fs.ErrNotExist)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		// Act
+		_, err := store.Get("../*")
+
+		// Assert
+		assert.ErrorContains(t, err, `invalid snapshot ID "../*"`)
+	})
+}
+
+func TestRestore(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("reverts the environment", func(t *testing.T) {
+		// Arrange
+		fake := newFake("editor")
+		store := newStore(t, fake)
+		entry, err := store.Save(ctx, "my-project", "test")
+		require.NoError(t, err)
+		setRole(fake, "viewer")
+		fake.Secrets.GetAllReturns(&secrets.GetAllResponse{}, nil)
+
+		// Act
+		report, err := store.Restore(ctx, entry.ID)
+
+		// Assert
+		require.NoError(t, err)
+		require.NoError(t, report.Err())
+		require.Len(t, report.Plan.Steps, 1)
+		assert.Equal(t, "environment[test].rbac_policy", report.Plan.Steps[0].ID)
+		sets := fake.RBACPolicy.SetCalls()
+		require.Len(t, sets, 1)
+		assert.Equal(t, []rbacpolicy.Role{{RoleID: "editor"}}, sets[0].CustomRoles)
+		assert.Equal(t, []string{
+			"environment test secret secret-1 was deleted after snapshot " + entry.ID +
+				" was taken and cannot be recreated with the same value",
+		}, report.Plan.Warnings)
+
+		require.NotNil(t, report.Backup)
+		assert.NotEqual(t, entry.ID, report.Backup.ID)
+		assert.Equal(t, "viewer", report.Backup.Snapshot.Environments[0].RBACPolicy.CustomRoles[0].RoleID)
+		assert.Empty(t, fake.Projects.UpdateCalls())
+		assert.Empty(t, fake.EmailTemplates.CreateCalls())
+	})
+
+	t.Run("plans without applying", func(t *testing.T) {
+		// Arrange
+		fake := newFake("editor")
+		store := newStore(t, fake)
+		entry, err := store.Save(ctx, "my-project", "test")
+		require.NoError(t, err)
+		setRole(fake, "viewer")
+
+		// Act
+		plan, err := store.PlanRestore(ctx, entry.ID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Len(t, plan.Steps, 1)
+		assert.Empty(t, fake.RBACPolicy.SetCalls())
+	})
+
+	t.Run("recreates deleted environments", func(t *testing.T) {
+		// Arrange
+		fake := newFake("editor")
+		store := newStore(t, fake)
+		entry, err := store.Save(ctx, "my-project", "test")
+		require.NoError(t, err)
+		fake.Environments.GetAllReturns(&environments.GetAllResponse{}, nil)
+
+		// Act
+		report, err := store.Restore(ctx, entry.ID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Nil(t, report.Backup)
+		creates := fake.Environments.CreateCalls()
+		require.Len(t, creates, 1)
+		require.NotNil(t, creates[0].EnvironmentSlug)
+		assert.Equal(t, "test", *creates[0].EnvironmentSlug)
+		assert.Contains(t, report.Plan.Warnings[0], "environment test was deleted after snapshot")
+	})
+}
@@ -0,0 +1,119 @@
+package history
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/environments"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/reconcile"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/snapshot"
+)
+
+// RestoreReport is the outcome of a restore.
+type RestoreReport struct {
+	// Entry is the entry that was restored.
+	Entry *Entry
+	// Backup is the entry saved for the environment's state before the restore, so that the restore
+	// can itself be undone. It is nil if the environment no longer existed.
+	Backup *Entry
+	// Plan is the plan that was applied. Its warnings include the differences that cannot be
+	// reverted, such as deleted secrets.
+	Plan *reconcile.Plan
+	// Result is the outcome of applying the plan.
+	Result *reconcile.Result
+}
+
+// Err returns the error of the result, if any.
+func (r *RestoreReport) Err() error {
+	return r.Result.Err()
+}
+
+// PlanRestore computes the steps that revert an environment to the entry with the given ID,
+// without changing anything.
+func (s *Store) PlanRestore(ctx context.Context, id string) (*reconcile.Plan, error) {
+	entry, err := s.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	plan, _, err := s.planRestore(ctx, entry)
+	return plan, err
+}
+
+// Restore reverts an environment to the entry with the given ID. The environment's current state
+// is saved first. Secrets and public tokens are left alone; those that were deleted after the entry
+// was taken are listed in the plan's warnings, since they cannot be recreated with the same value.
+func (s *Store) Restore(ctx context.Context, id string) (*RestoreReport, error) {
+	entry, err := s.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	plan, exists, err := s.planRestore(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+	report := &RestoreReport{Entry: entry, Plan: plan}
+	if exists {
+		if report.Backup, err = s.Save(ctx, entry.ProjectSlug, entry.EnvironmentSlug); err != nil {
+			return nil, fmt.Errorf("saving the current state: %w", err)
+		}
+	}
+	report.Result = plan.Apply(ctx, s.Client)
+	return report, nil
+}
+
+// planRestore computes the restore plan for an entry and reports whether the environment still
+// exists.
+func (s *Store) planRestore(ctx context.Context, entry *Entry) (*reconcile.Plan, bool, error) {
+	projectSlug, envSlug := entry.ProjectSlug, entry.EnvironmentSlug
+	envsResp, err := s.Client.EnvironmentsAPI().GetAll(ctx, environments.GetAllRequest{ProjectSlug: projectSlug})
+	if err != nil {
+		return nil, false, fmt.Errorf("getting environments: %w", err)
+	}
+	exists := slices.ContainsFunc(envsResp.Environments, func(e environments.Environment) bool {
+		return e.EnvironmentSlug == envSlug
+	})
+
+	var current *snapshot.Snapshot
+	if exists {
+		current, err = snapshot.Take(ctx, s.Client, projectSlug, snapshot.WithEnvironments(envSlug))
+	} else {
+		current, err = snapshot.Take(ctx, s.Client, projectSlug)
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("reading live configuration: %w", err)
+	}
+
+	// Only the environment is restored: the project-level configuration is kept as it is now.
+	desired := entry.Snapshot.DeepCopy()
+	desired.Project = current.Project
+	desired.EmailTemplates = current.EmailTemplates
+	desired.DefaultEmailTemplates = current.DefaultEmailTemplates
+	plan := reconcile.Compute(current, desired)
+
+	if !exists {
+		plan.Warnings = append(plan.Warnings, fmt.Sprintf(
+			"environment %s was deleted after snapshot %s was taken; it will be recreated with new IDs and no secrets or public tokens",
+			envSlug, entry.ID))
+		return plan, false, nil
+	}
+	secretIDs, publicTokens, err := credentials(ctx, s.Client, projectSlug, envSlug)
+	if err != nil {
+		return nil, false, err
+	}
+	for _, id := range entry.SecretIDs {
+		if !slices.Contains(secretIDs, id) {
+			plan.Warnings = append(plan.Warnings, fmt.Sprintf(
+				"environment %s secret %s was deleted after snapshot %s was taken and cannot be recreated with the same value",
+				envSlug, id, entry.ID))
+		}
+	}
+	for _, token := range entry.PublicTokens {
+		if !slices.Contains(publicTokens, token) {
+			plan.Warnings = append(plan.Warnings, fmt.Sprintf(
+				"environment %s public token %s was deleted after snapshot %s was taken and cannot be recreated with the same value",
+				envSlug, token, entry.ID))
+		}
+	}
+	return plan, true, nil
+}
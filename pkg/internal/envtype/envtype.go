@@ -0,0 +1,68 @@
+// Package envtype caches the types of environments for the packages that treat LIVE environments
+// differently, such as guard, scope and approval.
+package envtype
+
+import (
+	"context"
+	"sync"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/environments"
+)
+
+// LookupFunc looks up the type of an environment.
+type LookupFunc func(ctx context.Context, projectSlug, envSlug string) (environments.EnvironmentType, error)
+
+// Cache caches the types of environments. The zero value is an empty cache, ready to use.
+type Cache struct {
+	mu    sync.Mutex
+	types map[key]environments.EnvironmentType
+}
+
+type key struct {
+	projectSlug, envSlug string
+}
+
+// Get returns the type of an environment, calling lookup unless it is cached. Errors are not
+// cached.
+func (c *Cache) Get(ctx context.Context, projectSlug, envSlug string, lookup LookupFunc) (environments.EnvironmentType, error) {
+	k := key{projectSlug, envSlug}
+	c.mu.Lock()
+	envType, ok := c.types[k]
+	c.mu.Unlock()
+	if ok {
+		return envType, nil
+	}
+
+	envType, err := lookup(ctx, projectSlug, envSlug)
+	if err != nil {
+		return "", err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.types == nil {
+		c.types = map[key]environments.EnvironmentType{}
+	}
+	c.types[k] = envType
+	return envType, nil
+}
+
+// Forget drops the cached type of an environment, or of every environment of the project if
+// envSlug is empty.
+func (c *Cache) Forget(projectSlug, envSlug string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k := range c.types {
+		if k.projectSlug == projectSlug && (envSlug == "" || k.envSlug == envSlug) {
+			delete(c.types, k)
+		}
+	}
+}
+
+// Done is called after a successful call to an operation. It forgets the environments the
+// operation deleted, whose slugs may be reused by new environments of another type.
+func (c *Cache) Done(operation, projectSlug, envSlug string) {
+	switch operation {
+	case "Environments.Delete", "Projects.Delete":
+		c.Forget(projectSlug, envSlug)
+	}
+}
@@ -0,0 +1,58 @@
+package envtype_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/internal/envtype"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/environments"
+)
+
+func TestCache(t *testing.T) {
+	ctx := context.Background()
+	var lookups []string
+	lookup := func(_ context.Context, projectSlug, envSlug string) (environments.EnvironmentType, error) {
+		lookups = append(lookups, projectSlug+"/"+envSlug)
+		return environments.EnvironmentTypeLive, nil
+	}
+
+	t.Run("caches lookups", func(t *testing.T) {
+		// Arrange
+		lookups = nil
+		var cache envtype.Cache
+
+		// Act
+		_, err := cache.Get(ctx, "p", "production", lookup)
+		require.NoError(t, err)
+		envType, err := cache.Get(ctx, "p", "production", lookup)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, environments.EnvironmentTypeLive, envType)
+		assert.Equal(t, []string{"p/production"}, lookups)
+	})
+
+	t.Run("forgets deleted environments", func(t *testing.T) {
+		// Arrange
+		lookups = nil
+		var cache envtype.Cache
+		for _, key := range [][2]string{{"p", "production"}, {"p", "test"}, {"q", "test"}} {
+			_, err := cache.Get(ctx, key[0], key[1], lookup)
+			require.NoError(t, err)
+		}
+		lookups = nil
+
+		// Act
+		cache.Done("Environments.Update", "q", "test")
+		cache.Done("Projects.Delete", "p", "")
+		for _, key := range [][2]string{{"p", "production"}, {"p", "test"}, {"q", "test"}} {
+			_, err := cache.Get(ctx, key[0], key[1], lookup)
+			require.NoError(t, err)
+		}
+
+		// Assert
+		assert.Equal(t, []string{"p/production", "p/test"}, lookups)
+	})
+}
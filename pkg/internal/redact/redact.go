@@ -0,0 +1,104 @@
+// Package redact contains helpers for keeping credentials out of formatted output, structured logs
+// and request dumps.
+package redact
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+)
+
+// Placeholder is written in place of a credential that is too short to safely reveal any part of.
+const Placeholder = "[REDACTED]"
+
+// minRevealLength is the shortest value for which the last four characters are shown. Anything
+// shorter is replaced entirely so the suffix cannot be used to narrow down the value.
+const minRevealLength = 12
+
+// Mask returns a display-safe version of a credential. Empty values stay empty so that unset
+// fields remain distinguishable from set ones. Long values keep their last four characters, which
+// matches the "last four" the management API itself returns for masked credentials.
+func Mask(s string) string {
+	switch {
+	case s == "":
+		return ""
+	case len(s) < minRevealLength:
+		return Placeholder
+	default:
+		return "..." + s[len(s)-4:]
+	}
+}
+
+// Format writes v to f using the verb and flags f was invoked with. Callers pass an already
+// masked copy of their value converted to a type without a Format method, which avoids recursing
+// back into the caller's fmt.Formatter implementation.
+func Format(f fmt.State, verb rune, v any) {
+	_, _ = fmt.Fprintf(f, fmt.FormatString(f, verb), v)
+}
+
+// String returns a masked credential as a slog attribute.
+func String(key, value string) slog.Attr {
+	return slog.String(key, Mask(value))
+}
+
+// CredentialFields are the JSON fields of request and response bodies that hold credentials: the
+// value of a new secret, and the Datadog API key and Grafana Loki password of event log streaming
+// destinations.
+var CredentialFields = []string{"secret", "api_key", "password"}
+
+// IsCredentialField reports whether a JSON field name is one of CredentialFields.
+func IsCredentialField(name string) bool {
+	return slices.Contains(CredentialFields, name)
+}
+
+// JSON returns data with the string values of CredentialFields masked, at any depth. data is
+// returned as it is if it is not JSON or holds no credentials; otherwise it is re-encoded, with
+// object keys in sorted order.
+func JSON(data []byte) []byte {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil || dec.More() || !maskValue(v) {
+		return data
+	}
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return data
+	}
+	return bytes.TrimSuffix(b.Bytes(), []byte("\n"))
+}
+
+// ContainsCredentials reports whether JSON data holds a non-empty value for any of
+// CredentialFields, at any depth.
+func ContainsCredentials(data []byte) bool {
+	var v any
+	return json.Unmarshal(data, &v) == nil && maskValue(v)
+}
+
+// maskValue masks the credentials in a decoded JSON value in place, and reports whether it found
+// any.
+func maskValue(v any) bool {
+	masked := false
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if s, ok := value.(string); ok && s != "" && IsCredentialField(key) {
+				v[key] = Mask(s)
+				masked = true
+			} else if maskValue(value) {
+				masked = true
+			}
+		}
+	case []any:
+		for _, value := range v {
+			if maskValue(value) {
+				masked = true
+			}
+		}
+	}
+	return masked
+}
@@ -0,0 +1,35 @@
+// Package request reads the fields of the request structs of the model packages, for the packages
+// that wrap every resource client, such as scope and approval.
+package request
+
+import "reflect"
+
+// StringField returns the value of a string or *string field of a struct, or of the struct a
+// pointer points to, and whether it has one.
+func StringField(v any, name string) (string, bool) {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return "", false
+	}
+	f := rv.FieldByName(name)
+	switch {
+	case !f.IsValid():
+		return "", false
+	case f.Kind() == reflect.String:
+		return f.String(), true
+	case f.Kind() == reflect.Pointer && f.Type().Elem().Kind() == reflect.String:
+		if f.IsNil() {
+			return "", true
+		}
+		return f.Elem().String(), true
+	}
+	return "", false
+}
+
+// Slugs returns the ProjectSlug and EnvironmentSlug fields of a request, or "" for those it does
+// not have.
+func Slugs(body any) (projectSlug, envSlug string) {
+	projectSlug, _ = StringField(body, "ProjectSlug")
+	envSlug, _ = StringField(body, "EnvironmentSlug")
+	return projectSlug, envSlug
+}
@@ -0,0 +1,8 @@
+// Package models is the parent of the request, response and resource types used by the management
+// API clients in pkg/api. Each resource has its own subpackage.
+//
+// The resource types in each subpackage have generated DeepCopy, Equal and Normalize methods. Run
+// go generate in this directory after the types change.
+package models
+
+//go:generate go run ../../internal/cmd/modelgen -dir .
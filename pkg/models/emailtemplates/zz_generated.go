@@ -0,0 +1,205 @@
+// Code generated by modelgen. DO NOT EDIT.
+
+package emailtemplates
+
+// DeepCopy returns a copy of c that shares no memory with it.
+func (c CustomHTMLCustomization) DeepCopy() CustomHTMLCustomization {
+	out := c
+	if c.HTMLContent != nil {
+		v := *c.HTMLContent
+		out.HTMLContent = &v
+	}
+	if c.PlaintextContent != nil {
+		v := *c.PlaintextContent
+		out.PlaintextContent = &v
+	}
+	if c.Subject != nil {
+		v := *c.Subject
+		out.Subject = &v
+	}
+	return out
+}
+
+// Equal reports whether c and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (c CustomHTMLCustomization) Equal(other CustomHTMLCustomization) bool {
+	if c.TemplateType != other.TemplateType {
+		return false
+	}
+	if (c.HTMLContent == nil) != (other.HTMLContent == nil) || (c.HTMLContent != nil && *c.HTMLContent != *other.HTMLContent) {
+		return false
+	}
+	if (c.PlaintextContent == nil) != (other.PlaintextContent == nil) || (c.PlaintextContent != nil && *c.PlaintextContent != *other.PlaintextContent) {
+		return false
+	}
+	if (c.Subject == nil) != (other.Subject == nil) || (c.Subject != nil && *c.Subject != *other.Subject) {
+		return false
+	}
+	return true
+}
+
+// Normalize puts c into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (c *CustomHTMLCustomization) Normalize() {
+}
+
+// DeepCopy returns a copy of e that shares no memory with it.
+func (e EmailTemplate) DeepCopy() EmailTemplate {
+	out := e
+	if e.Name != nil {
+		v := *e.Name
+		out.Name = &v
+	}
+	if e.SenderInformation != nil {
+		v := e.SenderInformation.DeepCopy()
+		out.SenderInformation = &v
+	}
+	if e.PrebuiltCustomization != nil {
+		v := e.PrebuiltCustomization.DeepCopy()
+		out.PrebuiltCustomization = &v
+	}
+	if e.CustomHTMLCustomization != nil {
+		v := e.CustomHTMLCustomization.DeepCopy()
+		out.CustomHTMLCustomization = &v
+	}
+	return out
+}
+
+// Equal reports whether e and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (e EmailTemplate) Equal(other EmailTemplate) bool {
+	if e.TemplateID != other.TemplateID {
+		return false
+	}
+	if (e.Name == nil) != (other.Name == nil) || (e.Name != nil && *e.Name != *other.Name) {
+		return false
+	}
+	if (e.SenderInformation == nil) != (other.SenderInformation == nil) || (e.SenderInformation != nil && !e.SenderInformation.Equal(*other.SenderInformation)) {
+		return false
+	}
+	if (e.PrebuiltCustomization == nil) != (other.PrebuiltCustomization == nil) || (e.PrebuiltCustomization != nil && !e.PrebuiltCustomization.Equal(*other.PrebuiltCustomization)) {
+		return false
+	}
+	if (e.CustomHTMLCustomization == nil) != (other.CustomHTMLCustomization == nil) || (e.CustomHTMLCustomization != nil && !e.CustomHTMLCustomization.Equal(*other.CustomHTMLCustomization)) {
+		return false
+	}
+	return true
+}
+
+// Normalize puts e into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (e *EmailTemplate) Normalize() {
+	if e.SenderInformation != nil {
+		e.SenderInformation.Normalize()
+	}
+	if e.PrebuiltCustomization != nil {
+		e.PrebuiltCustomization.Normalize()
+	}
+	if e.CustomHTMLCustomization != nil {
+		e.CustomHTMLCustomization.Normalize()
+	}
+}
+
+// DeepCopy returns a copy of p that shares no memory with it.
+func (p PrebuiltCustomization) DeepCopy() PrebuiltCustomization {
+	out := p
+	if p.ButtonBorderRadius != nil {
+		v := *p.ButtonBorderRadius
+		out.ButtonBorderRadius = &v
+	}
+	if p.ButtonColor != nil {
+		v := *p.ButtonColor
+		out.ButtonColor = &v
+	}
+	if p.ButtonTextColor != nil {
+		v := *p.ButtonTextColor
+		out.ButtonTextColor = &v
+	}
+	return out
+}
+
+// Equal reports whether p and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (p PrebuiltCustomization) Equal(other PrebuiltCustomization) bool {
+	if (p.ButtonBorderRadius == nil) != (other.ButtonBorderRadius == nil) || (p.ButtonBorderRadius != nil && *p.ButtonBorderRadius != *other.ButtonBorderRadius) {
+		return false
+	}
+	if (p.ButtonColor == nil) != (other.ButtonColor == nil) || (p.ButtonColor != nil && *p.ButtonColor != *other.ButtonColor) {
+		return false
+	}
+	if (p.ButtonTextColor == nil) != (other.ButtonTextColor == nil) || (p.ButtonTextColor != nil && *p.ButtonTextColor != *other.ButtonTextColor) {
+		return false
+	}
+	if p.FontFamily != other.FontFamily {
+		return false
+	}
+	if p.TextAlignment != other.TextAlignment {
+		return false
+	}
+	return true
+}
+
+// Normalize puts p into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (p *PrebuiltCustomization) Normalize() {
+}
+
+// DeepCopy returns a copy of s that shares no memory with it.
+func (s SenderInformation) DeepCopy() SenderInformation {
+	out := s
+	if s.FromLocalPart != nil {
+		v := *s.FromLocalPart
+		out.FromLocalPart = &v
+	}
+	if s.FromDomain != nil {
+		v := *s.FromDomain
+		out.FromDomain = &v
+	}
+	if s.FromName != nil {
+		v := *s.FromName
+		out.FromName = &v
+	}
+	if s.ReplyToLocalPart != nil {
+		v := *s.ReplyToLocalPart
+		out.ReplyToLocalPart = &v
+	}
+	if s.ReplyToName != nil {
+		v := *s.ReplyToName
+		out.ReplyToName = &v
+	}
+	return out
+}
+
+// Equal reports whether s and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (s SenderInformation) Equal(other SenderInformation) bool {
+	if (s.FromLocalPart == nil) != (other.FromLocalPart == nil) || (s.FromLocalPart != nil && *s.FromLocalPart != *other.FromLocalPart) {
+		return false
+	}
+	if (s.FromDomain == nil) != (other.FromDomain == nil) || (s.FromDomain != nil && *s.FromDomain != *other.FromDomain) {
+		return false
+	}
+	if (s.FromName == nil) != (other.FromName == nil) || (s.FromName != nil && *s.FromName != *other.FromName) {
+		return false
+	}
+	if (s.ReplyToLocalPart == nil) != (other.ReplyToLocalPart == nil) || (s.ReplyToLocalPart != nil && *s.ReplyToLocalPart != *other.ReplyToLocalPart) {
+		return false
+	}
+	if (s.ReplyToName == nil) != (other.ReplyToName == nil) || (s.ReplyToName != nil && *s.ReplyToName != *other.ReplyToName) {
+		return false
+	}
+	return true
+}
+
+// Normalize puts s into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (s *SenderInformation) Normalize() {
+}
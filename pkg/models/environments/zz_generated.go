@@ -0,0 +1,104 @@
+// Code generated by modelgen. DO NOT EDIT.
+
+package environments
+
+// DeepCopy returns a copy of e that shares no memory with it.
+func (e Environment) DeepCopy() Environment {
+	out := e
+	return out
+}
+
+// Equal reports whether e and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (e Environment) Equal(other Environment) bool {
+	if e.EnvironmentSlug != other.EnvironmentSlug {
+		return false
+	}
+	if e.ProjectSlug != other.ProjectSlug {
+		return false
+	}
+	if e.Name != other.Name {
+		return false
+	}
+	if e.OAuthCallbackID != other.OAuthCallbackID {
+		return false
+	}
+	if e.CrossOrgPasswordsEnabled != other.CrossOrgPasswordsEnabled {
+		return false
+	}
+	if e.UserImpersonationEnabled != other.UserImpersonationEnabled {
+		return false
+	}
+	if e.ZeroDowntimeSessionMigrationURL != other.ZeroDowntimeSessionMigrationURL {
+		return false
+	}
+	if e.UseCustomDomainInMagicLinkEmails != other.UseCustomDomainInMagicLinkEmails {
+		return false
+	}
+	if e.UserLockSelfServeEnabled != other.UserLockSelfServeEnabled {
+		return false
+	}
+	if e.UserLockThreshold != other.UserLockThreshold {
+		return false
+	}
+	if e.UserLockTTL != other.UserLockTTL {
+		return false
+	}
+	if e.IDPAuthorizationURL != other.IDPAuthorizationURL {
+		return false
+	}
+	if e.IDPDynamicClientRegistrationEnabled != other.IDPDynamicClientRegistrationEnabled {
+		return false
+	}
+	if e.IDPDynamicClientRegistrationAccessTokenTemplateContent != other.IDPDynamicClientRegistrationAccessTokenTemplateContent {
+		return false
+	}
+	if e.ProjectID != other.ProjectID {
+		return false
+	}
+	if e.Type != other.Type {
+		return false
+	}
+	if !e.CreatedAt.Equal(other.CreatedAt) {
+		return false
+	}
+	return true
+}
+
+// Normalize puts e into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (e *Environment) Normalize() {
+}
+
+// DeepCopy returns a copy of m that shares no memory with it.
+func (m Metrics) DeepCopy() Metrics {
+	out := m
+	return out
+}
+
+// Equal reports whether m and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (m Metrics) Equal(other Metrics) bool {
+	if m.UserCount != other.UserCount {
+		return false
+	}
+	if m.OrganizationCount != other.OrganizationCount {
+		return false
+	}
+	if m.MemberCount != other.MemberCount {
+		return false
+	}
+	if m.M2MClientCount != other.M2MClientCount {
+		return false
+	}
+	return true
+}
+
+// Normalize puts m into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (m *Metrics) Normalize() {
+}
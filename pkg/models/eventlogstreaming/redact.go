@@ -0,0 +1,212 @@
+package eventlogstreaming
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/internal/redact"
+)
+
+// These types have the same fields as the exported types but none of their methods, so they can be
+// handed back to the fmt package without recursing into Format. Credentials in nested fields are
+// masked by the Format methods of the nested types.
+type (
+	datadogConfig     DatadogConfig
+	grafanaLokiConfig GrafanaLokiConfig
+	destinationConfig DestinationConfig
+	eventLogStreaming EventLogStreaming
+	createRequest     CreateRequest
+	createResponse    CreateResponse
+	updateRequest     UpdateRequest
+	updateResponse    UpdateResponse
+)
+
+// Reveal returns the full Datadog API key. Formatting a DatadogConfig with the fmt package or logging
+// it with log/slog masks the key, so calls to Reveal mark the places where it is used on purpose.
+func (c DatadogConfig) Reveal() string {
+	return c.APIKey
+}
+
+func (c DatadogConfig) masked() datadogConfig {
+	m := datadogConfig(c)
+	m.APIKey = redact.Mask(c.APIKey)
+	return m
+}
+
+// Format implements fmt.Formatter. The API key is masked for every verb.
+func (c DatadogConfig) Format(f fmt.State, verb rune) {
+	redact.Format(f, verb, c.masked())
+}
+
+// String implements fmt.Stringer. The API key is masked.
+func (c DatadogConfig) String() string {
+	return fmt.Sprint(c.masked())
+}
+
+// LogValue implements slog.LogValuer. The API key is masked.
+func (c DatadogConfig) LogValue() slog.Value {
+	return slog.GroupValue(
+		redact.String("api_key", c.APIKey),
+		slog.String("site", string(c.Site)),
+	)
+}
+
+// Reveal returns the full Grafana Loki password. Formatting a GrafanaLokiConfig with the fmt package
+// or logging it with log/slog masks the password, so calls to Reveal mark the places where it is
+// used on purpose.
+func (c GrafanaLokiConfig) Reveal() string {
+	return c.Password
+}
+
+func (c GrafanaLokiConfig) masked() grafanaLokiConfig {
+	m := grafanaLokiConfig(c)
+	m.Password = redact.Mask(c.Password)
+	return m
+}
+
+// Format implements fmt.Formatter. The password is masked for every verb.
+func (c GrafanaLokiConfig) Format(f fmt.State, verb rune) {
+	redact.Format(f, verb, c.masked())
+}
+
+// String implements fmt.Stringer. The password is masked.
+func (c GrafanaLokiConfig) String() string {
+	return fmt.Sprint(c.masked())
+}
+
+// LogValue implements slog.LogValuer. The password is masked.
+func (c GrafanaLokiConfig) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("hostname", c.Hostname),
+		slog.String("username", c.Username),
+		redact.String("password", c.Password),
+	)
+}
+
+// Format implements fmt.Formatter. Destination credentials are masked for every verb.
+func (c DestinationConfig) Format(f fmt.State, verb rune) {
+	redact.Format(f, verb, destinationConfig(c))
+}
+
+// String implements fmt.Stringer. Destination credentials are masked.
+func (c DestinationConfig) String() string {
+	return fmt.Sprint(destinationConfig(c))
+}
+
+// LogValue implements slog.LogValuer. Destination credentials are masked.
+func (c DestinationConfig) LogValue() slog.Value {
+	var attrs []slog.Attr
+	if c.Datadog != nil {
+		attrs = append(attrs, slog.Attr{Key: "datadog", Value: c.Datadog.LogValue()})
+	}
+	if c.GrafanaLoki != nil {
+		attrs = append(attrs, slog.Attr{Key: "grafana_loki", Value: c.GrafanaLoki.LogValue()})
+	}
+	return slog.GroupValue(attrs...)
+}
+
+// Format implements fmt.Formatter. Destination credentials are masked for every verb.
+func (e EventLogStreaming) Format(f fmt.State, verb rune) {
+	redact.Format(f, verb, eventLogStreaming(e))
+}
+
+// String implements fmt.Stringer. Destination credentials are masked.
+func (e EventLogStreaming) String() string {
+	return fmt.Sprint(eventLogStreaming(e))
+}
+
+// LogValue implements slog.LogValuer. Destination credentials are masked.
+func (e EventLogStreaming) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("destination_type", string(e.DestinationType)),
+		destinationConfigAttr(e.DestinationConfig),
+		slog.String("streaming_status", string(e.StreamingStatus)),
+	)
+}
+
+// Format implements fmt.Formatter. Destination credentials are masked for every verb.
+func (r CreateRequest) Format(f fmt.State, verb rune) {
+	redact.Format(f, verb, createRequest(r))
+}
+
+// String implements fmt.Stringer. Destination credentials are masked.
+func (r CreateRequest) String() string {
+	return fmt.Sprint(createRequest(r))
+}
+
+// LogValue implements slog.LogValuer. Destination credentials are masked.
+func (r CreateRequest) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("project_slug", r.ProjectSlug),
+		slog.String("environment_slug", r.EnvironmentSlug),
+		slog.String("destination_type", string(r.DestinationType)),
+		destinationConfigAttr(r.DestinationConfig),
+	)
+}
+
+// Format implements fmt.Formatter. Destination credentials are masked for every verb.
+func (r CreateResponse) Format(f fmt.State, verb rune) {
+	redact.Format(f, verb, createResponse(r))
+}
+
+// String implements fmt.Stringer. Destination credentials are masked.
+func (r CreateResponse) String() string {
+	return fmt.Sprint(createResponse(r))
+}
+
+// LogValue implements slog.LogValuer. Destination credentials are masked.
+func (r CreateResponse) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("request_id", r.RequestID),
+		slog.Attr{Key: "event_log_streaming_config", Value: r.EventLogStreamingConfig.LogValue()},
+		slog.Int("status_code", r.StatusCode),
+	)
+}
+
+// Format implements fmt.Formatter. Destination credentials are masked for every verb.
+func (r UpdateRequest) Format(f fmt.State, verb rune) {
+	redact.Format(f, verb, updateRequest(r))
+}
+
+// String implements fmt.Stringer. Destination credentials are masked.
+func (r UpdateRequest) String() string {
+	return fmt.Sprint(updateRequest(r))
+}
+
+// LogValue implements slog.LogValuer. Destination credentials are masked.
+func (r UpdateRequest) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("project_slug", r.ProjectSlug),
+		slog.String("environment_slug", r.EnvironmentSlug),
+		slog.String("destination_type", string(r.DestinationType)),
+		destinationConfigAttr(r.DestinationConfig),
+	)
+}
+
+// Format implements fmt.Formatter. Destination credentials are masked for every verb.
+func (r UpdateResponse) Format(f fmt.State, verb rune) {
+	redact.Format(f, verb, updateResponse(r))
+}
+
+// String implements fmt.Stringer. Destination credentials are masked.
+func (r UpdateResponse) String() string {
+	return fmt.Sprint(updateResponse(r))
+}
+
+// LogValue implements slog.LogValuer. Destination credentials are masked.
+func (r UpdateResponse) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("request_id", r.RequestID),
+		slog.Attr{Key: "event_log_streaming_config", Value: r.EventLogStreamingConfig.LogValue()},
+		slog.Int("status_code", r.StatusCode),
+	)
+}
+
+// destinationConfigAttr returns the log attribute for an optional destination config. A nil config
+// yields the zero Attr, which slog handlers omit.
+func destinationConfigAttr(c *DestinationConfig) slog.Attr {
+	if c == nil {
+		return slog.Attr{}
+	}
+	return slog.Attr{Key: "destination_config", Value: c.LogValue()}
+}
@@ -0,0 +1,79 @@
+package eventlogstreaming_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/eventlogstreaming"
+)
+
+const (
+	apiKey   = "datadog-api-key-0123456789"
+	password = "grafana-password-abcdefgh"
+)
+
+func TestEventLogStreaming_Redaction(t *testing.T) {
+	config := &eventlogstreaming.DestinationConfig{
+		Datadog: &eventlogstreaming.DatadogConfig{
+			APIKey: apiKey,
+			Site:   eventlogstreaming.DatadogSiteUs,
+		},
+		GrafanaLoki: &eventlogstreaming.GrafanaLokiConfig{
+			Hostname: "logs.example.com",
+			Username: "stytch",
+			Password: password,
+		},
+	}
+	values := []any{
+		*config.Datadog,
+		*config.GrafanaLoki,
+		config,
+		eventlogstreaming.CreateRequest{ProjectSlug: "project", DestinationConfig: config},
+		eventlogstreaming.UpdateRequest{ProjectSlug: "project", DestinationConfig: config},
+		eventlogstreaming.CreateResponse{EventLogStreamingConfig: eventlogstreaming.EventLogStreaming{
+			DestinationConfig: config,
+		}},
+		eventlogstreaming.UpdateResponse{EventLogStreamingConfig: eventlogstreaming.EventLogStreaming{
+			DestinationConfig: config,
+		}},
+	}
+
+	t.Run("fmt verbs", func(t *testing.T) {
+		for _, verb := range []string{"%v", "%+v", "%#v", "%s"} {
+			for _, v := range values {
+				out := fmt.Sprintf(verb, v)
+				assert.NotContains(t, out, apiKey, "verb %s on %T", verb, v)
+				assert.NotContains(t, out, password, "verb %s on %T", verb, v)
+			}
+		}
+		assert.Contains(t, fmt.Sprintf("%+v", config), "logs.example.com")
+	})
+
+	t.Run("slog JSON", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger := slog.New(slog.NewJSONHandler(&buf, nil))
+		for _, v := range values {
+			logger.Info("config", "value", v)
+		}
+		assert.NotContains(t, buf.String(), apiKey)
+		assert.NotContains(t, buf.String(), password)
+		assert.Contains(t, buf.String(), `"hostname":"logs.example.com"`)
+	})
+
+	t.Run("request bodies are not masked", func(t *testing.T) {
+		body, err := json.Marshal(eventlogstreaming.CreateRequest{DestinationConfig: config})
+		require.NoError(t, err)
+		assert.Contains(t, string(body), apiKey)
+		assert.Contains(t, string(body), password)
+	})
+
+	t.Run("reveal", func(t *testing.T) {
+		assert.Equal(t, apiKey, config.Datadog.Reveal())
+		assert.Equal(t, password, config.GrafanaLoki.Reveal())
+	})
+}
@@ -0,0 +1,257 @@
+// Code generated by modelgen. DO NOT EDIT.
+
+package eventlogstreaming
+
+// DeepCopy returns a copy of d that shares no memory with it.
+func (d DatadogConfig) DeepCopy() DatadogConfig {
+	out := d
+	return out
+}
+
+// Equal reports whether d and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (d DatadogConfig) Equal(other DatadogConfig) bool {
+	if d.APIKey != other.APIKey {
+		return false
+	}
+	if d.Site != other.Site {
+		return false
+	}
+	return true
+}
+
+// Normalize puts d into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (d *DatadogConfig) Normalize() {
+}
+
+// DeepCopy returns a copy of d that shares no memory with it.
+func (d DatadogConfigMasked) DeepCopy() DatadogConfigMasked {
+	out := d
+	return out
+}
+
+// Equal reports whether d and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (d DatadogConfigMasked) Equal(other DatadogConfigMasked) bool {
+	if d.APIKeyLastFour != other.APIKeyLastFour {
+		return false
+	}
+	if d.Site != other.Site {
+		return false
+	}
+	return true
+}
+
+// Normalize puts d into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (d *DatadogConfigMasked) Normalize() {
+}
+
+// DeepCopy returns a copy of d that shares no memory with it.
+func (d DestinationConfig) DeepCopy() DestinationConfig {
+	out := d
+	if d.Datadog != nil {
+		v := d.Datadog.DeepCopy()
+		out.Datadog = &v
+	}
+	if d.GrafanaLoki != nil {
+		v := d.GrafanaLoki.DeepCopy()
+		out.GrafanaLoki = &v
+	}
+	return out
+}
+
+// Equal reports whether d and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (d DestinationConfig) Equal(other DestinationConfig) bool {
+	if (d.Datadog == nil) != (other.Datadog == nil) || (d.Datadog != nil && !d.Datadog.Equal(*other.Datadog)) {
+		return false
+	}
+	if (d.GrafanaLoki == nil) != (other.GrafanaLoki == nil) || (d.GrafanaLoki != nil && !d.GrafanaLoki.Equal(*other.GrafanaLoki)) {
+		return false
+	}
+	return true
+}
+
+// Normalize puts d into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (d *DestinationConfig) Normalize() {
+	if d.Datadog != nil {
+		d.Datadog.Normalize()
+	}
+	if d.GrafanaLoki != nil {
+		d.GrafanaLoki.Normalize()
+	}
+}
+
+// DeepCopy returns a copy of d that shares no memory with it.
+func (d DestinationConfigMasked) DeepCopy() DestinationConfigMasked {
+	out := d
+	if d.Datadog != nil {
+		v := d.Datadog.DeepCopy()
+		out.Datadog = &v
+	}
+	if d.GrafanaLoki != nil {
+		v := d.GrafanaLoki.DeepCopy()
+		out.GrafanaLoki = &v
+	}
+	return out
+}
+
+// Equal reports whether d and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (d DestinationConfigMasked) Equal(other DestinationConfigMasked) bool {
+	if (d.Datadog == nil) != (other.Datadog == nil) || (d.Datadog != nil && !d.Datadog.Equal(*other.Datadog)) {
+		return false
+	}
+	if (d.GrafanaLoki == nil) != (other.GrafanaLoki == nil) || (d.GrafanaLoki != nil && !d.GrafanaLoki.Equal(*other.GrafanaLoki)) {
+		return false
+	}
+	return true
+}
+
+// Normalize puts d into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (d *DestinationConfigMasked) Normalize() {
+	if d.Datadog != nil {
+		d.Datadog.Normalize()
+	}
+	if d.GrafanaLoki != nil {
+		d.GrafanaLoki.Normalize()
+	}
+}
+
+// DeepCopy returns a copy of e that shares no memory with it.
+func (e EventLogStreaming) DeepCopy() EventLogStreaming {
+	out := e
+	if e.DestinationConfig != nil {
+		v := e.DestinationConfig.DeepCopy()
+		out.DestinationConfig = &v
+	}
+	return out
+}
+
+// Equal reports whether e and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (e EventLogStreaming) Equal(other EventLogStreaming) bool {
+	if e.DestinationType != other.DestinationType {
+		return false
+	}
+	if (e.DestinationConfig == nil) != (other.DestinationConfig == nil) || (e.DestinationConfig != nil && !e.DestinationConfig.Equal(*other.DestinationConfig)) {
+		return false
+	}
+	if e.StreamingStatus != other.StreamingStatus {
+		return false
+	}
+	return true
+}
+
+// Normalize puts e into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (e *EventLogStreaming) Normalize() {
+	if e.DestinationConfig != nil {
+		e.DestinationConfig.Normalize()
+	}
+}
+
+// DeepCopy returns a copy of e that shares no memory with it.
+func (e EventLogStreamingMasked) DeepCopy() EventLogStreamingMasked {
+	out := e
+	if e.DestinationConfig != nil {
+		v := e.DestinationConfig.DeepCopy()
+		out.DestinationConfig = &v
+	}
+	return out
+}
+
+// Equal reports whether e and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (e EventLogStreamingMasked) Equal(other EventLogStreamingMasked) bool {
+	if e.DestinationType != other.DestinationType {
+		return false
+	}
+	if (e.DestinationConfig == nil) != (other.DestinationConfig == nil) || (e.DestinationConfig != nil && !e.DestinationConfig.Equal(*other.DestinationConfig)) {
+		return false
+	}
+	if e.StreamingStatus != other.StreamingStatus {
+		return false
+	}
+	return true
+}
+
+// Normalize puts e into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (e *EventLogStreamingMasked) Normalize() {
+	if e.DestinationConfig != nil {
+		e.DestinationConfig.Normalize()
+	}
+}
+
+// DeepCopy returns a copy of g that shares no memory with it.
+func (g GrafanaLokiConfig) DeepCopy() GrafanaLokiConfig {
+	out := g
+	return out
+}
+
+// Equal reports whether g and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (g GrafanaLokiConfig) Equal(other GrafanaLokiConfig) bool {
+	if g.Hostname != other.Hostname {
+		return false
+	}
+	if g.Username != other.Username {
+		return false
+	}
+	if g.Password != other.Password {
+		return false
+	}
+	return true
+}
+
+// Normalize puts g into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (g *GrafanaLokiConfig) Normalize() {
+}
+
+// DeepCopy returns a copy of g that shares no memory with it.
+func (g GrafanaLokiConfigMasked) DeepCopy() GrafanaLokiConfigMasked {
+	out := g
+	return out
+}
+
+// Equal reports whether g and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (g GrafanaLokiConfigMasked) Equal(other GrafanaLokiConfigMasked) bool {
+	if g.Hostname != other.Hostname {
+		return false
+	}
+	if g.Username != other.Username {
+		return false
+	}
+	if g.PasswordLastFour != other.PasswordLastFour {
+		return false
+	}
+	return true
+}
+
+// Normalize puts g into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (g *GrafanaLokiConfigMasked) Normalize() {
+}
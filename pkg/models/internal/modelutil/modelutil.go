@@ -0,0 +1,117 @@
+// Package modelutil contains runtime helpers used by the generated DeepCopy, Equal and Normalize
+// methods of the model packages. It handles the loosely typed values (such as attribute mappings)
+// that the generator cannot expand into field-by-field code.
+package modelutil
+
+import (
+	"reflect"
+)
+
+// CopyMap returns a deep copy of a JSON-like map. A nil pointer is returned as nil.
+func CopyMap(m *map[string]any) *map[string]any {
+	if m == nil {
+		return nil
+	}
+	out := CopyValue(*m).(map[string]any)
+	return &out
+}
+
+// CopyValue returns a deep copy of a value decoded from JSON. Maps and slices are copied
+// recursively; every other value is returned as is.
+func CopyValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		if v == nil {
+			return map[string]any(nil)
+		}
+		out := make(map[string]any, len(v))
+		for k, e := range v {
+			out[k] = CopyValue(e)
+		}
+		return out
+	case []any:
+		if v == nil {
+			return []any(nil)
+		}
+		out := make([]any, len(v))
+		for i, e := range v {
+			out[i] = CopyValue(e)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// EqualMap reports whether two JSON-like maps hold the same values. A nil pointer and a pointer to
+// an empty map are considered equal.
+func EqualMap(a, b *map[string]any) bool {
+	var am, bm map[string]any
+	if a != nil {
+		am = *a
+	}
+	if b != nil {
+		bm = *b
+	}
+	return EqualValue(am, bm)
+}
+
+// EqualValue reports whether two values decoded from JSON are equal. Numbers are compared by value
+// regardless of their Go type, since a value built in code (for example an int) and the same value
+// decoded from a response (a float64) should compare equal. Nil and empty maps and slices are
+// considered equal.
+func EqualValue(a, b any) bool {
+	if af, ok := toFloat(a); ok {
+		bf, ok := toFloat(b)
+		return ok && af == bf
+	}
+	switch a := a.(type) {
+	case map[string]any:
+		b, ok := b.(map[string]any)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for k, av := range a {
+			bv, ok := b[k]
+			if !ok || !EqualValue(av, bv) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		b, ok := b.([]any)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !EqualValue(a[i], b[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(a, b)
+	}
+}
+
+// NormalizeMap returns nil for a nil pointer or a pointer to an empty map, and m otherwise.
+func NormalizeMap(m *map[string]any) *map[string]any {
+	if m == nil || len(*m) == 0 {
+		return nil
+	}
+	return m
+}
+
+func toFloat(v any) (float64, bool) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,31 @@
+// Code generated by modelgen. DO NOT EDIT.
+
+package jwttemplates
+
+// DeepCopy returns a copy of j that shares no memory with it.
+func (j JWTTemplate) DeepCopy() JWTTemplate {
+	out := j
+	return out
+}
+
+// Equal reports whether j and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (j JWTTemplate) Equal(other JWTTemplate) bool {
+	if j.TemplateContent != other.TemplateContent {
+		return false
+	}
+	if j.CustomAudience != other.CustomAudience {
+		return false
+	}
+	if j.JWTTemplateType != other.JWTTemplateType {
+		return false
+	}
+	return true
+}
+
+// Normalize puts j into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (j *JWTTemplate) Normalize() {
+}
@@ -0,0 +1,48 @@
+// Code generated by modelgen. DO NOT EDIT.
+
+package passwordstrengthconfig
+
+// DeepCopy returns a copy of p that shares no memory with it.
+func (p PasswordStrengthConfig) DeepCopy() PasswordStrengthConfig {
+	out := p
+	if p.LudsMinPasswordLength != nil {
+		v := *p.LudsMinPasswordLength
+		out.LudsMinPasswordLength = &v
+	}
+	if p.LudsMinPasswordComplexity != nil {
+		v := *p.LudsMinPasswordComplexity
+		out.LudsMinPasswordComplexity = &v
+	}
+	return out
+}
+
+// Equal reports whether p and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (p PasswordStrengthConfig) Equal(other PasswordStrengthConfig) bool {
+	if p.CheckBreachOnCreation != other.CheckBreachOnCreation {
+		return false
+	}
+	if p.CheckBreachOnAuthentication != other.CheckBreachOnAuthentication {
+		return false
+	}
+	if p.ValidateOnAuthentication != other.ValidateOnAuthentication {
+		return false
+	}
+	if p.ValidationPolicy != other.ValidationPolicy {
+		return false
+	}
+	if (p.LudsMinPasswordLength == nil) != (other.LudsMinPasswordLength == nil) || (p.LudsMinPasswordLength != nil && *p.LudsMinPasswordLength != *other.LudsMinPasswordLength) {
+		return false
+	}
+	if (p.LudsMinPasswordComplexity == nil) != (other.LudsMinPasswordComplexity == nil) || (p.LudsMinPasswordComplexity != nil && *p.LudsMinPasswordComplexity != *other.LudsMinPasswordComplexity) {
+		return false
+	}
+	return true
+}
+
+// Normalize puts p into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (p *PasswordStrengthConfig) Normalize() {
+}
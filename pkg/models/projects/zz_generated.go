@@ -0,0 +1,34 @@
+// Code generated by modelgen. DO NOT EDIT.
+
+package projects
+
+// DeepCopy returns a copy of p that shares no memory with it.
+func (p Project) DeepCopy() Project {
+	out := p
+	return out
+}
+
+// Equal reports whether p and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (p Project) Equal(other Project) bool {
+	if p.ProjectSlug != other.ProjectSlug {
+		return false
+	}
+	if p.Name != other.Name {
+		return false
+	}
+	if p.Vertical != other.Vertical {
+		return false
+	}
+	if !p.CreatedAt.Equal(other.CreatedAt) {
+		return false
+	}
+	return true
+}
+
+// Normalize puts p into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (p *Project) Normalize() {
+}
@@ -0,0 +1,28 @@
+// Code generated by modelgen. DO NOT EDIT.
+
+package publictokens
+
+// DeepCopy returns a copy of p that shares no memory with it.
+func (p PublicToken) DeepCopy() PublicToken {
+	out := p
+	return out
+}
+
+// Equal reports whether p and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (p PublicToken) Equal(other PublicToken) bool {
+	if p.PublicToken != other.PublicToken {
+		return false
+	}
+	if !p.CreatedAt.Equal(other.CreatedAt) {
+		return false
+	}
+	return true
+}
+
+// Normalize puts p into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (p *PublicToken) Normalize() {
+}
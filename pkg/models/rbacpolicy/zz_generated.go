@@ -0,0 +1,377 @@
+// Code generated by modelgen. DO NOT EDIT.
+
+package rbacpolicy
+
+import (
+	"cmp"
+	"slices"
+)
+
+// DeepCopy returns a copy of d that shares no memory with it.
+func (d DefaultRole) DeepCopy() DefaultRole {
+	out := d
+	if d.Permissions != nil {
+		out.Permissions = make([]Permission, len(d.Permissions))
+		for i := range d.Permissions {
+			out.Permissions[i] = d.Permissions[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether d and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (d DefaultRole) Equal(other DefaultRole) bool {
+	if len(d.Permissions) != len(other.Permissions) {
+		return false
+	}
+	for i := range d.Permissions {
+		if !d.Permissions[i].Equal(other.Permissions[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Normalize puts d into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (d *DefaultRole) Normalize() {
+	if len(d.Permissions) == 0 {
+		d.Permissions = nil
+	} else {
+		for i := range d.Permissions {
+			d.Permissions[i].Normalize()
+		}
+		slices.SortStableFunc(d.Permissions, comparePermission)
+	}
+}
+
+// DeepCopy returns a copy of p that shares no memory with it.
+func (p Permission) DeepCopy() Permission {
+	out := p
+	if p.Actions != nil {
+		out.Actions = make([]string, len(p.Actions))
+		copy(out.Actions, p.Actions)
+	}
+	return out
+}
+
+// Equal reports whether p and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (p Permission) Equal(other Permission) bool {
+	if p.ResourceID != other.ResourceID {
+		return false
+	}
+	if len(p.Actions) != len(other.Actions) {
+		return false
+	}
+	for i := range p.Actions {
+		if p.Actions[i] != other.Actions[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Normalize puts p into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (p *Permission) Normalize() {
+	if len(p.Actions) == 0 {
+		p.Actions = nil
+	} else {
+		slices.Sort(p.Actions)
+	}
+}
+
+// DeepCopy returns a copy of p that shares no memory with it.
+func (p Policy) DeepCopy() Policy {
+	out := p
+	if p.StytchResources != nil {
+		out.StytchResources = make([]Resource, len(p.StytchResources))
+		for i := range p.StytchResources {
+			out.StytchResources[i] = p.StytchResources[i].DeepCopy()
+		}
+	}
+	if p.CustomRoles != nil {
+		out.CustomRoles = make([]Role, len(p.CustomRoles))
+		for i := range p.CustomRoles {
+			out.CustomRoles[i] = p.CustomRoles[i].DeepCopy()
+		}
+	}
+	if p.CustomResources != nil {
+		out.CustomResources = make([]Resource, len(p.CustomResources))
+		for i := range p.CustomResources {
+			out.CustomResources[i] = p.CustomResources[i].DeepCopy()
+		}
+	}
+	if p.CustomScopes != nil {
+		out.CustomScopes = make([]Scope, len(p.CustomScopes))
+		for i := range p.CustomScopes {
+			out.CustomScopes[i] = p.CustomScopes[i].DeepCopy()
+		}
+	}
+	if p.StytchMember != nil {
+		v := p.StytchMember.DeepCopy()
+		out.StytchMember = &v
+	}
+	if p.StytchAdmin != nil {
+		v := p.StytchAdmin.DeepCopy()
+		out.StytchAdmin = &v
+	}
+	if p.StytchUser != nil {
+		v := p.StytchUser.DeepCopy()
+		out.StytchUser = &v
+	}
+	return out
+}
+
+// Equal reports whether p and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (p Policy) Equal(other Policy) bool {
+	if len(p.StytchResources) != len(other.StytchResources) {
+		return false
+	}
+	for i := range p.StytchResources {
+		if !p.StytchResources[i].Equal(other.StytchResources[i]) {
+			return false
+		}
+	}
+	if len(p.CustomRoles) != len(other.CustomRoles) {
+		return false
+	}
+	for i := range p.CustomRoles {
+		if !p.CustomRoles[i].Equal(other.CustomRoles[i]) {
+			return false
+		}
+	}
+	if len(p.CustomResources) != len(other.CustomResources) {
+		return false
+	}
+	for i := range p.CustomResources {
+		if !p.CustomResources[i].Equal(other.CustomResources[i]) {
+			return false
+		}
+	}
+	if len(p.CustomScopes) != len(other.CustomScopes) {
+		return false
+	}
+	for i := range p.CustomScopes {
+		if !p.CustomScopes[i].Equal(other.CustomScopes[i]) {
+			return false
+		}
+	}
+	if (p.StytchMember == nil) != (other.StytchMember == nil) || (p.StytchMember != nil && !p.StytchMember.Equal(*other.StytchMember)) {
+		return false
+	}
+	if (p.StytchAdmin == nil) != (other.StytchAdmin == nil) || (p.StytchAdmin != nil && !p.StytchAdmin.Equal(*other.StytchAdmin)) {
+		return false
+	}
+	if (p.StytchUser == nil) != (other.StytchUser == nil) || (p.StytchUser != nil && !p.StytchUser.Equal(*other.StytchUser)) {
+		return false
+	}
+	return true
+}
+
+// Normalize puts p into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (p *Policy) Normalize() {
+	if len(p.StytchResources) == 0 {
+		p.StytchResources = nil
+	} else {
+		for i := range p.StytchResources {
+			p.StytchResources[i].Normalize()
+		}
+		slices.SortStableFunc(p.StytchResources, compareResource)
+	}
+	if len(p.CustomRoles) == 0 {
+		p.CustomRoles = nil
+	} else {
+		for i := range p.CustomRoles {
+			p.CustomRoles[i].Normalize()
+		}
+		slices.SortStableFunc(p.CustomRoles, compareRole)
+	}
+	if len(p.CustomResources) == 0 {
+		p.CustomResources = nil
+	} else {
+		for i := range p.CustomResources {
+			p.CustomResources[i].Normalize()
+		}
+		slices.SortStableFunc(p.CustomResources, compareResource)
+	}
+	if len(p.CustomScopes) == 0 {
+		p.CustomScopes = nil
+	} else {
+		for i := range p.CustomScopes {
+			p.CustomScopes[i].Normalize()
+		}
+		slices.SortStableFunc(p.CustomScopes, compareScope)
+	}
+	if p.StytchMember != nil {
+		p.StytchMember.Normalize()
+	}
+	if p.StytchAdmin != nil {
+		p.StytchAdmin.Normalize()
+	}
+	if p.StytchUser != nil {
+		p.StytchUser.Normalize()
+	}
+}
+
+// DeepCopy returns a copy of r that shares no memory with it.
+func (r Resource) DeepCopy() Resource {
+	out := r
+	if r.AvailableActions != nil {
+		out.AvailableActions = make([]string, len(r.AvailableActions))
+		copy(out.AvailableActions, r.AvailableActions)
+	}
+	return out
+}
+
+// Equal reports whether r and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (r Resource) Equal(other Resource) bool {
+	if r.ResourceID != other.ResourceID {
+		return false
+	}
+	if r.Description != other.Description {
+		return false
+	}
+	if len(r.AvailableActions) != len(other.AvailableActions) {
+		return false
+	}
+	for i := range r.AvailableActions {
+		if r.AvailableActions[i] != other.AvailableActions[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Normalize puts r into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (r *Resource) Normalize() {
+	if len(r.AvailableActions) == 0 {
+		r.AvailableActions = nil
+	} else {
+		slices.Sort(r.AvailableActions)
+	}
+}
+
+// DeepCopy returns a copy of r that shares no memory with it.
+func (r Role) DeepCopy() Role {
+	out := r
+	if r.Permissions != nil {
+		out.Permissions = make([]Permission, len(r.Permissions))
+		for i := range r.Permissions {
+			out.Permissions[i] = r.Permissions[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (r Role) Equal(other Role) bool {
+	if r.RoleID != other.RoleID {
+		return false
+	}
+	if r.Description != other.Description {
+		return false
+	}
+	if len(r.Permissions) != len(other.Permissions) {
+		return false
+	}
+	for i := range r.Permissions {
+		if !r.Permissions[i].Equal(other.Permissions[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Normalize puts r into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (r *Role) Normalize() {
+	if len(r.Permissions) == 0 {
+		r.Permissions = nil
+	} else {
+		for i := range r.Permissions {
+			r.Permissions[i].Normalize()
+		}
+		slices.SortStableFunc(r.Permissions, comparePermission)
+	}
+}
+
+// DeepCopy returns a copy of s that shares no memory with it.
+func (s Scope) DeepCopy() Scope {
+	out := s
+	if s.Permissions != nil {
+		out.Permissions = make([]Permission, len(s.Permissions))
+		for i := range s.Permissions {
+			out.Permissions[i] = s.Permissions[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether s and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (s Scope) Equal(other Scope) bool {
+	if s.Scope != other.Scope {
+		return false
+	}
+	if s.Description != other.Description {
+		return false
+	}
+	if len(s.Permissions) != len(other.Permissions) {
+		return false
+	}
+	for i := range s.Permissions {
+		if !s.Permissions[i].Equal(other.Permissions[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Normalize puts s into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (s *Scope) Normalize() {
+	if len(s.Permissions) == 0 {
+		s.Permissions = nil
+	} else {
+		for i := range s.Permissions {
+			s.Permissions[i].Normalize()
+		}
+		slices.SortStableFunc(s.Permissions, comparePermission)
+	}
+}
+
+func comparePermission(a, b Permission) int {
+	return cmp.Compare(a.ResourceID, b.ResourceID)
+}
+
+func compareResource(a, b Resource) int {
+	return cmp.Compare(a.ResourceID, b.ResourceID)
+}
+
+func compareRole(a, b Role) int {
+	return cmp.Compare(a.RoleID, b.RoleID)
+}
+
+func compareScope(a, b Scope) int {
+	return cmp.Compare(a.Scope, b.Scope)
+}
@@ -0,0 +1,61 @@
+package rbacpolicy_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/rbacpolicy"
+)
+
+func TestPolicy_Helpers(t *testing.T) {
+	policy := func() rbacpolicy.Policy {
+		return rbacpolicy.Policy{
+			CustomRoles: []rbacpolicy.Role{
+				{RoleID: "writer", Permissions: []rbacpolicy.Permission{
+					{ResourceID: "documents", Actions: []string{"update", "create"}},
+					{ResourceID: "images", Actions: []string{"read"}},
+				}},
+				{RoleID: "reader", Permissions: []rbacpolicy.Permission{
+					{ResourceID: "documents", Actions: []string{"read"}},
+				}},
+			},
+			CustomScopes: []rbacpolicy.Scope{},
+			StytchMember: &rbacpolicy.DefaultRole{},
+		}
+	}
+
+	t.Run("deep copy shares no memory", func(t *testing.T) {
+		original := policy()
+		cp := original.DeepCopy()
+		cp.CustomRoles[0].Permissions[0].Actions[0] = "delete"
+		cp.StytchMember.Permissions = append(cp.StytchMember.Permissions, rbacpolicy.Permission{ResourceID: "x"})
+
+		assert.Equal(t, "update", original.CustomRoles[0].Permissions[0].Actions[0])
+		assert.Empty(t, original.StytchMember.Permissions)
+	})
+
+	t.Run("equal treats nil and empty lists alike", func(t *testing.T) {
+		a := policy()
+		b := policy()
+		b.CustomScopes = nil
+		assert.True(t, a.Equal(b))
+
+		b.StytchMember = nil
+		assert.False(t, a.Equal(b))
+	})
+
+	t.Run("normalize makes order irrelevant", func(t *testing.T) {
+		a := policy()
+		b := policy()
+		b.CustomRoles[0], b.CustomRoles[1] = b.CustomRoles[1], b.CustomRoles[0]
+		b.CustomRoles[1].Permissions[0].Actions = []string{"create", "update"}
+		assert.False(t, a.Equal(b))
+
+		a.Normalize()
+		b.Normalize()
+		assert.True(t, a.Equal(b))
+		assert.Equal(t, "reader", a.CustomRoles[0].RoleID)
+		assert.Equal(t, []string{"create", "update"}, a.CustomRoles[1].Permissions[0].Actions)
+		assert.Nil(t, a.CustomScopes)
+	})
+}
@@ -0,0 +1,81 @@
+// Code generated by modelgen. DO NOT EDIT.
+
+package redirecturls
+
+import (
+	"cmp"
+	"slices"
+)
+
+// DeepCopy returns a copy of r that shares no memory with it.
+func (r RedirectURL) DeepCopy() RedirectURL {
+	out := r
+	if r.ValidTypes != nil {
+		out.ValidTypes = make([]URLType, len(r.ValidTypes))
+		for i := range r.ValidTypes {
+			out.ValidTypes[i] = r.ValidTypes[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (r RedirectURL) Equal(other RedirectURL) bool {
+	if r.URL != other.URL {
+		return false
+	}
+	if len(r.ValidTypes) != len(other.ValidTypes) {
+		return false
+	}
+	for i := range r.ValidTypes {
+		if !r.ValidTypes[i].Equal(other.ValidTypes[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Normalize puts r into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (r *RedirectURL) Normalize() {
+	if len(r.ValidTypes) == 0 {
+		r.ValidTypes = nil
+	} else {
+		for i := range r.ValidTypes {
+			r.ValidTypes[i].Normalize()
+		}
+		slices.SortStableFunc(r.ValidTypes, compareURLType)
+	}
+}
+
+// DeepCopy returns a copy of u that shares no memory with it.
+func (u URLType) DeepCopy() URLType {
+	out := u
+	return out
+}
+
+// Equal reports whether u and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (u URLType) Equal(other URLType) bool {
+	if u.IsDefault != other.IsDefault {
+		return false
+	}
+	if u.Type != other.Type {
+		return false
+	}
+	return true
+}
+
+// Normalize puts u into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (u *URLType) Normalize() {
+}
+
+func compareURLType(a, b URLType) int {
+	return cmp.Compare(a.Type, b.Type)
+}
@@ -0,0 +1,1070 @@
+// Code generated by modelgen. DO NOT EDIT.
+
+package sdk
+
+import (
+	"cmp"
+	"slices"
+)
+
+// DeepCopy returns a copy of a that shares no memory with it.
+func (a AuthorizedB2BDomain) DeepCopy() AuthorizedB2BDomain {
+	out := a
+	return out
+}
+
+// Equal reports whether a and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (a AuthorizedB2BDomain) Equal(other AuthorizedB2BDomain) bool {
+	if a.Domain != other.Domain {
+		return false
+	}
+	if a.SlugPattern != other.SlugPattern {
+		return false
+	}
+	return true
+}
+
+// Normalize puts a into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (a *AuthorizedB2BDomain) Normalize() {
+}
+
+// DeepCopy returns a copy of b that shares no memory with it.
+func (b B2BBasicConfig) DeepCopy() B2BBasicConfig {
+	out := b
+	if b.Domains != nil {
+		out.Domains = make([]AuthorizedB2BDomain, len(b.Domains))
+		for i := range b.Domains {
+			out.Domains[i] = b.Domains[i].DeepCopy()
+		}
+	}
+	if b.BundleIDs != nil {
+		out.BundleIDs = make([]string, len(b.BundleIDs))
+		copy(out.BundleIDs, b.BundleIDs)
+	}
+	return out
+}
+
+// Equal reports whether b and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (b B2BBasicConfig) Equal(other B2BBasicConfig) bool {
+	if b.Enabled != other.Enabled {
+		return false
+	}
+	if b.AllowSelfOnboarding != other.AllowSelfOnboarding {
+		return false
+	}
+	if b.EnableMemberPermissions != other.EnableMemberPermissions {
+		return false
+	}
+	if len(b.Domains) != len(other.Domains) {
+		return false
+	}
+	for i := range b.Domains {
+		if !b.Domains[i].Equal(other.Domains[i]) {
+			return false
+		}
+	}
+	if len(b.BundleIDs) != len(other.BundleIDs) {
+		return false
+	}
+	for i := range b.BundleIDs {
+		if b.BundleIDs[i] != other.BundleIDs[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Normalize puts b into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (b *B2BBasicConfig) Normalize() {
+	if len(b.Domains) == 0 {
+		b.Domains = nil
+	} else {
+		for i := range b.Domains {
+			b.Domains[i].Normalize()
+		}
+		slices.SortStableFunc(b.Domains, compareAuthorizedB2BDomain)
+	}
+	if len(b.BundleIDs) == 0 {
+		b.BundleIDs = nil
+	} else {
+		slices.Sort(b.BundleIDs)
+	}
+}
+
+// DeepCopy returns a copy of b that shares no memory with it.
+func (b B2BConfig) DeepCopy() B2BConfig {
+	out := b
+	if b.Basic != nil {
+		v := b.Basic.DeepCopy()
+		out.Basic = &v
+	}
+	if b.Sessions != nil {
+		v := b.Sessions.DeepCopy()
+		out.Sessions = &v
+	}
+	if b.MagicLinks != nil {
+		v := b.MagicLinks.DeepCopy()
+		out.MagicLinks = &v
+	}
+	if b.OAuth != nil {
+		v := b.OAuth.DeepCopy()
+		out.OAuth = &v
+	}
+	if b.TOTPs != nil {
+		v := b.TOTPs.DeepCopy()
+		out.TOTPs = &v
+	}
+	if b.SSO != nil {
+		v := b.SSO.DeepCopy()
+		out.SSO = &v
+	}
+	if b.OTPs != nil {
+		v := b.OTPs.DeepCopy()
+		out.OTPs = &v
+	}
+	if b.DFPPA != nil {
+		v := b.DFPPA.DeepCopy()
+		out.DFPPA = &v
+	}
+	if b.Passwords != nil {
+		v := b.Passwords.DeepCopy()
+		out.Passwords = &v
+	}
+	if b.Cookies != nil {
+		v := b.Cookies.DeepCopy()
+		out.Cookies = &v
+	}
+	if b.UserImpersonation != nil {
+		v := b.UserImpersonation.DeepCopy()
+		out.UserImpersonation = &v
+	}
+	return out
+}
+
+// Equal reports whether b and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (b B2BConfig) Equal(other B2BConfig) bool {
+	if (b.Basic == nil) != (other.Basic == nil) || (b.Basic != nil && !b.Basic.Equal(*other.Basic)) {
+		return false
+	}
+	if (b.Sessions == nil) != (other.Sessions == nil) || (b.Sessions != nil && !b.Sessions.Equal(*other.Sessions)) {
+		return false
+	}
+	if (b.MagicLinks == nil) != (other.MagicLinks == nil) || (b.MagicLinks != nil && !b.MagicLinks.Equal(*other.MagicLinks)) {
+		return false
+	}
+	if (b.OAuth == nil) != (other.OAuth == nil) || (b.OAuth != nil && !b.OAuth.Equal(*other.OAuth)) {
+		return false
+	}
+	if (b.TOTPs == nil) != (other.TOTPs == nil) || (b.TOTPs != nil && !b.TOTPs.Equal(*other.TOTPs)) {
+		return false
+	}
+	if (b.SSO == nil) != (other.SSO == nil) || (b.SSO != nil && !b.SSO.Equal(*other.SSO)) {
+		return false
+	}
+	if (b.OTPs == nil) != (other.OTPs == nil) || (b.OTPs != nil && !b.OTPs.Equal(*other.OTPs)) {
+		return false
+	}
+	if (b.DFPPA == nil) != (other.DFPPA == nil) || (b.DFPPA != nil && !b.DFPPA.Equal(*other.DFPPA)) {
+		return false
+	}
+	if (b.Passwords == nil) != (other.Passwords == nil) || (b.Passwords != nil && !b.Passwords.Equal(*other.Passwords)) {
+		return false
+	}
+	if (b.Cookies == nil) != (other.Cookies == nil) || (b.Cookies != nil && !b.Cookies.Equal(*other.Cookies)) {
+		return false
+	}
+	if (b.UserImpersonation == nil) != (other.UserImpersonation == nil) || (b.UserImpersonation != nil && !b.UserImpersonation.Equal(*other.UserImpersonation)) {
+		return false
+	}
+	return true
+}
+
+// Normalize puts b into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (b *B2BConfig) Normalize() {
+	if b.Basic != nil {
+		b.Basic.Normalize()
+	}
+	if b.Sessions != nil {
+		b.Sessions.Normalize()
+	}
+	if b.MagicLinks != nil {
+		b.MagicLinks.Normalize()
+	}
+	if b.OAuth != nil {
+		b.OAuth.Normalize()
+	}
+	if b.TOTPs != nil {
+		b.TOTPs.Normalize()
+	}
+	if b.SSO != nil {
+		b.SSO.Normalize()
+	}
+	if b.OTPs != nil {
+		b.OTPs.Normalize()
+	}
+	if b.DFPPA != nil {
+		b.DFPPA.Normalize()
+	}
+	if b.Passwords != nil {
+		b.Passwords.Normalize()
+	}
+	if b.Cookies != nil {
+		b.Cookies.Normalize()
+	}
+	if b.UserImpersonation != nil {
+		b.UserImpersonation.Normalize()
+	}
+}
+
+// DeepCopy returns a copy of b that shares no memory with it.
+func (b B2BCookiesConfig) DeepCopy() B2BCookiesConfig {
+	out := b
+	return out
+}
+
+// Equal reports whether b and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (b B2BCookiesConfig) Equal(other B2BCookiesConfig) bool {
+	if b.HTTPOnly != other.HTTPOnly {
+		return false
+	}
+	return true
+}
+
+// Normalize puts b into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (b *B2BCookiesConfig) Normalize() {
+}
+
+// DeepCopy returns a copy of b that shares no memory with it.
+func (b B2BDFPPAConfig) DeepCopy() B2BDFPPAConfig {
+	out := b
+	return out
+}
+
+// Equal reports whether b and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (b B2BDFPPAConfig) Equal(other B2BDFPPAConfig) bool {
+	if b.Enabled != other.Enabled {
+		return false
+	}
+	if b.OnChallenge != other.OnChallenge {
+		return false
+	}
+	return true
+}
+
+// Normalize puts b into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (b *B2BDFPPAConfig) Normalize() {
+}
+
+// DeepCopy returns a copy of b that shares no memory with it.
+func (b B2BMagicLinksConfig) DeepCopy() B2BMagicLinksConfig {
+	out := b
+	return out
+}
+
+// Equal reports whether b and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (b B2BMagicLinksConfig) Equal(other B2BMagicLinksConfig) bool {
+	if b.Enabled != other.Enabled {
+		return false
+	}
+	if b.PKCERequired != other.PKCERequired {
+		return false
+	}
+	return true
+}
+
+// Normalize puts b into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (b *B2BMagicLinksConfig) Normalize() {
+}
+
+// DeepCopy returns a copy of b that shares no memory with it.
+func (b B2BOAuthConfig) DeepCopy() B2BOAuthConfig {
+	out := b
+	return out
+}
+
+// Equal reports whether b and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (b B2BOAuthConfig) Equal(other B2BOAuthConfig) bool {
+	if b.Enabled != other.Enabled {
+		return false
+	}
+	if b.PKCERequired != other.PKCERequired {
+		return false
+	}
+	return true
+}
+
+// Normalize puts b into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (b *B2BOAuthConfig) Normalize() {
+}
+
+// DeepCopy returns a copy of b that shares no memory with it.
+func (b B2BOTPsConfig) DeepCopy() B2BOTPsConfig {
+	out := b
+	if b.SMSAutofillMetadata != nil {
+		out.SMSAutofillMetadata = make([]SMSAutofillMetadata, len(b.SMSAutofillMetadata))
+		for i := range b.SMSAutofillMetadata {
+			out.SMSAutofillMetadata[i] = b.SMSAutofillMetadata[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether b and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (b B2BOTPsConfig) Equal(other B2BOTPsConfig) bool {
+	if b.SMSEnabled != other.SMSEnabled {
+		return false
+	}
+	if len(b.SMSAutofillMetadata) != len(other.SMSAutofillMetadata) {
+		return false
+	}
+	for i := range b.SMSAutofillMetadata {
+		if !b.SMSAutofillMetadata[i].Equal(other.SMSAutofillMetadata[i]) {
+			return false
+		}
+	}
+	if b.EmailEnabled != other.EmailEnabled {
+		return false
+	}
+	return true
+}
+
+// Normalize puts b into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (b *B2BOTPsConfig) Normalize() {
+	if len(b.SMSAutofillMetadata) == 0 {
+		b.SMSAutofillMetadata = nil
+	} else {
+		for i := range b.SMSAutofillMetadata {
+			b.SMSAutofillMetadata[i].Normalize()
+		}
+		slices.SortStableFunc(b.SMSAutofillMetadata, compareSMSAutofillMetadata)
+	}
+}
+
+// DeepCopy returns a copy of b that shares no memory with it.
+func (b B2BPasswordsConfig) DeepCopy() B2BPasswordsConfig {
+	out := b
+	return out
+}
+
+// Equal reports whether b and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (b B2BPasswordsConfig) Equal(other B2BPasswordsConfig) bool {
+	if b.Enabled != other.Enabled {
+		return false
+	}
+	if b.PKCERequiredForPasswordResets != other.PKCERequiredForPasswordResets {
+		return false
+	}
+	return true
+}
+
+// Normalize puts b into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (b *B2BPasswordsConfig) Normalize() {
+}
+
+// DeepCopy returns a copy of b that shares no memory with it.
+func (b B2BSSOConfig) DeepCopy() B2BSSOConfig {
+	out := b
+	return out
+}
+
+// Equal reports whether b and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (b B2BSSOConfig) Equal(other B2BSSOConfig) bool {
+	if b.Enabled != other.Enabled {
+		return false
+	}
+	if b.PKCERequired != other.PKCERequired {
+		return false
+	}
+	return true
+}
+
+// Normalize puts b into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (b *B2BSSOConfig) Normalize() {
+}
+
+// DeepCopy returns a copy of b that shares no memory with it.
+func (b B2BSessionsConfig) DeepCopy() B2BSessionsConfig {
+	out := b
+	return out
+}
+
+// Equal reports whether b and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (b B2BSessionsConfig) Equal(other B2BSessionsConfig) bool {
+	if b.MaxSessionDurationMinutes != other.MaxSessionDurationMinutes {
+		return false
+	}
+	return true
+}
+
+// Normalize puts b into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (b *B2BSessionsConfig) Normalize() {
+}
+
+// DeepCopy returns a copy of b that shares no memory with it.
+func (b B2BTOTPsConfig) DeepCopy() B2BTOTPsConfig {
+	out := b
+	return out
+}
+
+// Equal reports whether b and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (b B2BTOTPsConfig) Equal(other B2BTOTPsConfig) bool {
+	if b.CreateTOTPs != other.CreateTOTPs {
+		return false
+	}
+	if b.Enabled != other.Enabled {
+		return false
+	}
+	return true
+}
+
+// Normalize puts b into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (b *B2BTOTPsConfig) Normalize() {
+}
+
+// DeepCopy returns a copy of b that shares no memory with it.
+func (b B2BUserImpersonationConfig) DeepCopy() B2BUserImpersonationConfig {
+	out := b
+	return out
+}
+
+// Equal reports whether b and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (b B2BUserImpersonationConfig) Equal(other B2BUserImpersonationConfig) bool {
+	if b.Enabled != other.Enabled {
+		return false
+	}
+	return true
+}
+
+// Normalize puts b into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (b *B2BUserImpersonationConfig) Normalize() {
+}
+
+// DeepCopy returns a copy of c that shares no memory with it.
+func (c ConsumerBasicConfig) DeepCopy() ConsumerBasicConfig {
+	out := c
+	if c.Domains != nil {
+		out.Domains = make([]string, len(c.Domains))
+		copy(out.Domains, c.Domains)
+	}
+	if c.BundleIDs != nil {
+		out.BundleIDs = make([]string, len(c.BundleIDs))
+		copy(out.BundleIDs, c.BundleIDs)
+	}
+	return out
+}
+
+// Equal reports whether c and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (c ConsumerBasicConfig) Equal(other ConsumerBasicConfig) bool {
+	if c.Enabled != other.Enabled {
+		return false
+	}
+	if len(c.Domains) != len(other.Domains) {
+		return false
+	}
+	for i := range c.Domains {
+		if c.Domains[i] != other.Domains[i] {
+			return false
+		}
+	}
+	if len(c.BundleIDs) != len(other.BundleIDs) {
+		return false
+	}
+	for i := range c.BundleIDs {
+		if c.BundleIDs[i] != other.BundleIDs[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Normalize puts c into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (c *ConsumerBasicConfig) Normalize() {
+	if len(c.Domains) == 0 {
+		c.Domains = nil
+	} else {
+		slices.Sort(c.Domains)
+	}
+	if len(c.BundleIDs) == 0 {
+		c.BundleIDs = nil
+	} else {
+		slices.Sort(c.BundleIDs)
+	}
+}
+
+// DeepCopy returns a copy of c that shares no memory with it.
+func (c ConsumerBiometricsConfig) DeepCopy() ConsumerBiometricsConfig {
+	out := c
+	return out
+}
+
+// Equal reports whether c and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (c ConsumerBiometricsConfig) Equal(other ConsumerBiometricsConfig) bool {
+	if c.CreateBiometricsEnabled != other.CreateBiometricsEnabled {
+		return false
+	}
+	if c.Enabled != other.Enabled {
+		return false
+	}
+	return true
+}
+
+// Normalize puts c into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (c *ConsumerBiometricsConfig) Normalize() {
+}
+
+// DeepCopy returns a copy of c that shares no memory with it.
+func (c ConsumerConfig) DeepCopy() ConsumerConfig {
+	out := c
+	if c.Basic != nil {
+		v := c.Basic.DeepCopy()
+		out.Basic = &v
+	}
+	if c.Sessions != nil {
+		v := c.Sessions.DeepCopy()
+		out.Sessions = &v
+	}
+	if c.MagicLinks != nil {
+		v := c.MagicLinks.DeepCopy()
+		out.MagicLinks = &v
+	}
+	if c.OTPs != nil {
+		v := c.OTPs.DeepCopy()
+		out.OTPs = &v
+	}
+	if c.OAuth != nil {
+		v := c.OAuth.DeepCopy()
+		out.OAuth = &v
+	}
+	if c.TOTPs != nil {
+		v := c.TOTPs.DeepCopy()
+		out.TOTPs = &v
+	}
+	if c.WebAuthn != nil {
+		v := c.WebAuthn.DeepCopy()
+		out.WebAuthn = &v
+	}
+	if c.CryptoWallets != nil {
+		v := c.CryptoWallets.DeepCopy()
+		out.CryptoWallets = &v
+	}
+	if c.DFPPA != nil {
+		v := c.DFPPA.DeepCopy()
+		out.DFPPA = &v
+	}
+	if c.Biometrics != nil {
+		v := c.Biometrics.DeepCopy()
+		out.Biometrics = &v
+	}
+	if c.Passwords != nil {
+		v := c.Passwords.DeepCopy()
+		out.Passwords = &v
+	}
+	if c.Cookies != nil {
+		v := c.Cookies.DeepCopy()
+		out.Cookies = &v
+	}
+	if c.UserImpersonation != nil {
+		v := c.UserImpersonation.DeepCopy()
+		out.UserImpersonation = &v
+	}
+	return out
+}
+
+// Equal reports whether c and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (c ConsumerConfig) Equal(other ConsumerConfig) bool {
+	if (c.Basic == nil) != (other.Basic == nil) || (c.Basic != nil && !c.Basic.Equal(*other.Basic)) {
+		return false
+	}
+	if (c.Sessions == nil) != (other.Sessions == nil) || (c.Sessions != nil && !c.Sessions.Equal(*other.Sessions)) {
+		return false
+	}
+	if (c.MagicLinks == nil) != (other.MagicLinks == nil) || (c.MagicLinks != nil && !c.MagicLinks.Equal(*other.MagicLinks)) {
+		return false
+	}
+	if (c.OTPs == nil) != (other.OTPs == nil) || (c.OTPs != nil && !c.OTPs.Equal(*other.OTPs)) {
+		return false
+	}
+	if (c.OAuth == nil) != (other.OAuth == nil) || (c.OAuth != nil && !c.OAuth.Equal(*other.OAuth)) {
+		return false
+	}
+	if (c.TOTPs == nil) != (other.TOTPs == nil) || (c.TOTPs != nil && !c.TOTPs.Equal(*other.TOTPs)) {
+		return false
+	}
+	if (c.WebAuthn == nil) != (other.WebAuthn == nil) || (c.WebAuthn != nil && !c.WebAuthn.Equal(*other.WebAuthn)) {
+		return false
+	}
+	if (c.CryptoWallets == nil) != (other.CryptoWallets == nil) || (c.CryptoWallets != nil && !c.CryptoWallets.Equal(*other.CryptoWallets)) {
+		return false
+	}
+	if (c.DFPPA == nil) != (other.DFPPA == nil) || (c.DFPPA != nil && !c.DFPPA.Equal(*other.DFPPA)) {
+		return false
+	}
+	if (c.Biometrics == nil) != (other.Biometrics == nil) || (c.Biometrics != nil && !c.Biometrics.Equal(*other.Biometrics)) {
+		return false
+	}
+	if (c.Passwords == nil) != (other.Passwords == nil) || (c.Passwords != nil && !c.Passwords.Equal(*other.Passwords)) {
+		return false
+	}
+	if (c.Cookies == nil) != (other.Cookies == nil) || (c.Cookies != nil && !c.Cookies.Equal(*other.Cookies)) {
+		return false
+	}
+	if (c.UserImpersonation == nil) != (other.UserImpersonation == nil) || (c.UserImpersonation != nil && !c.UserImpersonation.Equal(*other.UserImpersonation)) {
+		return false
+	}
+	return true
+}
+
+// Normalize puts c into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (c *ConsumerConfig) Normalize() {
+	if c.Basic != nil {
+		c.Basic.Normalize()
+	}
+	if c.Sessions != nil {
+		c.Sessions.Normalize()
+	}
+	if c.MagicLinks != nil {
+		c.MagicLinks.Normalize()
+	}
+	if c.OTPs != nil {
+		c.OTPs.Normalize()
+	}
+	if c.OAuth != nil {
+		c.OAuth.Normalize()
+	}
+	if c.TOTPs != nil {
+		c.TOTPs.Normalize()
+	}
+	if c.WebAuthn != nil {
+		c.WebAuthn.Normalize()
+	}
+	if c.CryptoWallets != nil {
+		c.CryptoWallets.Normalize()
+	}
+	if c.DFPPA != nil {
+		c.DFPPA.Normalize()
+	}
+	if c.Biometrics != nil {
+		c.Biometrics.Normalize()
+	}
+	if c.Passwords != nil {
+		c.Passwords.Normalize()
+	}
+	if c.Cookies != nil {
+		c.Cookies.Normalize()
+	}
+	if c.UserImpersonation != nil {
+		c.UserImpersonation.Normalize()
+	}
+}
+
+// DeepCopy returns a copy of c that shares no memory with it.
+func (c ConsumerCookiesConfig) DeepCopy() ConsumerCookiesConfig {
+	out := c
+	return out
+}
+
+// Equal reports whether c and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (c ConsumerCookiesConfig) Equal(other ConsumerCookiesConfig) bool {
+	if c.HTTPOnly != other.HTTPOnly {
+		return false
+	}
+	return true
+}
+
+// Normalize puts c into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (c *ConsumerCookiesConfig) Normalize() {
+}
+
+// DeepCopy returns a copy of c that shares no memory with it.
+func (c ConsumerCryptoWalletsConfig) DeepCopy() ConsumerCryptoWalletsConfig {
+	out := c
+	return out
+}
+
+// Equal reports whether c and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (c ConsumerCryptoWalletsConfig) Equal(other ConsumerCryptoWalletsConfig) bool {
+	if c.Enabled != other.Enabled {
+		return false
+	}
+	if c.SIWERequired != other.SIWERequired {
+		return false
+	}
+	return true
+}
+
+// Normalize puts c into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (c *ConsumerCryptoWalletsConfig) Normalize() {
+}
+
+// DeepCopy returns a copy of c that shares no memory with it.
+func (c ConsumerDFPPAConfig) DeepCopy() ConsumerDFPPAConfig {
+	out := c
+	return out
+}
+
+// Equal reports whether c and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (c ConsumerDFPPAConfig) Equal(other ConsumerDFPPAConfig) bool {
+	if c.Enabled != other.Enabled {
+		return false
+	}
+	if c.OnChallenge != other.OnChallenge {
+		return false
+	}
+	return true
+}
+
+// Normalize puts c into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (c *ConsumerDFPPAConfig) Normalize() {
+}
+
+// DeepCopy returns a copy of c that shares no memory with it.
+func (c ConsumerMagicLinksConfig) DeepCopy() ConsumerMagicLinksConfig {
+	out := c
+	return out
+}
+
+// Equal reports whether c and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (c ConsumerMagicLinksConfig) Equal(other ConsumerMagicLinksConfig) bool {
+	if c.LoginOrCreateEnabled != other.LoginOrCreateEnabled {
+		return false
+	}
+	if c.SendEnabled != other.SendEnabled {
+		return false
+	}
+	if c.PKCERequired != other.PKCERequired {
+		return false
+	}
+	return true
+}
+
+// Normalize puts c into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (c *ConsumerMagicLinksConfig) Normalize() {
+}
+
+// DeepCopy returns a copy of c that shares no memory with it.
+func (c ConsumerOAuthConfig) DeepCopy() ConsumerOAuthConfig {
+	out := c
+	return out
+}
+
+// Equal reports whether c and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (c ConsumerOAuthConfig) Equal(other ConsumerOAuthConfig) bool {
+	if c.Enabled != other.Enabled {
+		return false
+	}
+	if c.PKCERequired != other.PKCERequired {
+		return false
+	}
+	return true
+}
+
+// Normalize puts c into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (c *ConsumerOAuthConfig) Normalize() {
+}
+
+// DeepCopy returns a copy of c that shares no memory with it.
+func (c ConsumerOTPsConfig) DeepCopy() ConsumerOTPsConfig {
+	out := c
+	if c.SMSAutofillMetadata != nil {
+		out.SMSAutofillMetadata = make([]SMSAutofillMetadata, len(c.SMSAutofillMetadata))
+		for i := range c.SMSAutofillMetadata {
+			out.SMSAutofillMetadata[i] = c.SMSAutofillMetadata[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether c and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (c ConsumerOTPsConfig) Equal(other ConsumerOTPsConfig) bool {
+	if c.SMSLoginOrCreateEnabled != other.SMSLoginOrCreateEnabled {
+		return false
+	}
+	if c.WhatsAppLoginOrCreateEnabled != other.WhatsAppLoginOrCreateEnabled {
+		return false
+	}
+	if c.EmailLoginOrCreateEnabled != other.EmailLoginOrCreateEnabled {
+		return false
+	}
+	if c.SMSSendEnabled != other.SMSSendEnabled {
+		return false
+	}
+	if c.WhatsAppSendEnabled != other.WhatsAppSendEnabled {
+		return false
+	}
+	if c.EmailSendEnabled != other.EmailSendEnabled {
+		return false
+	}
+	if len(c.SMSAutofillMetadata) != len(other.SMSAutofillMetadata) {
+		return false
+	}
+	for i := range c.SMSAutofillMetadata {
+		if !c.SMSAutofillMetadata[i].Equal(other.SMSAutofillMetadata[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Normalize puts c into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (c *ConsumerOTPsConfig) Normalize() {
+	if len(c.SMSAutofillMetadata) == 0 {
+		c.SMSAutofillMetadata = nil
+	} else {
+		for i := range c.SMSAutofillMetadata {
+			c.SMSAutofillMetadata[i].Normalize()
+		}
+		slices.SortStableFunc(c.SMSAutofillMetadata, compareSMSAutofillMetadata)
+	}
+}
+
+// DeepCopy returns a copy of c that shares no memory with it.
+func (c ConsumerPasswordsConfig) DeepCopy() ConsumerPasswordsConfig {
+	out := c
+	return out
+}
+
+// Equal reports whether c and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (c ConsumerPasswordsConfig) Equal(other ConsumerPasswordsConfig) bool {
+	if c.Enabled != other.Enabled {
+		return false
+	}
+	if c.PKCERequiredForPasswordResets != other.PKCERequiredForPasswordResets {
+		return false
+	}
+	return true
+}
+
+// Normalize puts c into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (c *ConsumerPasswordsConfig) Normalize() {
+}
+
+// DeepCopy returns a copy of c that shares no memory with it.
+func (c ConsumerSessionsConfig) DeepCopy() ConsumerSessionsConfig {
+	out := c
+	return out
+}
+
+// Equal reports whether c and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (c ConsumerSessionsConfig) Equal(other ConsumerSessionsConfig) bool {
+	if c.MaxSessionDurationMinutes != other.MaxSessionDurationMinutes {
+		return false
+	}
+	return true
+}
+
+// Normalize puts c into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (c *ConsumerSessionsConfig) Normalize() {
+}
+
+// DeepCopy returns a copy of c that shares no memory with it.
+func (c ConsumerTOTPsConfig) DeepCopy() ConsumerTOTPsConfig {
+	out := c
+	return out
+}
+
+// Equal reports whether c and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (c ConsumerTOTPsConfig) Equal(other ConsumerTOTPsConfig) bool {
+	if c.CreateTOTPs != other.CreateTOTPs {
+		return false
+	}
+	if c.Enabled != other.Enabled {
+		return false
+	}
+	return true
+}
+
+// Normalize puts c into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (c *ConsumerTOTPsConfig) Normalize() {
+}
+
+// DeepCopy returns a copy of c that shares no memory with it.
+func (c ConsumerUserImpersonationConfig) DeepCopy() ConsumerUserImpersonationConfig {
+	out := c
+	return out
+}
+
+// Equal reports whether c and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (c ConsumerUserImpersonationConfig) Equal(other ConsumerUserImpersonationConfig) bool {
+	if c.Enabled != other.Enabled {
+		return false
+	}
+	return true
+}
+
+// Normalize puts c into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (c *ConsumerUserImpersonationConfig) Normalize() {
+}
+
+// DeepCopy returns a copy of c that shares no memory with it.
+func (c ConsumerWebAuthnConfig) DeepCopy() ConsumerWebAuthnConfig {
+	out := c
+	return out
+}
+
+// Equal reports whether c and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (c ConsumerWebAuthnConfig) Equal(other ConsumerWebAuthnConfig) bool {
+	if c.CreateWebAuthns != other.CreateWebAuthns {
+		return false
+	}
+	if c.Enabled != other.Enabled {
+		return false
+	}
+	return true
+}
+
+// Normalize puts c into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (c *ConsumerWebAuthnConfig) Normalize() {
+}
+
+// DeepCopy returns a copy of s that shares no memory with it.
+func (s SMSAutofillMetadata) DeepCopy() SMSAutofillMetadata {
+	out := s
+	return out
+}
+
+// Equal reports whether s and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (s SMSAutofillMetadata) Equal(other SMSAutofillMetadata) bool {
+	if s.MetadataType != other.MetadataType {
+		return false
+	}
+	if s.MetadataValue != other.MetadataValue {
+		return false
+	}
+	if s.BundleID != other.BundleID {
+		return false
+	}
+	return true
+}
+
+// Normalize puts s into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (s *SMSAutofillMetadata) Normalize() {
+}
+
+func compareAuthorizedB2BDomain(a, b AuthorizedB2BDomain) int {
+	if c := cmp.Compare(a.Domain, b.Domain); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.SlugPattern, b.SlugPattern)
+}
+
+func compareSMSAutofillMetadata(a, b SMSAutofillMetadata) int {
+	if c := cmp.Compare(a.MetadataType, b.MetadataType); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(a.MetadataValue, b.MetadataValue); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.BundleID, b.BundleID)
+}
@@ -0,0 +1,65 @@
+package secrets
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/internal/redact"
+)
+
+// secret and createResponse have the same fields as the exported types but none of their methods,
+// so they can be handed back to the fmt package without recursing into Format.
+type (
+	secret         Secret
+	createResponse CreateResponse
+)
+
+// Reveal returns the full secret value. Formatting a Secret with the fmt package or logging it with
+// log/slog masks the value, so calls to Reveal mark the places where the value is used on purpose.
+func (s Secret) Reveal() string {
+	return s.Secret
+}
+
+func (s Secret) masked() secret {
+	m := secret(s)
+	m.Secret = redact.Mask(s.Secret)
+	return m
+}
+
+// Format implements fmt.Formatter. The secret value is masked for every verb.
+func (s Secret) Format(f fmt.State, verb rune) {
+	redact.Format(f, verb, s.masked())
+}
+
+// String implements fmt.Stringer. The secret value is masked.
+func (s Secret) String() string {
+	return fmt.Sprint(s.masked())
+}
+
+// LogValue implements slog.LogValuer. The secret value is masked.
+func (s Secret) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("secret_id", s.SecretID),
+		redact.String("secret", s.Secret),
+		slog.Time("created_at", s.CreatedAt),
+	)
+}
+
+// Format implements fmt.Formatter. The secret value is masked for every verb.
+func (r CreateResponse) Format(f fmt.State, verb rune) {
+	redact.Format(f, verb, createResponse(r))
+}
+
+// String implements fmt.Stringer. The secret value is masked.
+func (r CreateResponse) String() string {
+	return fmt.Sprint(createResponse(r))
+}
+
+// LogValue implements slog.LogValuer. The secret value is masked.
+func (r CreateResponse) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("request_id", r.RequestID),
+		slog.Attr{Key: "secret", Value: r.Secret.LogValue()},
+		slog.Int("status_code", r.StatusCode),
+	)
+}
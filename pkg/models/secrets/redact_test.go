@@ -0,0 +1,48 @@
+package secrets_test
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/secrets"
+)
+
+const secretValue = "secret-test-0123456789abcdef"
+
+func TestSecret_Redaction(t *testing.T) {
+	s := secrets.Secret{
+		SecretID:  "secret-test-1234",
+		Secret:    secretValue,
+		CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+	resp := secrets.CreateResponse{RequestID: "request-id-test-1234", Secret: s, StatusCode: 200}
+
+	t.Run("fmt verbs", func(t *testing.T) {
+		for _, verb := range []string{"%v", "%+v", "%#v", "%s", "%q"} {
+			for _, v := range []any{s, &s, resp, &resp} {
+				out := fmt.Sprintf(verb, v)
+				assert.NotContains(t, out, secretValue, "verb %s", verb)
+				assert.Contains(t, out, "...cdef", "verb %s", verb)
+			}
+		}
+		assert.NotContains(t, s.String(), secretValue)
+		assert.NotContains(t, resp.String(), secretValue)
+	})
+
+	t.Run("slog JSON", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger := slog.New(slog.NewJSONHandler(&buf, nil))
+		logger.Info("created", "secret", s, "response", resp)
+		assert.NotContains(t, buf.String(), secretValue)
+		assert.Contains(t, buf.String(), `"secret_id":"secret-test-1234"`)
+	})
+
+	t.Run("reveal", func(t *testing.T) {
+		assert.Equal(t, secretValue, s.Reveal())
+		assert.Equal(t, secretValue, resp.Secret.Reveal())
+	})
+}
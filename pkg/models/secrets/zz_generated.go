@@ -0,0 +1,62 @@
+// Code generated by modelgen. DO NOT EDIT.
+
+package secrets
+
+// DeepCopy returns a copy of m that shares no memory with it.
+func (m MaskedSecret) DeepCopy() MaskedSecret {
+	out := m
+	return out
+}
+
+// Equal reports whether m and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (m MaskedSecret) Equal(other MaskedSecret) bool {
+	if m.SecretID != other.SecretID {
+		return false
+	}
+	if m.LastFour != other.LastFour {
+		return false
+	}
+	if !m.CreatedAt.Equal(other.CreatedAt) {
+		return false
+	}
+	if !m.UsedAt.Equal(other.UsedAt) {
+		return false
+	}
+	return true
+}
+
+// Normalize puts m into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (m *MaskedSecret) Normalize() {
+}
+
+// DeepCopy returns a copy of s that shares no memory with it.
+func (s Secret) DeepCopy() Secret {
+	out := s
+	return out
+}
+
+// Equal reports whether s and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (s Secret) Equal(other Secret) bool {
+	if s.SecretID != other.SecretID {
+		return false
+	}
+	if s.Secret != other.Secret {
+		return false
+	}
+	if !s.CreatedAt.Equal(other.CreatedAt) {
+		return false
+	}
+	return true
+}
+
+// Normalize puts s into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (s *Secret) Normalize() {
+}
@@ -0,0 +1,113 @@
+// Code generated by modelgen. DO NOT EDIT.
+
+package trustedtokenprofiles
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/internal/modelutil"
+)
+
+// DeepCopy returns a copy of p that shares no memory with it.
+func (p PEMFile) DeepCopy() PEMFile {
+	out := p
+	return out
+}
+
+// Equal reports whether p and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (p PEMFile) Equal(other PEMFile) bool {
+	if p.PEMFileID != other.PEMFileID {
+		return false
+	}
+	if p.PublicKey != other.PublicKey {
+		return false
+	}
+	return true
+}
+
+// Normalize puts p into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (p *PEMFile) Normalize() {
+}
+
+// DeepCopy returns a copy of t that shares no memory with it.
+func (t TrustedTokenProfile) DeepCopy() TrustedTokenProfile {
+	out := t
+	if t.PEMFiles != nil {
+		out.PEMFiles = make([]PEMFile, len(t.PEMFiles))
+		for i := range t.PEMFiles {
+			out.PEMFiles[i] = t.PEMFiles[i].DeepCopy()
+		}
+	}
+	if t.JWKSURL != nil {
+		v := *t.JWKSURL
+		out.JWKSURL = &v
+	}
+	out.AttributeMapping = modelutil.CopyMap(t.AttributeMapping)
+	return out
+}
+
+// Equal reports whether t and other hold the same values. Nil and empty lists are considered
+// equal. The order of lists is significant; call Normalize on both values first to compare lists
+// regardless of order.
+func (t TrustedTokenProfile) Equal(other TrustedTokenProfile) bool {
+	if t.ProfileID != other.ProfileID {
+		return false
+	}
+	if t.Name != other.Name {
+		return false
+	}
+	if t.Audience != other.Audience {
+		return false
+	}
+	if t.Issuer != other.Issuer {
+		return false
+	}
+	if len(t.PEMFiles) != len(other.PEMFiles) {
+		return false
+	}
+	for i := range t.PEMFiles {
+		if !t.PEMFiles[i].Equal(other.PEMFiles[i]) {
+			return false
+		}
+	}
+	if t.CanJITProvision != other.CanJITProvision {
+		return false
+	}
+	if (t.JWKSURL == nil) != (other.JWKSURL == nil) || (t.JWKSURL != nil && *t.JWKSURL != *other.JWKSURL) {
+		return false
+	}
+	if !modelutil.EqualMap(t.AttributeMapping, other.AttributeMapping) {
+		return false
+	}
+	if t.PublicKeyType != other.PublicKeyType {
+		return false
+	}
+	return true
+}
+
+// Normalize puts t into a canonical form in place: lists are sorted by their natural key and
+// empty lists and maps are replaced with nil. Two values that differ only in list order or in
+// nil versus empty lists are Equal after normalization.
+func (t *TrustedTokenProfile) Normalize() {
+	if len(t.PEMFiles) == 0 {
+		t.PEMFiles = nil
+	} else {
+		for i := range t.PEMFiles {
+			t.PEMFiles[i].Normalize()
+		}
+		slices.SortStableFunc(t.PEMFiles, comparePEMFile)
+	}
+	t.AttributeMapping = modelutil.NormalizeMap(t.AttributeMapping)
+}
+
+func comparePEMFile(a, b PEMFile) int {
+	if c := cmp.Compare(a.PEMFileID, b.PEMFileID); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.PublicKey, b.PublicKey)
+}
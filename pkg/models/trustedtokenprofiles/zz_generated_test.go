@@ -0,0 +1,48 @@
+package trustedtokenprofiles_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/trustedtokenprofiles"
+)
+
+func TestTrustedTokenProfile_AttributeMapping(t *testing.T) {
+	mapping := map[string]any{
+		"email":  "user.email",
+		"nested": map[string]any{"max_age": 60, "claims": []any{"a", "b"}},
+	}
+	profile := trustedtokenprofiles.TrustedTokenProfile{
+		ProfileID:        "profile-test-1234",
+		AttributeMapping: &mapping,
+	}
+
+	t.Run("deep copy shares no memory", func(t *testing.T) {
+		cp := profile.DeepCopy()
+		(*cp.AttributeMapping)["nested"].(map[string]any)["max_age"] = 120
+
+		assert.Equal(t, 60, mapping["nested"].(map[string]any)["max_age"])
+		assert.False(t, profile.Equal(cp))
+	})
+
+	t.Run("equal compares numbers by value", func(t *testing.T) {
+		// The same mapping as decoded from a JSON response.
+		decoded := map[string]any{
+			"email":  "user.email",
+			"nested": map[string]any{"max_age": float64(60), "claims": []any{"a", "b"}},
+		}
+		other := profile
+		other.AttributeMapping = &decoded
+		assert.True(t, profile.Equal(other))
+	})
+
+	t.Run("normalize drops empty mappings", func(t *testing.T) {
+		empty := map[string]any{}
+		p := trustedtokenprofiles.TrustedTokenProfile{AttributeMapping: &empty, PEMFiles: []trustedtokenprofiles.PEMFile{}}
+		assert.True(t, p.Equal(trustedtokenprofiles.TrustedTokenProfile{}))
+
+		p.Normalize()
+		assert.Nil(t, p.AttributeMapping)
+		assert.Nil(t, p.PEMFiles)
+	})
+}
@@ -0,0 +1,222 @@
+package overlay
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Directive is the key of the patch directive in an overlay object. "$patch: delete" in an element
+// of a keyed list removes the element with the same key from the base; "$patch: replace" in an
+// object replaces the base object instead of merging into it, and as an element of its own in a
+// keyed list replaces the base list.
+const Directive = "$patch"
+
+// mergeKeys maps the names of list fields to the field that identifies their elements. Lists of
+// objects that are not listed here, and lists of scalars, are replaced by the overlay as a whole.
+var mergeKeys = map[string]string{
+	"custom_resources":       "resource_id",
+	"custom_roles":           "role_id",
+	"custom_scopes":          "scope",
+	"domains":                "domain",
+	"email_templates":        "template_id",
+	"environments":           "settings.environment_slug",
+	"event_log_streaming":    "destination_type",
+	"jwt_templates":          "jwt_template_type",
+	"pem_files":              "public_key",
+	"permissions":            "resource_id",
+	"redirect_urls":          "url",
+	"trusted_token_profiles": "name",
+	"valid_types":            "type",
+}
+
+// MergeKey returns the field that identifies the elements of the list field with the given name,
+// such as "url" for "redirect_urls", or "" if the list is replaced as a whole.
+func MergeKey(field string) string {
+	return mergeKeys[field]
+}
+
+// Merge applies an overlay to a base document, both as decoded from YAML or JSON, and returns the
+// result. Neither argument is modified.
+//
+// Objects are merged field by field, and a null field in the overlay removes the field from the
+// base. Lists with a merge key are merged element by element: an overlay element replaces or is
+// merged into the base element with the same key, and new elements are appended. All other values
+// in the overlay replace those in the base.
+func Merge(base, overlay any) (any, error) {
+	return merge("", "", base, overlay)
+}
+
+func merge(path, field string, base, overlay any) (any, error) {
+	switch o := overlay.(type) {
+	case map[string]any:
+		b, ok := base.(map[string]any)
+		if !ok || o[Directive] == "replace" {
+			return withoutDirective(path, o)
+		}
+		if d, ok := o[Directive]; ok && d != "replace" {
+			return nil, fmt.Errorf("%s: unsupported %s directive %v", orRoot(path), Directive, d)
+		}
+		out := make(map[string]any, len(b)+len(o))
+		for k, v := range b {
+			out[k] = v
+		}
+		for k, v := range o {
+			if v == nil {
+				delete(out, k)
+				continue
+			}
+			merged, err := merge(join(path, k), k, b[k], v)
+			if err != nil {
+				return nil, err
+			}
+			out[k] = merged
+		}
+		return out, nil
+	case []any:
+		b, _ := base.([]any)
+		if key := mergeKeys[field]; key != "" && objects(b) && objects(o) {
+			return mergeList(path, key, b, o)
+		}
+		return clean(path, o)
+	}
+	return overlay, nil
+}
+
+// mergeList merges two lists of objects by the given key.
+func mergeList(path, key string, base, overlay []any) (any, error) {
+	for i, e := range overlay {
+		if obj := e.(map[string]any); len(obj) == 1 && obj[Directive] == "replace" {
+			return clean(path, append(overlay[:i:i], overlay[i+1:]...))
+		}
+	}
+	out := make([]any, 0, len(base)+len(overlay))
+	index := map[string]int{}
+	for i, e := range base {
+		k, err := keyOf(fmt.Sprintf("%s[%d]", path, i), key, e)
+		if err != nil {
+			return nil, err
+		}
+		index[k] = len(out)
+		out = append(out, e)
+	}
+	deleted := map[int]bool{}
+	for i, e := range overlay {
+		elemPath := fmt.Sprintf("%s[%d]", path, i)
+		k, err := keyOf(elemPath, key, e)
+		if err != nil {
+			return nil, err
+		}
+		obj := e.(map[string]any)
+		j, exists := index[k]
+		if obj[Directive] == "delete" {
+			if exists {
+				deleted[j] = true
+			}
+			continue
+		}
+		if !exists {
+			v, err := withoutDirective(elemPath, obj)
+			if err != nil {
+				return nil, err
+			}
+			index[k] = len(out)
+			out = append(out, v)
+			continue
+		}
+		merged, err := merge(elemPath, "", out[j], obj)
+		if err != nil {
+			return nil, err
+		}
+		out[j] = merged
+	}
+	kept := out[:0]
+	for i, e := range out {
+		if !deleted[i] {
+			kept = append(kept, e)
+		}
+	}
+	return kept, nil
+}
+
+// keyOf returns the value of a merge key, which may be a dotted path, in a list element.
+func keyOf(path, key string, elem any) (string, error) {
+	v := elem
+	for _, part := range strings.Split(key, ".") {
+		obj, ok := v.(map[string]any)
+		if !ok {
+			v = nil
+			break
+		}
+		v = obj[part]
+	}
+	if v == nil {
+		return "", fmt.Errorf("%s: missing merge key %s", path, key)
+	}
+	return fmt.Sprint(v), nil
+}
+
+// withoutDirective returns an object with its "$patch: replace" directive removed, and directives
+// removed from everything nested in it.
+func withoutDirective(path string, o map[string]any) (any, error) {
+	if d, ok := o[Directive]; ok && d != "replace" {
+		return nil, fmt.Errorf("%s: unsupported %s directive %v", orRoot(path), Directive, d)
+	}
+	out := make(map[string]any, len(o))
+	for k, v := range o {
+		if k == Directive {
+			continue
+		}
+		c, err := clean(join(path, k), v)
+		if err != nil {
+			return nil, err
+		}
+		out[k] = c
+	}
+	return out, nil
+}
+
+// clean removes "$patch: replace" directives from a value that is not merged into anything.
+func clean(path string, v any) (any, error) {
+	switch v := v.(type) {
+	case map[string]any:
+		return withoutDirective(path, v)
+	case []any:
+		out := make([]any, len(v))
+		for i, e := range v {
+			elemPath := fmt.Sprintf("%s[%d]", path, i)
+			if obj, ok := e.(map[string]any); ok && obj[Directive] == "delete" {
+				return nil, fmt.Errorf("%s: nothing to delete", elemPath)
+			}
+			c, err := clean(elemPath, e)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = c
+		}
+		return out, nil
+	}
+	return v, nil
+}
+
+func objects(list []any) bool {
+	for _, e := range list {
+		if _, ok := e.(map[string]any); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func join(path, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
+
+func orRoot(path string) string {
+	if path == "" {
+		return "(root)"
+	}
+	return path
+}
@@ -0,0 +1,263 @@
+// Package overlay renders the desired state of a project's environments from a shared base
+// document and a small overlay per environment, so that configuration that environments have in
+// common is written once.
+//
+// A configuration directory holds three kinds of files:
+//
+//	base.yaml                 a snapshot document. Its one environment, if any, is the
+//	                          configuration shared by every environment.
+//	overlays/<env slug>.yaml  the differences of an environment, as an environment document with
+//	                          the fields of snapshot.Environment.
+//	vars.yaml                 optional values for ${VAR} references.
+//
+// Overlays are applied with a strategic merge: objects are merged field by field, and lists of
+// resources with a natural key, such as redirect URLs by URL, roles by role ID and SDK domains by
+// domain, are merged element by element. See Merge for the details and Directive for removing
+// elements. Variables are substituted in every file before it is parsed; see Substitute.
+//
+//	config, err := overlay.Load("stytch")
+//	desired, err := config.Render("production")
+//	plan, err := reconcile.NewPlan(ctx, client, desired)
+package overlay
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/snapshot"
+	"gopkg.in/yaml.v3"
+)
+
+// EnvironmentVar is the variable set to the slug of the environment being rendered. It takes
+// precedence over every other source of variables.
+const EnvironmentVar = "ENVIRONMENT_SLUG"
+
+// File is the name and content of a configuration file. The name is used in error messages.
+type File struct {
+	Name string
+	Data []byte
+}
+
+// Config is a base document with per-environment overlays.
+type Config struct {
+	Base File
+	// Overlays are keyed by environment slug.
+	Overlays map[string]File
+	// Vars are the values of variables referenced in the files.
+	Vars Vars
+	// LookupEnv returns the value of a variable from the process environment, which takes
+	// precedence over Vars. It defaults to os.LookupEnv; set it to a function that returns false
+	// to use Vars alone.
+	LookupEnv func(name string) (string, bool)
+}
+
+// Load reads a configuration directory laid out as described in the package documentation.
+func Load(dir string) (*Config, error) {
+	base, err := readFile(filepath.Join(dir, "base.yaml"))
+	if err != nil {
+		return nil, err
+	}
+	c := &Config{Base: base, Overlays: map[string]File{}}
+	paths, err := filepath.Glob(filepath.Join(dir, "overlays", "*.yaml"))
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range paths {
+		f, err := readFile(path)
+		if err != nil {
+			return nil, err
+		}
+		c.Overlays[strings.TrimSuffix(filepath.Base(path), ".yaml")] = f
+	}
+	varsPath := filepath.Join(dir, "vars.yaml")
+	if _, err := os.Stat(varsPath); err == nil {
+		if c.Vars, err = ReadVarsFile(varsPath); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+func readFile(path string) (File, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return File{}, err
+	}
+	return File{Name: path, Data: data}, nil
+}
+
+// Environments returns the slugs of the environments that have an overlay, sorted.
+func (c *Config) Environments() []string {
+	slugs := make([]string, 0, len(c.Overlays))
+	for slug := range c.Overlays {
+		slugs = append(slugs, slug)
+	}
+	sort.Strings(slugs)
+	return slugs
+}
+
+// Render returns the desired state of the given environments, or of every environment that has an
+// overlay if none are given. An environment without an overlay gets the base configuration alone.
+// The result holds the project-level configuration of the base and can be passed to
+// reconcile.NewPlan, which leaves environments that are not in it alone.
+//
+// The base is rendered once per environment, so its project-level configuration, everything but
+// its environment, must come out the same for every environment, whichever ones are rendered: an
+// error is returned if it depends on ${ENVIRONMENT_SLUG} across the given environments and those
+// that have an overlay.
+func (c *Config) Render(envSlugs ...string) (*snapshot.Snapshot, error) {
+	if len(envSlugs) == 0 {
+		envSlugs = c.Environments()
+	}
+	var (
+		doc     map[string]any
+		docSlug string
+		envs    []any
+	)
+	// project records the project-level configuration the base has for an environment, checking
+	// that it is the one the other environments got.
+	project := func(slug string, base map[string]any) error {
+		p := maps.Clone(base)
+		delete(p, "environments")
+		if doc == nil {
+			doc, docSlug = p, slug
+			return nil
+		}
+		if !reflect.DeepEqual(doc, p) {
+			return fmt.Errorf("%s: the project-level configuration differs between environments %s and %s; "+
+				"use ${%s} only in the environment", c.Base.Name, docSlug, slug, EnvironmentVar)
+		}
+		return nil
+	}
+	for _, slug := range envSlugs {
+		base, err := c.parse(c.Base, slug)
+		if err != nil {
+			return nil, err
+		}
+		if err := project(slug, base); err != nil {
+			return nil, err
+		}
+		shared, err := sharedEnvironment(c.Base.Name, base)
+		if err != nil {
+			return nil, err
+		}
+		env := shared
+		if f, ok := c.Overlays[slug]; ok {
+			patch, err := c.parse(f, slug)
+			if err != nil {
+				return nil, err
+			}
+			if env, err = Merge(shared, patch); err != nil {
+				return nil, fmt.Errorf("%s: %w", f.Name, err)
+			}
+		}
+		if err := setSlug(env, slug); err != nil {
+			return nil, err
+		}
+		envs = append(envs, env)
+	}
+	if doc == nil {
+		return nil, errors.New("no environments to render")
+	}
+	for _, slug := range c.Environments() {
+		if slices.Contains(envSlugs, slug) {
+			continue
+		}
+		base, err := c.parse(c.Base, slug)
+		if err != nil {
+			return nil, err
+		}
+		if err := project(slug, base); err != nil {
+			return nil, err
+		}
+	}
+	doc["environments"] = envs
+
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+	s, err := snapshot.Parse(b)
+	if err != nil {
+		return nil, fmt.Errorf("rendering %s: %w", strings.Join(envSlugs, ", "), err)
+	}
+	return s, nil
+}
+
+// parse substitutes the variables in a file for an environment and decodes it.
+func (c *Config) parse(f File, envSlug string) (map[string]any, error) {
+	lookupEnv := c.LookupEnv
+	if lookupEnv == nil {
+		lookupEnv = os.LookupEnv
+	}
+	text, err := Substitute(f.Data, func(name string) (string, bool) {
+		if name == EnvironmentVar {
+			return envSlug, true
+		}
+		if v, ok := lookupEnv(name); ok {
+			return v, true
+		}
+		v, ok := c.Vars[name]
+		return v, ok
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", f.Name, err)
+	}
+	var doc any
+	if err := yaml.Unmarshal(text, &doc); err != nil {
+		return nil, fmt.Errorf("%s: %w", f.Name, err)
+	}
+	// Round-trip through JSON so that the document has the types Merge and snapshot.Parse expect,
+	// such as string keys.
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", f.Name, err)
+	}
+	var out map[string]any
+	if err := json.Unmarshal(b, &out); err != nil {
+		return nil, fmt.Errorf("%s: must be an object", f.Name)
+	}
+	if out == nil {
+		out = map[string]any{}
+	}
+	return out, nil
+}
+
+// sharedEnvironment returns the environment of the base document, or an empty one.
+func sharedEnvironment(name string, base map[string]any) (any, error) {
+	envs, _ := base["environments"].([]any)
+	switch len(envs) {
+	case 0:
+		return map[string]any{}, nil
+	case 1:
+		return envs[0], nil
+	}
+	return nil, fmt.Errorf("%s: the base must have at most one environment, found %d", name, len(envs))
+}
+
+// setSlug sets the slug of a rendered environment, which must not be set to another one.
+func setSlug(env any, slug string) error {
+	obj, ok := env.(map[string]any)
+	if !ok {
+		return fmt.Errorf("environment %s: must be an object", slug)
+	}
+	settings, _ := obj["settings"].(map[string]any)
+	if settings == nil {
+		settings = map[string]any{}
+		obj["settings"] = settings
+	}
+	if s, ok := settings["environment_slug"]; ok && s != slug {
+		return fmt.Errorf("environment %s: settings.environment_slug is %v; leave it out or use ${%s}",
+			slug, s, EnvironmentVar)
+	}
+	settings["environment_slug"] = slug
+	return nil
+}
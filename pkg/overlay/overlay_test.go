@@ -0,0 +1,278 @@
+package overlay_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/rbacpolicy"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/redirecturls"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/overlay"
+	"gopkg.in/yaml.v3"
+)
+
+func decode(t *testing.T, doc string) any {
+	t.Helper()
+	var v any
+	require.NoError(t, yaml.Unmarshal([]byte(doc), &v))
+	return v
+}
+
+func TestMerge(t *testing.T) {
+	base := decode(t, `
+redirect_urls:
+  - url: https://example.com/login
+    valid_types: [{type: LOGIN, is_default: true}]
+  - url: https://example.com/signup
+    valid_types: [{type: SIGNUP}]
+rbac_policy:
+  custom_roles:
+    - role_id: editor
+      description: Edits things
+    - role_id: viewer
+sms_country_codes: ["1", "44"]
+password_strength_config: {check_breach_on_creation: true}
+`)
+
+	for name, tc := range map[string]struct {
+		overlay string
+		want    string
+	}{
+		"keyed lists": {
+			overlay: `
+redirect_urls:
+  - url: https://example.com/signup
+    valid_types: [{type: SIGNUP, is_default: true}]
+  - url: https://example.com/invite
+    valid_types: [{type: INVITE}]
+rbac_policy:
+  custom_roles:
+    - role_id: editor
+      description: Edits everything
+`,
+			want: `
+redirect_urls:
+  - url: https://example.com/login
+    valid_types: [{type: LOGIN, is_default: true}]
+  - url: https://example.com/signup
+    valid_types: [{type: SIGNUP, is_default: true}]
+  - url: https://example.com/invite
+    valid_types: [{type: INVITE}]
+rbac_policy:
+  custom_roles:
+    - role_id: editor
+      description: Edits everything
+    - role_id: viewer
+sms_country_codes: ["1", "44"]
+password_strength_config: {check_breach_on_creation: true}
+`,
+		},
+		"directives and nulls": {
+			overlay: `
+redirect_urls:
+  - url: https://example.com/signup
+    $patch: delete
+rbac_policy:
+  custom_roles:
+    - $patch: replace
+    - role_id: admin
+sms_country_codes: ["33"]
+password_strength_config: null
+`,
+			want: `
+redirect_urls:
+  - url: https://example.com/login
+    valid_types: [{type: LOGIN, is_default: true}]
+rbac_policy:
+  custom_roles:
+    - role_id: admin
+sms_country_codes: ["33"]
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// Act
+			got, err := overlay.Merge(base, decode(t, tc.overlay))
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, decode(t, tc.want), got)
+		})
+	}
+
+	t.Run("missing merge keys", func(t *testing.T) {
+		// Act
+		_, err := overlay.Merge(base, decode(t, "redirect_urls: [{valid_types: []}]"))
+
+		// Assert
+		assert.EqualError(t, err, "redirect_urls[0]: missing merge key url")
+	})
+
+	t.Run("does not modify its arguments", func(t *testing.T) {
+		// Arrange
+		before := decode(t, "rbac_policy: {custom_roles: [{role_id: editor}]}")
+
+		// Act
+		_, err := overlay.Merge(before, decode(t, "rbac_policy: {custom_roles: [{role_id: viewer}]}"))
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, decode(t, "rbac_policy: {custom_roles: [{role_id: editor}]}"), before)
+	})
+}
+
+func TestSubstitute(t *testing.T) {
+	vars := overlay.Vars{"DOMAIN": "example.com", "MINUTES": "60"}
+	lookup := func(name string) (string, bool) {
+		v, ok := vars[name]
+		return v, ok
+	}
+
+	t.Run("references", func(t *testing.T) {
+		// Act
+		got, err := overlay.Substitute([]byte(
+			"url: https://${DOMAIN}/login\nminutes: ${MINUTES}\nname: ${NAME:-Test}\nliteral: $${DOMAIN}\n"), lookup)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "url: https://example.com/login\nminutes: 60\nname: Test\nliteral: ${DOMAIN}\n", string(got))
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		// Act
+		_, err := overlay.Substitute([]byte("a: ${MISSING}\nb: ${DOMAIN\nc: ${OTHER}\n"), lookup)
+
+		// Assert
+		assert.EqualError(t, err, "line 1: variable MISSING is not set\n"+
+			"line 2: malformed variable reference\n"+
+			"line 3: variable OTHER is not set")
+	})
+}
+
+func TestRender(t *testing.T) {
+	// Arrange
+	dir := t.TempDir()
+	write := func(name, content string) {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+	write("base.yaml", `
+version: 1
+project: {project_slug: my-project, vertical: B2B}
+environments:
+  - settings: {name: "${ENVIRONMENT_SLUG}", user_lock_threshold: 5}
+    redirect_urls:
+      - url: https://${DOMAIN}/login
+        valid_types: [{type: LOGIN, is_default: true}]
+    rbac_policy:
+      custom_roles: [{role_id: editor}]
+    sdk_b2b:
+      basic:
+        enabled: true
+        domains: [{domain: "https://${DOMAIN}"}]
+`)
+	write("overlays/test.yaml", `
+redirect_urls:
+  - url: http://localhost:3000/login
+    valid_types: [{type: LOGIN}]
+sdk_b2b:
+  basic:
+    domains: [{domain: "http://localhost:3000"}]
+`)
+	write("overlays/production.yaml", `
+settings: {user_lock_threshold: 3}
+rbac_policy:
+  custom_roles: [{role_id: auditor}]
+`)
+	write("vars.yaml", "DOMAIN: app.example.com\n")
+
+	config, err := overlay.Load(dir)
+	require.NoError(t, err)
+	config.LookupEnv = func(string) (string, bool) { return "", false }
+
+	// Act
+	desired, err := config.Render()
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "my-project", desired.Project.ProjectSlug)
+	require.Len(t, desired.Environments, 2)
+
+	production := desired.Environment("production")
+	require.NotNil(t, production)
+	assert.Equal(t, "production", production.Settings.Name)
+	assert.Equal(t, 3, production.Settings.UserLockThreshold)
+	assert.Equal(t, []rbacpolicy.Role{{RoleID: "auditor"}, {RoleID: "editor"}}, production.RBACPolicy.CustomRoles)
+	require.Len(t, production.RedirectURLs, 1)
+	assert.Equal(t, "https://app.example.com/login", production.RedirectURLs[0].URL)
+
+	test := desired.Environment("test")
+	require.NotNil(t, test)
+	assert.Equal(t, 5, test.Settings.UserLockThreshold)
+	assert.Equal(t, []redirecturls.RedirectURL{
+		{URL: "http://localhost:3000/login", ValidTypes: []redirecturls.URLType{{Type: redirecturls.RedirectURLTypeLogin}}},
+		{URL: "https://app.example.com/login", ValidTypes: []redirecturls.URLType{{Type: redirecturls.RedirectURLTypeLogin, IsDefault: true}}},
+	}, test.RedirectURLs)
+	var domains []string
+	for _, d := range test.B2BSDKConfig.Basic.Domains {
+		domains = append(domains, d.Domain)
+	}
+	assert.Equal(t, []string{"http://localhost:3000", "https://app.example.com"}, domains)
+
+	t.Run("environment variables take precedence", func(t *testing.T) {
+		// Arrange
+		config.LookupEnv = func(name string) (string, bool) {
+			return "staging.example.com", name == "DOMAIN"
+		}
+
+		// Act
+		desired, err := config.Render("production")
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, desired.Environments, 1)
+		assert.Equal(t, "https://staging.example.com/login", desired.Environments[0].RedirectURLs[0].URL)
+	})
+
+	t.Run("unknown fields", func(t *testing.T) {
+		// Arrange
+		config.Overlays["qa"] = overlay.File{Name: "qa.yaml", Data: []byte("redirect_url: []\n")}
+
+		// Act
+		_, err := config.Render("qa")
+
+		// Assert
+		assert.ErrorContains(t, err, `unknown field "redirect_url"`)
+	})
+}
+
+func TestRenderProjectLevelConfiguration(t *testing.T) {
+	// Arrange
+	config := &overlay.Config{
+		Base: overlay.File{Name: "base.yaml", Data: []byte(`
+version: 1
+project: {project_slug: my-project, name: "App (${ENVIRONMENT_SLUG})", vertical: B2B}
+`)},
+		Overlays: map[string]overlay.File{
+			"live": {Name: "live.yaml", Data: []byte("{}\n")},
+			"test": {Name: "test.yaml", Data: []byte("{}\n")},
+		},
+		LookupEnv: func(string) (string, bool) { return "", false },
+	}
+
+	for name, envSlugs := range map[string][]string{
+		"every environment": nil,
+		"one environment":   {"live"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// Act
+			_, err := config.Render(envSlugs...)
+
+			// Assert
+			assert.ErrorContains(t, err, "base.yaml: the project-level configuration differs between environments")
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package overlay
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+
+	"gopkg.in/yaml.v3"
+)
+
+// reference matches "$${", which escapes a literal "${", and variable references: "${NAME}" and
+// "${NAME:-default}".
+var reference = regexp.MustCompile(`\$\$\{|\$\{([A-Za-z_][A-Za-z0-9_]*)(:-([^}]*))?\}`)
+
+// Vars holds the values of variables.
+type Vars map[string]string
+
+// ReadVarsFile reads variables from a YAML or JSON file holding a single object of scalars.
+func ReadVarsFile(path string) (Vars, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var raw map[string]any
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	vars := Vars{}
+	for k, v := range raw {
+		switch v.(type) {
+		case map[string]any, []any:
+			return nil, fmt.Errorf("%s: variable %s must be a string, number or boolean", path, k)
+		case nil:
+			vars[k] = ""
+		default:
+			vars[k] = fmt.Sprint(v)
+		}
+	}
+	return vars, nil
+}
+
+// Substitute replaces variable references in text with the values returned by lookup. A reference
+// is either "${NAME}" or "${NAME:-default}", which uses default if the variable is not set; "$${"
+// stands for a literal "${". Substitution is textual, so the YAML type of a value is decided by how
+// it is written: "${MINUTES}" becomes a number if MINUTES is "60", and "'${MINUTES}'" a string.
+//
+// An error lists every variable that is referenced but not set.
+func Substitute(text []byte, lookup func(name string) (string, bool)) ([]byte, error) {
+	var (
+		out  bytes.Buffer
+		errs []error
+		last int
+	)
+	// literal copies text that is not a reference, which must not contain "${".
+	literal := func(start, end int) {
+		if i := bytes.Index(text[start:end], []byte("${")); i >= 0 {
+			errs = append(errs, fmt.Errorf("line %d: malformed variable reference", line(text, start+i)))
+		}
+		out.Write(text[start:end])
+	}
+	for _, m := range reference.FindAllSubmatchIndex(text, -1) {
+		literal(last, m[0])
+		last = m[1]
+		if m[2] < 0 {
+			out.WriteString("${")
+			continue
+		}
+		name := string(text[m[2]:m[3]])
+		value, ok := lookup(name)
+		if !ok && m[4] >= 0 {
+			value, ok = string(text[m[6]:m[7]]), true
+		}
+		if !ok {
+			errs = append(errs, fmt.Errorf("line %d: variable %s is not set", line(text, m[0]), name))
+			continue
+		}
+		out.WriteString(value)
+	}
+	literal(last, len(text))
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+// line returns the 1-based line number of an offset in text.
+func line(text []byte, offset int) int {
+	return bytes.Count(text[:offset], []byte("\n")) + 1
+}
@@ -0,0 +1,207 @@
+// Package promote copies selected configuration from one environment of a project to another, such
+// as from staging to production.
+//
+// Promotion is built on pkg/reconcile: Plan computes the steps that make the selected resources of
+// the target environment match the source environment, which can be reviewed before they are
+// applied:
+//
+//	plan, err := promote.Plan(ctx, client, promote.Request{
+//		ProjectSlug: "my-project",
+//		From:        "staging",
+//		To:          "production",
+//		Resources: []reconcile.Resource{
+//			reconcile.ResourceRBACPolicy,
+//			reconcile.ResourceJWTTemplate,
+//			reconcile.ResourceB2BSDKConfig,
+//			reconcile.ResourceRedirectURL,
+//		},
+//		Rewrites: []promote.Rewrite{{From: "staging.example.com", To: "example.com"}},
+//	})
+//	err = plan.Render(os.Stdout, reconcile.FormatText)
+//	result := plan.Apply(ctx, client)
+//
+// Resources that hold credentials, such as secrets and event log streaming destinations, cannot
+// be promoted.
+package promote
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/reconcile"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/snapshot"
+)
+
+// Request describes a promotion.
+type Request struct {
+	ProjectSlug string
+	// From and To are the slugs of the source and target environments.
+	From string
+	To   string
+	// Resources are the resource types to promote. Everything else in the target environment is left
+	// alone. See Resources for the types that can be promoted.
+	Resources []reconcile.Resource
+	// Rewrites are applied, in order, to redirect URLs and SDK authorized domains as they are copied.
+	Rewrites []Rewrite
+}
+
+// Rewrite replaces every occurrence of From with To, such as "staging.example.com" with
+// "example.com".
+type Rewrite struct {
+	From string
+	To   string
+}
+
+func (r Rewrite) apply(s string) string {
+	return strings.ReplaceAll(s, r.From, r.To)
+}
+
+// Resources returns the resource types that can be promoted.
+func Resources() []reconcile.Resource {
+	return []reconcile.Resource{
+		reconcile.ResourceRedirectURL,
+		reconcile.ResourceB2BSDKConfig,
+		reconcile.ResourceConsumerSDKConfig,
+		reconcile.ResourceRBACPolicy,
+		reconcile.ResourceJWTTemplate,
+		reconcile.ResourcePasswordStrengthConfig,
+		reconcile.ResourceSMSCountryCodes,
+		reconcile.ResourceWhatsAppCountryCodes,
+		reconcile.ResourceTrustedTokenProfile,
+	}
+}
+
+// credentialResources name the resources that are refused with a specific message, because they
+// hold credentials that must be created in each environment separately.
+var credentialResources = map[reconcile.Resource]bool{
+	"secret":                            true,
+	"secrets":                           true,
+	"public_token":                      true,
+	"public_tokens":                     true,
+	reconcile.ResourceEventLogStreaming: true,
+}
+
+func (r Request) validate() error {
+	if r.ProjectSlug == "" {
+		return fmt.Errorf("ProjectSlug cannot be empty")
+	}
+	if r.From == "" || r.To == "" {
+		return fmt.Errorf("From and To cannot be empty")
+	}
+	if r.From == r.To {
+		return fmt.Errorf("cannot promote environment %s to itself", r.From)
+	}
+	if len(r.Resources) == 0 {
+		return fmt.Errorf("Resources cannot be empty")
+	}
+	for _, res := range r.Resources {
+		if credentialResources[res] {
+			return fmt.Errorf("refusing to promote %s: credentials must be created in each environment", res)
+		}
+		if !slices.Contains(Resources(), res) {
+			return fmt.Errorf("resource %s cannot be promoted", res)
+		}
+	}
+	for _, rw := range r.Rewrites {
+		if rw.From == "" {
+			return fmt.Errorf("rewrite From cannot be empty")
+		}
+	}
+	return nil
+}
+
+// Plan reads both environments and computes the steps that promote the requested resources. The
+// plan is checked to change nothing but the requested resources of the target environment.
+func Plan(ctx context.Context, client api.Interface, req Request) (*reconcile.Plan, error) {
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+	current, err := snapshot.Take(ctx, client, req.ProjectSlug, snapshot.WithEnvironments(req.To))
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", req.To, err)
+	}
+	source, err := snapshot.TakeEnvironment(ctx, client, req.ProjectSlug, req.From)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", req.From, err)
+	}
+
+	desired := current.DeepCopy()
+	target := desired.Environment(req.To)
+	for _, res := range req.Resources {
+		copyResource(target, source, res, req.Rewrites)
+	}
+	target.Normalize()
+
+	plan := reconcile.Compute(current, desired)
+	for _, step := range plan.Steps {
+		if !promotes(req, step) {
+			return nil, fmt.Errorf("promotion would %s, which was not requested", step)
+		}
+	}
+	return plan, nil
+}
+
+// promotes reports whether step changes one of the requested resources of the target environment.
+func promotes(req Request, step *reconcile.Step) bool {
+	resource := step.Resource
+	if resource == reconcile.ResourcePEMFile {
+		resource = reconcile.ResourceTrustedTokenProfile
+	}
+	return step.Environment == req.To && slices.Contains(req.Resources, resource)
+}
+
+// copyResource replaces a resource of dst with a copy of the one in src, with the rewrites applied.
+func copyResource(dst, src *snapshot.Environment, res reconcile.Resource, rewrites []Rewrite) {
+	s := src.DeepCopy()
+	for _, rw := range rewrites {
+		rewrite(&s, rw)
+	}
+	switch res {
+	case reconcile.ResourceRedirectURL:
+		dst.RedirectURLs = s.RedirectURLs
+	case reconcile.ResourceB2BSDKConfig:
+		dst.B2BSDKConfig = s.B2BSDKConfig
+	case reconcile.ResourceConsumerSDKConfig:
+		dst.ConsumerSDKConfig = s.ConsumerSDKConfig
+	case reconcile.ResourceRBACPolicy:
+		dst.RBACPolicy = s.RBACPolicy
+	case reconcile.ResourceJWTTemplate:
+		dst.JWTTemplates = s.JWTTemplates
+	case reconcile.ResourcePasswordStrengthConfig:
+		dst.PasswordStrengthConfig = s.PasswordStrengthConfig
+	case reconcile.ResourceSMSCountryCodes:
+		dst.SMSCountryCodes = s.SMSCountryCodes
+	case reconcile.ResourceWhatsAppCountryCodes:
+		dst.WhatsAppCountryCodes = s.WhatsAppCountryCodes
+	case reconcile.ResourceTrustedTokenProfile:
+		// Profiles are matched by name in the target environment, so the source's IDs are dropped.
+		for i := range s.TrustedTokenProfiles {
+			s.TrustedTokenProfiles[i].ProfileID = ""
+			for j := range s.TrustedTokenProfiles[i].PEMFiles {
+				s.TrustedTokenProfiles[i].PEMFiles[j].PEMFileID = ""
+			}
+		}
+		dst.TrustedTokenProfiles = s.TrustedTokenProfiles
+	}
+}
+
+// rewrite applies a rewrite rule to the redirect URLs and SDK authorized domains of e.
+func rewrite(e *snapshot.Environment, rw Rewrite) {
+	for i := range e.RedirectURLs {
+		e.RedirectURLs[i].URL = rw.apply(e.RedirectURLs[i].URL)
+	}
+	if c := e.B2BSDKConfig; c != nil && c.Basic != nil {
+		for i := range c.Basic.Domains {
+			c.Basic.Domains[i].Domain = rw.apply(c.Basic.Domains[i].Domain)
+			c.Basic.Domains[i].SlugPattern = rw.apply(c.Basic.Domains[i].SlugPattern)
+		}
+	}
+	if c := e.ConsumerSDKConfig; c != nil && c.Basic != nil {
+		for i := range c.Basic.Domains {
+			c.Basic.Domains[i] = rw.apply(c.Basic.Domains[i])
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package promote_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/apifake"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/environments"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/passwordstrengthconfig"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/rbacpolicy"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/redirecturls"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/promote"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/reconcile"
+)
+
+// newFake returns a fake project whose staging environment has a viewer role, a staging redirect
+// URL and a stricter password policy than its production environment.
+func newFake() *apifake.API {
+	fake := apifake.New()
+	fake.Projects.GetReturns(&projects.GetResponse{Project: projects.Project{
+		ProjectSlug: "my-project",
+		Vertical:    projects.VerticalConsumer,
+	}}, nil)
+	fake.Environments.GetAllReturns(&environments.GetAllResponse{Environments: []environments.Environment{
+		{EnvironmentSlug: "staging"},
+		{EnvironmentSlug: "production"},
+	}}, nil)
+	fake.Environments.GetReturns(&environments.GetResponse{Environment: environments.Environment{
+		EnvironmentSlug: "staging",
+	}}, nil)
+	fake.RedirectURLs.GetAllFunc = func(
+		_ context.Context, body redirecturls.GetAllRequest,
+	) (*redirecturls.GetAllResponse, error) {
+		url := "https://example.com/callback"
+		if body.EnvironmentSlug == "staging" {
+			url = "https://staging.example.com/callback"
+		}
+		return &redirecturls.GetAllResponse{RedirectURLs: []redirecturls.RedirectURL{
+			{URL: url, ValidTypes: []redirecturls.URLType{{Type: redirecturls.RedirectURLTypeLogin, IsDefault: true}}},
+		}}, nil
+	}
+	fake.RBACPolicy.GetFunc = func(_ context.Context, body rbacpolicy.GetRequest) (*rbacpolicy.GetResponse, error) {
+		if body.EnvironmentSlug == "staging" {
+			return &rbacpolicy.GetResponse{Policy: rbacpolicy.Policy{CustomRoles: []rbacpolicy.Role{{RoleID: "viewer"}}}}, nil
+		}
+		return &rbacpolicy.GetResponse{}, nil
+	}
+	fake.PasswordStrengthConfig.GetFunc = func(
+		_ context.Context, body passwordstrengthconfig.GetRequest,
+	) (*passwordstrengthconfig.GetResponse, error) {
+		if body.EnvironmentSlug == "staging" {
+			length := 12
+			return &passwordstrengthconfig.GetResponse{PasswordStrengthConfig: passwordstrengthconfig.PasswordStrengthConfig{
+				LudsMinPasswordLength: &length,
+			}}, nil
+		}
+		return &passwordstrengthconfig.GetResponse{}, nil
+	}
+	return fake
+}
+
+func TestPlan(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("promotes selected resources", func(t *testing.T) {
+		// Arrange
+		fake := newFake()
+		req := promote.Request{
+			ProjectSlug: "my-project",
+			From:        "staging",
+			To:          "production",
+			Resources:   []reconcile.Resource{reconcile.ResourceRBACPolicy, reconcile.ResourceRedirectURL},
+			Rewrites:    []promote.Rewrite{{From: "staging.example.com", To: "example.com"}},
+		}
+
+		// Act
+		plan, err := promote.Plan(ctx, fake, req)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, plan.Steps, 1)
+		assert.Equal(t, "update environment[production].rbac_policy", plan.Steps[0].String())
+	})
+
+	t.Run("leaves other resources alone", func(t *testing.T) {
+		// Arrange
+		fake := newFake()
+		req := promote.Request{
+			ProjectSlug: "my-project",
+			From:        "staging",
+			To:          "production",
+			Resources:   []reconcile.Resource{reconcile.ResourceRedirectURL},
+		}
+
+		// Act
+		plan, err := promote.Plan(ctx, fake, req)
+
+		// Assert
+		require.NoError(t, err)
+		var steps []string
+		for _, s := range plan.Steps {
+			steps = append(steps, s.String())
+		}
+		assert.Equal(t, []string{
+			"create environment[production].redirect_url[https://staging.example.com/callback]",
+			"delete environment[production].redirect_url[https://example.com/callback]",
+		}, steps)
+	})
+
+	t.Run("refuses secrets", func(t *testing.T) {
+		// Arrange
+		fake := newFake()
+		req := promote.Request{
+			ProjectSlug: "my-project",
+			From:        "staging",
+			To:          "production",
+			Resources:   []reconcile.Resource{reconcile.ResourceRBACPolicy, "secrets"},
+		}
+
+		// Act
+		_, err := promote.Plan(ctx, fake, req)
+
+		// Assert
+		assert.ErrorContains(t, err, "refusing to promote secrets")
+		assert.Empty(t, fake.Calls())
+	})
+
+	t.Run("rejects unknown resources", func(t *testing.T) {
+		// Arrange
+		req := promote.Request{
+			ProjectSlug: "my-project",
+			From:        "staging",
+			To:          "production",
+			Resources:   []reconcile.Resource{reconcile.ResourceProject},
+		}
+
+		// Act
+		_, err := promote.Plan(ctx, newFake(), req)
+
+		// Assert
+		assert.ErrorContains(t, err, "resource project cannot be promoted")
+	})
+}
@@ -0,0 +1,878 @@
+package reconcile
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/countrycodeallowlist"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/emailtemplates"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/environments"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/eventlogstreaming"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/jwttemplates"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/passwordstrengthconfig"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/rbacpolicy"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/redirecturls"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/sdk"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/trustedtokenprofiles"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/snapshot"
+)
+
+type applyFunc = func(ctx context.Context, client api.Interface) error
+
+type planner struct {
+	plan    *Plan
+	current *snapshot.Snapshot
+	desired *snapshot.Snapshot
+}
+
+func (p *planner) add(step *Step, apply applyFunc) string {
+	step.ID = stepID(step.Environment, step.Resource, step.Key)
+	step.apply = apply
+	p.plan.Steps = append(p.plan.Steps, step)
+	return step.ID
+}
+
+func (p *planner) warn(format string, args ...any) {
+	p.plan.Warnings = append(p.plan.Warnings, fmt.Sprintf(format, args...))
+}
+
+func (p *planner) compute() {
+	cur, des := p.current, p.desired
+	projectSlug := des.Project.ProjectSlug
+
+	if des.Project.Name != "" && des.Project.Name != cur.Project.Name {
+		name := des.Project.Name
+		after := cur.Project
+		after.Name = name
+		p.add(&Step{
+			phase:    phaseProject,
+			Resource: ResourceProject,
+			Action:   ActionUpdate,
+			Before:   cur.Project,
+			After:    after,
+		}, func(ctx context.Context, client api.Interface) error {
+			_, err := client.ProjectsAPI().Update(ctx, projects.UpdateRequest{ProjectSlug: projectSlug, Name: &name})
+			return err
+		})
+	}
+	if des.Project.Vertical != "" && des.Project.Vertical != cur.Project.Vertical {
+		p.warn("project vertical cannot be changed from %s to %s", cur.Project.Vertical, des.Project.Vertical)
+	}
+
+	p.emailTemplates()
+	// Test environments can only be created in a project that has a live environment, so live
+	// environments are planned first.
+	envs := make([]*snapshot.Environment, len(des.Environments))
+	for i := range des.Environments {
+		envs[i] = &des.Environments[i]
+	}
+	slices.SortStableFunc(envs, func(a, b *snapshot.Environment) int {
+		return liveFirst(a) - liveFirst(b)
+	})
+	for _, env := range envs {
+		p.environment(cur.Environment(env.Settings.EnvironmentSlug), env)
+	}
+}
+
+func (p *planner) emailTemplates() {
+	projectSlug := p.desired.Project.ProjectSlug
+	current := map[string]emailtemplates.EmailTemplate{}
+	for _, t := range p.current.EmailTemplates {
+		current[t.TemplateID] = t
+	}
+	desired := map[string]bool{}
+	templateSteps := map[string]string{}
+
+	for _, t := range p.desired.EmailTemplates {
+		t := t
+		desired[t.TemplateID] = true
+		before, ok := current[t.TemplateID]
+		switch {
+		case !ok:
+			templateSteps[t.TemplateID] = p.add(&Step{
+				phase:    phaseEmailTemplate,
+				Resource: ResourceEmailTemplate,
+				Key:      t.TemplateID,
+				Action:   ActionCreate,
+				After:    t,
+			}, func(ctx context.Context, client api.Interface) error {
+				_, err := client.EmailTemplatesAPI().Create(ctx, emailtemplates.CreateRequest{
+					ProjectSlug:             projectSlug,
+					TemplateID:              t.TemplateID,
+					Name:                    t.Name,
+					SenderInformation:       t.SenderInformation,
+					PrebuiltCustomization:   t.PrebuiltCustomization,
+					CustomHTMLCustomization: t.CustomHTMLCustomization,
+				})
+				return err
+			})
+		case !before.Equal(t):
+			templateSteps[t.TemplateID] = p.add(&Step{
+				phase:    phaseEmailTemplate,
+				Resource: ResourceEmailTemplate,
+				Key:      t.TemplateID,
+				Action:   ActionUpdate,
+				Before:   before,
+				After:    t,
+			}, func(ctx context.Context, client api.Interface) error {
+				_, err := client.EmailTemplatesAPI().Update(ctx, emailtemplates.UpdateRequest{
+					ProjectSlug:             projectSlug,
+					TemplateID:              t.TemplateID,
+					Name:                    t.Name,
+					SenderInformation:       t.SenderInformation,
+					PrebuiltCustomization:   t.PrebuiltCustomization,
+					CustomHTMLCustomization: t.CustomHTMLCustomization,
+				})
+				return err
+			})
+		}
+	}
+
+	for _, typ := range emailtemplates.TemplateTypes() {
+		typ := typ
+		before, after := p.current.DefaultEmailTemplates[typ], p.desired.DefaultEmailTemplates[typ]
+		switch {
+		case after != "" && after != before:
+			step := &Step{
+				phase:    phaseDefaultEmailTemplate,
+				Resource: ResourceDefaultEmailTemplate,
+				Key:      string(typ),
+				Action:   ActionUpdate,
+				After:    after,
+			}
+			if before == "" {
+				step.Action = ActionCreate
+			} else {
+				step.Before = before
+			}
+			if id, ok := templateSteps[after]; ok {
+				step.DependsOn = []string{id}
+			}
+			p.add(step, func(ctx context.Context, client api.Interface) error {
+				_, err := client.EmailTemplatesAPI().SetDefault(ctx, emailtemplates.SetDefaultRequest{
+					ProjectSlug:       projectSlug,
+					TemplateID:        after,
+					EmailTemplateType: typ,
+				})
+				return err
+			})
+		case after == "" && before != "":
+			p.add(&Step{
+				phase:    phaseDefaultEmailTemplate,
+				Resource: ResourceDefaultEmailTemplate,
+				Key:      string(typ),
+				Action:   ActionDelete,
+				Before:   before,
+			}, func(ctx context.Context, client api.Interface) error {
+				_, err := client.EmailTemplatesAPI().UnsetDefault(ctx, emailtemplates.UnsetDefaultRequest{
+					ProjectSlug:       projectSlug,
+					EmailTemplateType: typ,
+				})
+				return err
+			})
+		}
+	}
+
+	for _, t := range p.current.EmailTemplates {
+		if desired[t.TemplateID] {
+			continue
+		}
+		id := t.TemplateID
+		p.add(&Step{
+			phase:    phaseEmailTemplateDelete,
+			Resource: ResourceEmailTemplate,
+			Key:      id,
+			Action:   ActionDelete,
+			Before:   t,
+		}, func(ctx context.Context, client api.Interface) error {
+			_, err := client.EmailTemplatesAPI().Delete(ctx, emailtemplates.DeleteRequest{
+				ProjectSlug: projectSlug,
+				TemplateID:  id,
+			})
+			return err
+		})
+	}
+}
+
+// environment plans the changes to a single environment. cur is nil if the environment does not
+// exist yet.
+func (p *planner) environment(cur, des *snapshot.Environment) {
+	e := &envPlanner{
+		planner:     p,
+		projectSlug: p.desired.Project.ProjectSlug,
+		envSlug:     des.Settings.EnvironmentSlug,
+	}
+
+	if cur == nil {
+		created := settings(des.Settings)
+		e.dependsOn = []string{p.add(&Step{
+			phase:    phaseEnvironment,
+			Resource: ResourceEnvironment,
+			Key:      e.envSlug,
+			Action:   ActionCreate,
+			After:    created,
+		}, func(ctx context.Context, client api.Interface) error {
+			_, err := client.EnvironmentsAPI().Create(ctx, createEnvironmentRequest(e.projectSlug, created))
+			return err
+		})}
+		cur = &snapshot.Environment{Settings: environments.Environment{EnvironmentSlug: e.envSlug}}
+	} else {
+		e.settings(cur.Settings, des.Settings)
+	}
+
+	e.redirectURLs(cur.RedirectURLs, des.RedirectURLs)
+	e.sdkConfig(cur, des)
+	e.rbacPolicy(cur.RBACPolicy, des.RBACPolicy)
+	e.jwtTemplates(cur.JWTTemplates, des.JWTTemplates)
+	e.passwordStrengthConfig(cur.PasswordStrengthConfig, des.PasswordStrengthConfig)
+	e.countryCodes(cur, des)
+	e.trustedTokenProfiles(cur.TrustedTokenProfiles, des.TrustedTokenProfiles)
+	e.eventLogStreaming(cur.EventLogStreaming, des.EventLogStreaming)
+}
+
+// liveFirst orders live environments before the others.
+func liveFirst(env *snapshot.Environment) int {
+	if env.Settings.Type == environments.EnvironmentTypeLive {
+		return 0
+	}
+	return 1
+}
+
+type envPlanner struct {
+	*planner
+	projectSlug string
+	envSlug     string
+	// dependsOn is set when the environment is created by the plan.
+	dependsOn []string
+}
+
+func (e *envPlanner) add(step *Step, apply applyFunc) string {
+	step.Environment = e.envSlug
+	step.DependsOn = append(slices.Clone(e.dependsOn), step.DependsOn...)
+	return e.planner.add(step, apply)
+}
+
+func (e *envPlanner) settings(cur, des environments.Environment) {
+	if des.Type != "" && des.Type != cur.Type {
+		e.warn("environment %s type cannot be changed from %s to %s", e.envSlug, cur.Type, des.Type)
+	}
+	req := environments.UpdateRequest{ProjectSlug: e.projectSlug, EnvironmentSlug: e.envSlug}
+	changed := false
+	changed = setIfChanged(&req.Name, cur.Name, des.Name) || changed
+	changed = setIfChanged(&req.CrossOrgPasswordsEnabled, cur.CrossOrgPasswordsEnabled, des.CrossOrgPasswordsEnabled) || changed
+	changed = setIfChanged(&req.UserImpersonationEnabled, cur.UserImpersonationEnabled, des.UserImpersonationEnabled) || changed
+	changed = setIfChanged(&req.ZeroDowntimeSessionMigrationURL, cur.ZeroDowntimeSessionMigrationURL, des.ZeroDowntimeSessionMigrationURL) || changed
+	changed = setIfChanged(&req.UseCustomDomainInMagicLinkEmails, cur.UseCustomDomainInMagicLinkEmails, des.UseCustomDomainInMagicLinkEmails) || changed
+	changed = setIfChanged(&req.UserLockSelfServeEnabled, cur.UserLockSelfServeEnabled, des.UserLockSelfServeEnabled) || changed
+	changed = setIfChanged(&req.UserLockThreshold, cur.UserLockThreshold, des.UserLockThreshold) || changed
+	changed = setIfChanged(&req.UserLockTTL, cur.UserLockTTL, des.UserLockTTL) || changed
+	changed = setIfChanged(&req.IDPAuthorizationURL, cur.IDPAuthorizationURL, des.IDPAuthorizationURL) || changed
+	changed = setIfChanged(&req.IDPDynamicClientRegistrationEnabled,
+		cur.IDPDynamicClientRegistrationEnabled, des.IDPDynamicClientRegistrationEnabled) || changed
+	changed = setIfChanged(&req.IDPDynamicClientRegistrationAccessTokenTemplateContent,
+		cur.IDPDynamicClientRegistrationAccessTokenTemplateContent,
+		des.IDPDynamicClientRegistrationAccessTokenTemplateContent) || changed
+	if !changed {
+		return
+	}
+	// Only the settings the update changes are kept, so that server-assigned fields and the type,
+	// which cannot be changed, do not show up as differences.
+	before, after := settings(cur), settings(des)
+	after.Type = before.Type
+	e.planner.add(&Step{
+		phase:    phaseEnvironment,
+		Resource: ResourceEnvironment,
+		Key:      e.envSlug,
+		Action:   ActionUpdate,
+		Before:   before,
+		After:    after,
+	}, func(ctx context.Context, client api.Interface) error {
+		_, err := client.EnvironmentsAPI().Update(ctx, req)
+		return err
+	})
+}
+
+// settings returns s without the fields that are assigned by the API.
+func settings(s environments.Environment) environments.Environment {
+	s.ProjectSlug, s.ProjectID, s.OAuthCallbackID, s.CreatedAt = "", "", "", time.Time{}
+	return s
+}
+
+func createEnvironmentRequest(projectSlug string, s environments.Environment) environments.CreateRequest {
+	slug := s.EnvironmentSlug
+	return environments.CreateRequest{
+		ProjectSlug:                         projectSlug,
+		Name:                                s.Name,
+		Type:                                s.Type,
+		EnvironmentSlug:                     &slug,
+		CrossOrgPasswordsEnabled:            nonZero(s.CrossOrgPasswordsEnabled),
+		UserImpersonationEnabled:            nonZero(s.UserImpersonationEnabled),
+		ZeroDowntimeSessionMigrationURL:     nonZero(s.ZeroDowntimeSessionMigrationURL),
+		UserLockSelfServeEnabled:            nonZero(s.UserLockSelfServeEnabled),
+		UserLockThreshold:                   nonZero(s.UserLockThreshold),
+		UserLockTTL:                         nonZero(s.UserLockTTL),
+		IDPAuthorizationURL:                 nonZero(s.IDPAuthorizationURL),
+		IDPDynamicClientRegistrationEnabled: nonZero(s.IDPDynamicClientRegistrationEnabled),
+		IDPDynamicClientRegistrationAccessTokenTemplateContent: nonZero(
+			s.IDPDynamicClientRegistrationAccessTokenTemplateContent),
+	}
+}
+
+// redirectURLs plans redirect URL changes. Every request sets DoNotPromoteDefaults, so that the
+// defaults end up exactly as desired rather than being promoted by the API as URLs come and go.
+func (e *envPlanner) redirectURLs(cur, des []redirecturls.RedirectURL) {
+	current := map[string]redirecturls.RedirectURL{}
+	for _, u := range cur {
+		current[u.URL] = u
+	}
+	desired := map[string]bool{}
+	doNotPromote := true
+
+	for _, u := range des {
+		u := u
+		desired[u.URL] = true
+		before, ok := current[u.URL]
+		switch {
+		case !ok:
+			e.add(&Step{
+				phase:    phaseRedirectURL,
+				Resource: ResourceRedirectURL,
+				Key:      u.URL,
+				Action:   ActionCreate,
+				After:    u,
+			}, func(ctx context.Context, client api.Interface) error {
+				_, err := client.RedirectURLsAPI().Create(ctx, redirecturls.CreateRequest{
+					ProjectSlug:          e.projectSlug,
+					EnvironmentSlug:      e.envSlug,
+					URL:                  u.URL,
+					ValidTypes:           u.ValidTypes,
+					DoNotPromoteDefaults: &doNotPromote,
+				})
+				return err
+			})
+		case !before.Equal(u):
+			e.add(&Step{
+				phase:    phaseRedirectURL,
+				Resource: ResourceRedirectURL,
+				Key:      u.URL,
+				Action:   ActionUpdate,
+				Before:   before,
+				After:    u,
+			}, func(ctx context.Context, client api.Interface) error {
+				_, err := client.RedirectURLsAPI().Update(ctx, redirecturls.UpdateRequest{
+					ProjectSlug:          e.projectSlug,
+					EnvironmentSlug:      e.envSlug,
+					URL:                  u.URL,
+					ValidTypes:           u.ValidTypes,
+					DoNotPromoteDefaults: &doNotPromote,
+				})
+				return err
+			})
+		}
+	}
+
+	for _, u := range cur {
+		if desired[u.URL] {
+			continue
+		}
+		url := u.URL
+		e.add(&Step{
+			phase:    phaseRedirectURLDelete,
+			Resource: ResourceRedirectURL,
+			Key:      url,
+			Action:   ActionDelete,
+			Before:   u,
+		}, func(ctx context.Context, client api.Interface) error {
+			_, err := client.RedirectURLsAPI().Delete(ctx, redirecturls.DeleteRequest{
+				ProjectSlug:          e.projectSlug,
+				EnvironmentSlug:      e.envSlug,
+				URL:                  url,
+				DoNotPromoteDefaults: &doNotPromote,
+			})
+			return err
+		})
+	}
+}
+
+// sdkConfig plans SDK configuration changes. A snapshot without an SDK configuration leaves the
+// live one alone.
+func (e *envPlanner) sdkConfig(cur, des *snapshot.Environment) {
+	if des.B2BSDKConfig != nil && (cur.B2BSDKConfig == nil || !cur.B2BSDKConfig.Equal(*des.B2BSDKConfig)) {
+		config := *des.B2BSDKConfig
+		step := &Step{
+			phase:    phaseSettings,
+			Resource: ResourceB2BSDKConfig,
+			Action:   ActionUpdate,
+			After:    config,
+		}
+		if cur.B2BSDKConfig != nil {
+			step.Before = *cur.B2BSDKConfig
+		}
+		e.add(step, func(ctx context.Context, client api.Interface) error {
+			_, err := client.SDKAPI().SetB2BConfig(ctx, sdk.SetB2BConfigRequest{
+				ProjectSlug:     e.projectSlug,
+				EnvironmentSlug: e.envSlug,
+				Config:          &config,
+			})
+			return err
+		})
+	}
+	if des.ConsumerSDKConfig != nil && (cur.ConsumerSDKConfig == nil || !cur.ConsumerSDKConfig.Equal(*des.ConsumerSDKConfig)) {
+		config := *des.ConsumerSDKConfig
+		step := &Step{
+			phase:    phaseSettings,
+			Resource: ResourceConsumerSDKConfig,
+			Action:   ActionUpdate,
+			After:    config,
+		}
+		if cur.ConsumerSDKConfig != nil {
+			step.Before = *cur.ConsumerSDKConfig
+		}
+		e.add(step, func(ctx context.Context, client api.Interface) error {
+			_, err := client.SDKAPI().SetConsumerConfig(ctx, sdk.SetConsumerConfigRequest{
+				ProjectSlug:     e.projectSlug,
+				EnvironmentSlug: e.envSlug,
+				Config:          &config,
+			})
+			return err
+		})
+	}
+}
+
+// rbacPolicy plans RBAC policy changes. The Stytch resources are defined by Stytch and are not
+// compared.
+func (e *envPlanner) rbacPolicy(cur, des rbacpolicy.Policy) {
+	cur.StytchResources, des.StytchResources = nil, nil
+	if cur.Equal(des) {
+		return
+	}
+	e.add(&Step{
+		phase:    phaseSettings,
+		Resource: ResourceRBACPolicy,
+		Action:   ActionUpdate,
+		Before:   cur,
+		After:    des,
+	}, func(ctx context.Context, client api.Interface) error {
+		_, err := client.RBACPolicyAPI().Set(ctx, rbacpolicy.SetRequest{
+			ProjectSlug:     e.projectSlug,
+			EnvironmentSlug: e.envSlug,
+			StytchMember:    des.StytchMember,
+			StytchAdmin:     des.StytchAdmin,
+			StytchUser:      des.StytchUser,
+			CustomRoles:     des.CustomRoles,
+			CustomResources: des.CustomResources,
+			CustomScopes:    des.CustomScopes,
+		})
+		return err
+	})
+}
+
+// jwtTemplates plans JWT template changes. Deleting a template sets it to empty content.
+func (e *envPlanner) jwtTemplates(cur, des []jwttemplates.JWTTemplate) {
+	find := func(templates []jwttemplates.JWTTemplate, typ jwttemplates.JWTTemplateType) (jwttemplates.JWTTemplate, bool) {
+		for _, t := range templates {
+			if t.JWTTemplateType == typ {
+				return t, true
+			}
+		}
+		return jwttemplates.JWTTemplate{}, false
+	}
+	for _, typ := range jwttemplates.JWTTemplateTypes() {
+		typ := typ
+		before, hasBefore := find(cur, typ)
+		after, hasAfter := find(des, typ)
+		step := &Step{
+			phase:    phaseSettings,
+			Resource: ResourceJWTTemplate,
+			Key:      string(typ),
+		}
+		switch {
+		case hasAfter && !hasBefore:
+			step.Action, step.After = ActionCreate, after
+		case hasAfter && !before.Equal(after):
+			step.Action, step.Before, step.After = ActionUpdate, before, after
+		case hasBefore && !hasAfter:
+			step.Action, step.Before = ActionDelete, before
+		default:
+			continue
+		}
+		e.add(step, func(ctx context.Context, client api.Interface) error {
+			_, err := client.JWTTemplatesAPI().Set(ctx, jwttemplates.SetRequest{
+				ProjectSlug:     e.projectSlug,
+				EnvironmentSlug: e.envSlug,
+				JWTTemplateType: typ,
+				TemplateContent: after.TemplateContent,
+				CustomAudience:  after.CustomAudience,
+			})
+			return err
+		})
+	}
+}
+
+func (e *envPlanner) passwordStrengthConfig(cur, des passwordstrengthconfig.PasswordStrengthConfig) {
+	if cur.Equal(des) {
+		return
+	}
+	e.add(&Step{
+		phase:    phaseSettings,
+		Resource: ResourcePasswordStrengthConfig,
+		Action:   ActionUpdate,
+		Before:   cur,
+		After:    des,
+	}, func(ctx context.Context, client api.Interface) error {
+		_, err := client.PasswordStrengthConfigAPI().Set(ctx, passwordstrengthconfig.SetRequest{
+			ProjectSlug:                 e.projectSlug,
+			EnvironmentSlug:             e.envSlug,
+			CheckBreachOnCreation:       des.CheckBreachOnCreation,
+			CheckBreachOnAuthentication: des.CheckBreachOnAuthentication,
+			ValidateOnAuthentication:    des.ValidateOnAuthentication,
+			ValidationPolicy:            des.ValidationPolicy,
+			LudsMinPasswordLength:       des.LudsMinPasswordLength,
+			LudsMinPasswordComplexity:   des.LudsMinPasswordComplexity,
+		})
+		return err
+	})
+}
+
+func (e *envPlanner) countryCodes(cur, des *snapshot.Environment) {
+	if !slices.Equal(cur.SMSCountryCodes, des.SMSCountryCodes) {
+		codes := des.SMSCountryCodes
+		e.add(&Step{
+			phase:    phaseSettings,
+			Resource: ResourceSMSCountryCodes,
+			Action:   ActionUpdate,
+			Before:   cur.SMSCountryCodes,
+			After:    codes,
+		}, func(ctx context.Context, client api.Interface) error {
+			_, err := client.CountryCodeAllowlistAPI().SetAllowedSMSCountryCodes(ctx,
+				countrycodeallowlist.SetAllowedSMSCountryCodesRequest{
+					ProjectSlug:     e.projectSlug,
+					EnvironmentSlug: e.envSlug,
+					CountryCodes:    codes,
+				})
+			return err
+		})
+	}
+	if !slices.Equal(cur.WhatsAppCountryCodes, des.WhatsAppCountryCodes) {
+		codes := des.WhatsAppCountryCodes
+		e.add(&Step{
+			phase:    phaseSettings,
+			Resource: ResourceWhatsAppCountryCodes,
+			Action:   ActionUpdate,
+			Before:   cur.WhatsAppCountryCodes,
+			After:    codes,
+		}, func(ctx context.Context, client api.Interface) error {
+			_, err := client.CountryCodeAllowlistAPI().SetAllowedWhatsAppCountryCodes(ctx,
+				countrycodeallowlist.SetAllowedWhatsAppCountryCodesRequest{
+					ProjectSlug:     e.projectSlug,
+					EnvironmentSlug: e.envSlug,
+					CountryCodes:    codes,
+				})
+			return err
+		})
+	}
+}
+
+// trustedTokenProfiles plans trusted token profile changes. Profile IDs are generated by the API,
+// so a desired profile is matched to a live one by ID and then by name. New profiles are created
+// with their PEM files; the PEM files of existing profiles are added and removed individually.
+func (e *envPlanner) trustedTokenProfiles(cur, des []trustedtokenprofiles.TrustedTokenProfile) {
+	matched := map[string]bool{}
+	match := func(d trustedtokenprofiles.TrustedTokenProfile) (trustedtokenprofiles.TrustedTokenProfile, bool) {
+		for _, c := range cur {
+			if !matched[c.ProfileID] && d.ProfileID != "" && c.ProfileID == d.ProfileID {
+				return c, true
+			}
+		}
+		for _, c := range cur {
+			if !matched[c.ProfileID] && c.Name == d.Name {
+				return c, true
+			}
+		}
+		return trustedtokenprofiles.TrustedTokenProfile{}, false
+	}
+
+	// Names are not unique, so new profiles that share one are told apart by their position.
+	createKeys := map[string]bool{}
+	createKey := func(name string) string {
+		key := name
+		for n := 2; createKeys[key]; n++ {
+			key = fmt.Sprintf("%s#%d", name, n)
+		}
+		createKeys[key] = true
+		return key
+	}
+
+	for _, d := range des {
+		d := d
+		c, ok := match(d)
+		if !ok {
+			e.add(&Step{
+				phase:    phaseTrustedTokenProfile,
+				Resource: ResourceTrustedTokenProfile,
+				Key:      createKey(d.Name),
+				Action:   ActionCreate,
+				After:    d,
+			}, func(ctx context.Context, client api.Interface) error {
+				var keys []string
+				for _, f := range d.PEMFiles {
+					keys = append(keys, f.PublicKey)
+				}
+				_, err := client.TrustedTokenProfilesAPI().Create(ctx, trustedtokenprofiles.CreateRequest{
+					ProjectSlug:      e.projectSlug,
+					EnvironmentSlug:  e.envSlug,
+					Name:             d.Name,
+					Audience:         d.Audience,
+					Issuer:           d.Issuer,
+					PEMFiles:         keys,
+					CanJITProvision:  d.CanJITProvision,
+					JWKSURL:          d.JWKSURL,
+					AttributeMapping: d.AttributeMapping,
+					PublicKeyType:    d.PublicKeyType,
+				})
+				return err
+			})
+			continue
+		}
+		matched[c.ProfileID] = true
+		e.trustedTokenProfile(c, d)
+	}
+
+	for _, c := range cur {
+		if matched[c.ProfileID] {
+			continue
+		}
+		profileID := c.ProfileID
+		e.add(&Step{
+			phase:    phaseTrustedTokenProfileDelete,
+			Resource: ResourceTrustedTokenProfile,
+			Key:      profileID,
+			Action:   ActionDelete,
+			Before:   c,
+		}, func(ctx context.Context, client api.Interface) error {
+			_, err := client.TrustedTokenProfilesAPI().Delete(ctx, trustedtokenprofiles.DeleteRequest{
+				ProjectSlug:     e.projectSlug,
+				EnvironmentSlug: e.envSlug,
+				ProfileID:       profileID,
+			})
+			return err
+		})
+	}
+}
+
+// trustedTokenProfile plans the changes to a profile that exists in both states.
+func (e *envPlanner) trustedTokenProfile(cur, des trustedtokenprofiles.TrustedTokenProfile) {
+	profileID := cur.ProfileID
+	if des.PublicKeyType != "" && des.PublicKeyType != cur.PublicKeyType {
+		e.warn("environment %s trusted token profile %s public key type cannot be changed from %s to %s",
+			e.envSlug, profileID, cur.PublicKeyType, des.PublicKeyType)
+	}
+
+	req := trustedtokenprofiles.UpdateRequest{
+		ProjectSlug:     e.projectSlug,
+		EnvironmentSlug: e.envSlug,
+		ProfileID:       profileID,
+	}
+	changed := false
+	changed = setIfChanged(&req.Name, cur.Name, des.Name) || changed
+	changed = setIfChanged(&req.Audience, cur.Audience, des.Audience) || changed
+	changed = setIfChanged(&req.Issuer, cur.Issuer, des.Issuer) || changed
+	changed = setIfChanged(&req.CanJITProvision, cur.CanJITProvision, des.CanJITProvision) || changed
+	if deref(cur.JWKSURL) != deref(des.JWKSURL) {
+		req.JWKSURL, changed = des.JWKSURL, true
+	}
+	mappingBefore := trustedtokenprofiles.TrustedTokenProfile{AttributeMapping: cur.AttributeMapping}
+	mappingAfter := trustedtokenprofiles.TrustedTokenProfile{AttributeMapping: des.AttributeMapping}
+	if !mappingBefore.Equal(mappingAfter) {
+		req.AttributeMapping, changed = des.AttributeMapping, true
+	}
+	if changed {
+		before, after := cur, des
+		before.PEMFiles, after.PEMFiles = nil, nil
+		after.ProfileID, after.PublicKeyType = profileID, before.PublicKeyType
+		e.add(&Step{
+			phase:    phaseTrustedTokenProfile,
+			Resource: ResourceTrustedTokenProfile,
+			Key:      profileID,
+			Action:   ActionUpdate,
+			Before:   before,
+			After:    after,
+		}, func(ctx context.Context, client api.Interface) error {
+			_, err := client.TrustedTokenProfilesAPI().Update(ctx, req)
+			return err
+		})
+	}
+
+	currentKeys := map[string]bool{}
+	for _, f := range cur.PEMFiles {
+		currentKeys[strings.TrimSpace(f.PublicKey)] = true
+	}
+	desiredKeys := map[string]bool{}
+	for _, f := range des.PEMFiles {
+		key := strings.TrimSpace(f.PublicKey)
+		desiredKeys[key] = true
+		if currentKeys[key] {
+			continue
+		}
+		e.add(&Step{
+			phase:    phasePEMFile,
+			Resource: ResourcePEMFile,
+			Key:      profileID + "/" + fingerprint(key),
+			Action:   ActionCreate,
+			After:    f,
+		}, func(ctx context.Context, client api.Interface) error {
+			_, err := client.TrustedTokenProfilesAPI().CreatePEMFile(ctx, trustedtokenprofiles.CreatePEMFileRequest{
+				ProjectSlug:     e.projectSlug,
+				EnvironmentSlug: e.envSlug,
+				ProfileID:       profileID,
+				PublicKey:       key,
+			})
+			return err
+		})
+	}
+	for _, f := range cur.PEMFiles {
+		if desiredKeys[strings.TrimSpace(f.PublicKey)] {
+			continue
+		}
+		pemFileID := f.PEMFileID
+		e.add(&Step{
+			phase:    phasePEMFile,
+			Resource: ResourcePEMFile,
+			Key:      profileID + "/" + pemFileID,
+			Action:   ActionDelete,
+			Before:   f,
+		}, func(ctx context.Context, client api.Interface) error {
+			_, err := client.TrustedTokenProfilesAPI().DeletePEMFile(ctx, trustedtokenprofiles.DeletePEMFileRequest{
+				ProjectSlug:     e.projectSlug,
+				EnvironmentSlug: e.envSlug,
+				ProfileID:       profileID,
+				PEMFileID:       pemFileID,
+			})
+			return err
+		})
+	}
+}
+
+// eventLogStreaming plans event log streaming changes. Snapshots hold masked destination
+// configurations, so destinations can be enabled, disabled and deleted, but creating one or
+// changing its configuration is reported as a warning instead.
+func (e *envPlanner) eventLogStreaming(cur, des []eventlogstreaming.EventLogStreamingMasked) {
+	current := map[eventlogstreaming.DestinationType]eventlogstreaming.EventLogStreamingMasked{}
+	for _, d := range cur {
+		current[d.DestinationType] = d
+	}
+	desired := map[eventlogstreaming.DestinationType]bool{}
+
+	for _, d := range des {
+		typ := d.DestinationType
+		desired[typ] = true
+		before, ok := current[typ]
+		if !ok {
+			e.warn("environment %s event log streaming to %s must be created with its credentials", e.envSlug, typ)
+			continue
+		}
+		beforeConfig, afterConfig := before, d
+		beforeConfig.StreamingStatus, afterConfig.StreamingStatus = "", ""
+		if !beforeConfig.Equal(afterConfig) {
+			e.warn("environment %s event log streaming to %s must be updated with its credentials", e.envSlug, typ)
+		}
+
+		var enable bool
+		switch {
+		case d.StreamingStatus == eventlogstreaming.StreamingStatusActive &&
+			before.StreamingStatus == eventlogstreaming.StreamingStatusDisabled:
+			enable = true
+		case d.StreamingStatus == eventlogstreaming.StreamingStatusDisabled &&
+			before.StreamingStatus != eventlogstreaming.StreamingStatusDisabled:
+			enable = false
+		default:
+			continue
+		}
+		after := before
+		after.StreamingStatus = d.StreamingStatus
+		e.add(&Step{
+			phase:    phaseEventLogStreaming,
+			Resource: ResourceEventLogStreaming,
+			Key:      string(typ),
+			Action:   ActionUpdate,
+			Before:   before,
+			After:    after,
+		}, func(ctx context.Context, client api.Interface) error {
+			var err error
+			if enable {
+				_, err = client.EventLogStreamingAPI().Enable(ctx, eventlogstreaming.EnableRequest{
+					ProjectSlug:     e.projectSlug,
+					EnvironmentSlug: e.envSlug,
+					DestinationType: typ,
+				})
+			} else {
+				_, err = client.EventLogStreamingAPI().Disable(ctx, eventlogstreaming.DisableRequest{
+					ProjectSlug:     e.projectSlug,
+					EnvironmentSlug: e.envSlug,
+					DestinationType: typ,
+				})
+			}
+			return err
+		})
+	}
+
+	for _, d := range cur {
+		typ := d.DestinationType
+		if desired[typ] {
+			continue
+		}
+		e.add(&Step{
+			phase:    phaseEventLogStreaming,
+			Resource: ResourceEventLogStreaming,
+			Key:      string(typ),
+			Action:   ActionDelete,
+			Before:   d,
+		}, func(ctx context.Context, client api.Interface) error {
+			_, err := client.EventLogStreamingAPI().Delete(ctx, eventlogstreaming.DeleteRequest{
+				ProjectSlug:     e.projectSlug,
+				EnvironmentSlug: e.envSlug,
+				DestinationType: typ,
+			})
+			return err
+		})
+	}
+}
+
+// setIfChanged points dst at a copy of des if it differs from cur.
+func setIfChanged[T comparable](dst **T, cur, des T) bool {
+	if cur == des {
+		return false
+	}
+	*dst = &des
+	return true
+}
+
+// nonZero returns a pointer to v, or nil if v is the zero value.
+func nonZero[T comparable](v T) *T {
+	var zero T
+	if v == zero {
+		return nil
+	}
+	return &v
+}
+
+func deref[T any](p *T) T {
+	var zero T
+	if p == nil {
+		return zero
+	}
+	return *p
+}
+
+// fingerprint identifies a public key in step IDs without including the whole key.
+func fingerprint(publicKey string) string {
+	sum := sha256.Sum256([]byte(publicKey))
+	return hex.EncodeToString(sum[:6])
+}
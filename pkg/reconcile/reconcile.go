@@ -0,0 +1,256 @@
+// Package reconcile brings a project's live configuration in line with a desired state, expressed
+// as a snapshot document.
+//
+// Reconciling is done in two stages. NewPlan reads the live configuration and computes the
+// create, update and delete steps needed to reach the desired state; nothing is changed. Apply
+// then runs the steps in dependency order and reports the outcome of each one:
+//
+//	desired, err := snapshot.ReadFile("stytch.yaml")
+//	plan, err := reconcile.NewPlan(ctx, client, desired)
+//	for _, step := range plan.Steps {
+//		fmt.Println(step)
+//	}
+//	result := plan.Apply(ctx, client)
+//	if err := result.Err(); err != nil {
+//		return err
+//	}
+//
+// Environments that exist in the project but not in the desired state are left alone, as are
+// secrets and public tokens, which snapshots do not include. Within a managed environment the
+// desired state is authoritative: redirect URLs, trusted token profiles, JWT templates and event
+// log streaming destinations that are not in it are deleted.
+package reconcile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/snapshot"
+)
+
+// Action is what a step does to a resource.
+type Action string
+
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+// Resource is the kind of resource a step changes.
+type Resource string
+
+const (
+	ResourceProject                Resource = "project"
+	ResourceEmailTemplate          Resource = "email_template"
+	ResourceDefaultEmailTemplate   Resource = "default_email_template"
+	ResourceEnvironment            Resource = "environment"
+	ResourceRedirectURL            Resource = "redirect_url"
+	ResourceB2BSDKConfig           Resource = "sdk_b2b"
+	ResourceConsumerSDKConfig      Resource = "sdk_consumer"
+	ResourceRBACPolicy             Resource = "rbac_policy"
+	ResourceJWTTemplate            Resource = "jwt_template"
+	ResourcePasswordStrengthConfig Resource = "password_strength_config"
+	ResourceSMSCountryCodes        Resource = "sms_country_codes"
+	ResourceWhatsAppCountryCodes   Resource = "whatsapp_country_codes"
+	ResourceTrustedTokenProfile    Resource = "trusted_token_profile"
+	ResourcePEMFile                Resource = "pem_file"
+	ResourceEventLogStreaming      Resource = "event_log_streaming"
+)
+
+// Step is a single change in a plan.
+type Step struct {
+	// ID identifies the step within its plan, such as
+	// "environment[test].redirect_url[https://example.com/callback]".
+	ID string
+	// Resource, Environment and Key identify the resource the step changes. Environment is empty
+	// for project-level resources, and Key is empty for resources that an environment has only one
+	// of, such as its RBAC policy.
+	Resource    Resource
+	Environment string
+	Key         string
+	Action      Action
+	// Before and After are the resource's current and desired values, as pkg/models types. Before is
+	// nil for a create and After is nil for a delete.
+	Before any
+	After  any
+	// DependsOn lists the IDs of steps that must succeed before this one runs.
+	DependsOn []string
+
+	phase phase
+	apply func(ctx context.Context, client api.Interface) error
+}
+
+// String describes the step, such as "create environment[test].redirect_url[https://...]".
+func (s *Step) String() string {
+	return string(s.Action) + " " + s.ID
+}
+
+// phase orders the steps of a plan so that resources are created before the resources that refer
+// to them, and deleted after.
+type phase int
+
+const (
+	phaseProject phase = iota
+	phaseEnvironment
+	phaseEmailTemplate
+	phaseDefaultEmailTemplate
+	phaseEmailTemplateDelete
+	phaseRedirectURL
+	phaseRedirectURLDelete
+	phaseSettings
+	phaseTrustedTokenProfile
+	phasePEMFile
+	phaseTrustedTokenProfileDelete
+	phaseEventLogStreaming
+)
+
+// Plan is the list of steps that brings a project to a desired state.
+type Plan struct {
+	// ProjectSlug is the project the plan applies to.
+	ProjectSlug string
+	// Steps are in the order Apply runs them.
+	Steps []*Step
+	// Warnings describe differences the plan cannot reconcile, such as a change of project
+	// vertical or event log streaming credentials that the snapshot does not contain.
+	Warnings []string
+}
+
+// Empty reports whether the plan has no steps.
+func (p *Plan) Empty() bool {
+	return len(p.Steps) == 0
+}
+
+// Step returns the step with the given ID, or nil.
+func (p *Plan) Step(id string) *Step {
+	for _, s := range p.Steps {
+		if s.ID == id {
+			return s
+		}
+	}
+	return nil
+}
+
+// NewPlan reads the live configuration of the desired snapshot's project and computes the steps
+// needed to reach the desired state.
+func NewPlan(ctx context.Context, client api.Interface, desired *snapshot.Snapshot) (*Plan, error) {
+	current, err := snapshot.Take(ctx, client, desired.Project.ProjectSlug)
+	if err != nil {
+		return nil, fmt.Errorf("reading live configuration: %w", err)
+	}
+	return Compute(current, desired), nil
+}
+
+// Compute returns the steps needed to change current into desired. Both snapshots must be of the
+// same project.
+func Compute(current, desired *snapshot.Snapshot) *Plan {
+	p := &planner{
+		plan:    &Plan{ProjectSlug: desired.Project.ProjectSlug},
+		current: normalized(current),
+		desired: normalized(desired),
+	}
+	p.compute()
+	slices.SortStableFunc(p.plan.Steps, func(a, b *Step) int {
+		return int(a.phase) - int(b.phase)
+	})
+	return p.plan
+}
+
+// Status is the outcome of a step.
+type Status string
+
+const (
+	StatusApplied Status = "applied"
+	StatusFailed  Status = "failed"
+	// StatusSkipped means the step was not run because a step it depends on did not succeed, or
+	// because the context was canceled.
+	StatusSkipped Status = "skipped"
+)
+
+// StepResult is the outcome of a single step.
+type StepResult struct {
+	Step   *Step
+	Status Status
+	// Err is set for failed and skipped steps.
+	Err error
+}
+
+// Result is the outcome of applying a plan.
+type Result struct {
+	// Steps are in the order they were run.
+	Steps []StepResult
+}
+
+// Err returns the errors of the failed steps joined together, or nil if every step was applied.
+// Skipped steps are not included, since they are a consequence of other failures.
+func (r *Result) Err() error {
+	var errs []error
+	for _, s := range r.Steps {
+		if s.Status == StatusFailed {
+			errs = append(errs, fmt.Errorf("%s: %w", s.Step, s.Err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Count returns the number of steps with the given status.
+func (r *Result) Count(status Status) int {
+	n := 0
+	for _, s := range r.Steps {
+		if s.Status == status {
+			n++
+		}
+	}
+	return n
+}
+
+// Apply runs the steps of the plan in order. A step that fails does not stop the steps that do not
+// depend on it; its dependents are skipped.
+func (p *Plan) Apply(ctx context.Context, client api.Interface) *Result {
+	result := &Result{}
+	failed := map[string]bool{}
+	for _, step := range p.Steps {
+		res := StepResult{Step: step}
+		switch {
+		case ctx.Err() != nil:
+			res.Status, res.Err = StatusSkipped, ctx.Err()
+		case slices.ContainsFunc(step.DependsOn, func(id string) bool { return failed[id] }):
+			res.Status, res.Err = StatusSkipped, fmt.Errorf("depends on a step that did not succeed")
+		default:
+			if err := step.apply(ctx, client); err != nil {
+				res.Status, res.Err = StatusFailed, err
+			} else {
+				res.Status = StatusApplied
+			}
+		}
+		if res.Status != StatusApplied {
+			failed[step.ID] = true
+		}
+		result.Steps = append(result.Steps, res)
+	}
+	return result
+}
+
+// stepID builds a step ID from the environment, resource and key of a step.
+func stepID(env string, resource Resource, key string) string {
+	var b strings.Builder
+	if env != "" {
+		fmt.Fprintf(&b, "%s[%s].", ResourceEnvironment, env)
+	}
+	b.WriteString(string(resource))
+	if key != "" {
+		fmt.Fprintf(&b, "[%s]", key)
+	}
+	return b.String()
+}
+
+// normalized returns a normalized deep copy of s.
+func normalized(s *snapshot.Snapshot) *snapshot.Snapshot {
+	out := s.DeepCopy()
+	out.Normalize()
+	return out
+}
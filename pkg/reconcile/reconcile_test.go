@@ -0,0 +1,259 @@
+package reconcile_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/apifake"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/emailtemplates"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/environments"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/eventlogstreaming"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/redirecturls"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/trustedtokenprofiles"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/reconcile"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/snapshot"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/stytcherror"
+)
+
+func loginURL(url string) redirecturls.RedirectURL {
+	return redirecturls.RedirectURL{
+		URL:        url,
+		ValidTypes: []redirecturls.URLType{{Type: redirecturls.RedirectURLTypeLogin, IsDefault: true}},
+	}
+}
+
+// current returns the live state used by the tests: a project with a single test environment.
+func current() *snapshot.Snapshot {
+	return &snapshot.Snapshot{
+		Version: snapshot.FormatVersion,
+		Project: projects.Project{ProjectSlug: "my-project", Name: "My project", Vertical: projects.VerticalB2B},
+		EmailTemplates: []emailtemplates.EmailTemplate{
+			{TemplateID: "old"},
+		},
+		Environments: []snapshot.Environment{{
+			Settings: environments.Environment{
+				EnvironmentSlug: "test",
+				Name:            "Test",
+				Type:            environments.EnvironmentTypeTest,
+				OAuthCallbackID: "callback-id",
+			},
+			RedirectURLs: []redirecturls.RedirectURL{loginURL("https://old.example.com")},
+			TrustedTokenProfiles: []trustedtokenprofiles.TrustedTokenProfile{{
+				ProfileID: "profile-1",
+				Name:      "Okta",
+				Audience:  "aud",
+				PEMFiles:  []trustedtokenprofiles.PEMFile{{PEMFileID: "pem-1", PublicKey: "old-key"}},
+			}},
+			EventLogStreaming: []eventlogstreaming.EventLogStreamingMasked{{
+				DestinationType: eventlogstreaming.DestinationTypeDatadog,
+				StreamingStatus: eventlogstreaming.StreamingStatusActive,
+			}},
+		}},
+	}
+}
+
+func TestCompute(t *testing.T) {
+	t.Run("no changes", func(t *testing.T) {
+		// Act
+		plan := reconcile.Compute(current(), current())
+
+		// Assert
+		assert.True(t, plan.Empty())
+		assert.Empty(t, plan.Warnings)
+	})
+
+	t.Run("ignores server-assigned fields", func(t *testing.T) {
+		// Arrange
+		desired := current()
+		desired.Environments[0].Settings.OAuthCallbackID = ""
+
+		// Act
+		plan := reconcile.Compute(current(), desired)
+
+		// Assert
+		assert.True(t, plan.Empty())
+	})
+
+	t.Run("orders steps by dependency", func(t *testing.T) {
+		// Arrange
+		desired := current()
+		desired.Project.Name = "Renamed"
+		desired.EmailTemplates = []emailtemplates.EmailTemplate{{TemplateID: "new"}}
+		desired.DefaultEmailTemplates = map[emailtemplates.TemplateType]string{emailtemplates.TemplateTypeLogin: "new"}
+		env := &desired.Environments[0]
+		env.Settings.Name = "Testing"
+		env.RedirectURLs = []redirecturls.RedirectURL{loginURL("https://new.example.com")}
+		env.TrustedTokenProfiles[0].Audience = "new-aud"
+		env.TrustedTokenProfiles[0].PEMFiles = []trustedtokenprofiles.PEMFile{{PublicKey: "new-key"}}
+		env.EventLogStreaming = nil
+
+		// Act
+		plan := reconcile.Compute(current(), desired)
+
+		// Assert
+		var steps []string
+		for _, s := range plan.Steps {
+			steps = append(steps, s.String())
+		}
+		assert.Equal(t, []string{
+			"update project",
+			"update environment[test]",
+			"create email_template[new]",
+			"create default_email_template[LOGIN]",
+			"delete email_template[old]",
+			"create environment[test].redirect_url[https://new.example.com]",
+			"delete environment[test].redirect_url[https://old.example.com]",
+			"update environment[test].trusted_token_profile[profile-1]",
+			"create environment[test].pem_file[profile-1/479a61d5370a]",
+			"delete environment[test].pem_file[profile-1/pem-1]",
+			"delete environment[test].event_log_streaming[DATADOG]",
+		}, steps)
+		assert.Equal(t, []string{"email_template[new]"}, plan.Step("default_email_template[LOGIN]").DependsOn)
+	})
+
+	t.Run("creates missing environments", func(t *testing.T) {
+		// Arrange
+		desired := current()
+		staging := desired.Environments[0].DeepCopy()
+		staging.Settings.EnvironmentSlug = "staging"
+		staging.TrustedTokenProfiles = nil
+		staging.EventLogStreaming = nil
+		desired.Environments = append(desired.Environments, staging)
+
+		// Act
+		plan := reconcile.Compute(current(), desired)
+
+		// Assert
+		require.Len(t, plan.Steps, 2)
+		assert.Equal(t, "create environment[staging]", plan.Steps[0].String())
+		assert.Equal(t, "create environment[staging].redirect_url[https://old.example.com]", plan.Steps[1].String())
+		assert.Equal(t, []string{"environment[staging]"}, plan.Steps[1].DependsOn)
+	})
+
+	t.Run("creates live environments before test environments", func(t *testing.T) {
+		// Arrange
+		desired := current()
+		desired.Environments = append(desired.Environments,
+			snapshot.Environment{Settings: environments.Environment{
+				EnvironmentSlug: "development",
+				Type:            environments.EnvironmentTypeTest,
+			}},
+			snapshot.Environment{Settings: environments.Environment{
+				EnvironmentSlug: "production",
+				Type:            environments.EnvironmentTypeLive,
+			}},
+		)
+
+		// Act
+		plan := reconcile.Compute(current(), desired)
+
+		// Assert
+		var steps []string
+		for _, s := range plan.Steps {
+			steps = append(steps, s.String())
+		}
+		assert.Equal(t, []string{
+			"create environment[production]",
+			"create environment[development]",
+		}, steps)
+	})
+
+	t.Run("gives new profiles with the same name distinct steps", func(t *testing.T) {
+		// Arrange
+		desired := current()
+		auth0 := trustedtokenprofiles.TrustedTokenProfile{Name: "Auth0", Audience: "other-aud"}
+		desired.Environments[0].TrustedTokenProfiles = append(desired.Environments[0].TrustedTokenProfiles, auth0, auth0)
+
+		// Act
+		plan := reconcile.Compute(current(), desired)
+
+		// Assert
+		var steps []string
+		for _, s := range plan.Steps {
+			steps = append(steps, s.String())
+		}
+		assert.Equal(t, []string{
+			"create environment[test].trusted_token_profile[Auth0]",
+			"create environment[test].trusted_token_profile[Auth0#2]",
+		}, steps)
+	})
+
+	t.Run("leaves unmanaged environments alone", func(t *testing.T) {
+		// Arrange
+		desired := current()
+		desired.Environments = nil
+
+		// Act
+		plan := reconcile.Compute(current(), desired)
+
+		// Assert
+		assert.True(t, plan.Empty())
+	})
+
+	t.Run("warns about changes it cannot make", func(t *testing.T) {
+		// Arrange
+		desired := current()
+		desired.Project.Vertical = projects.VerticalConsumer
+		desired.Environments[0].EventLogStreaming = append(desired.Environments[0].EventLogStreaming,
+			eventlogstreaming.EventLogStreamingMasked{DestinationType: eventlogstreaming.DestinationTypeGrafanaLoki})
+
+		// Act
+		plan := reconcile.Compute(current(), desired)
+
+		// Assert
+		assert.True(t, plan.Empty())
+		assert.Len(t, plan.Warnings, 2)
+	})
+}
+
+func TestApply(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("applies steps in order", func(t *testing.T) {
+		// Arrange
+		desired := current()
+		desired.Environments[0].RedirectURLs = []redirecturls.RedirectURL{loginURL("https://new.example.com")}
+		plan := reconcile.Compute(current(), desired)
+		fake := apifake.New()
+
+		// Act
+		result := plan.Apply(ctx, fake)
+
+		// Assert
+		require.NoError(t, result.Err())
+		assert.Equal(t, 2, result.Count(reconcile.StatusApplied))
+		calls := fake.Calls()
+		require.Len(t, calls, 2)
+		assert.Equal(t, "RedirectURLs.Create", calls[0].Operation)
+		assert.Equal(t, "RedirectURLs.Delete", calls[1].Operation)
+		create := fake.RedirectURLs.CreateCalls()[0]
+		assert.Equal(t, "test", create.EnvironmentSlug)
+		require.NotNil(t, create.DoNotPromoteDefaults)
+		assert.True(t, *create.DoNotPromoteDefaults)
+	})
+
+	t.Run("skips dependents of failed steps", func(t *testing.T) {
+		// Arrange
+		desired := current()
+		desired.EmailTemplates = append(desired.EmailTemplates, emailtemplates.EmailTemplate{TemplateID: "new"})
+		desired.DefaultEmailTemplates = map[emailtemplates.TemplateType]string{emailtemplates.TemplateTypeLogin: "new"}
+		desired.Environments[0].Settings.Name = "Testing"
+		plan := reconcile.Compute(current(), desired)
+		fake := apifake.New()
+		fake.EmailTemplates.CreateReturns(nil, stytcherror.Error{StatusCode: 400, ErrorType: "invalid_template"})
+
+		// Act
+		result := plan.Apply(ctx, fake)
+
+		// Assert
+		assert.ErrorContains(t, result.Err(), "create email_template[new]")
+		assert.Equal(t, 1, result.Count(reconcile.StatusFailed))
+		assert.Equal(t, 1, result.Count(reconcile.StatusSkipped))
+		assert.Equal(t, 1, result.Count(reconcile.StatusApplied))
+		assert.Len(t, fake.Environments.UpdateCalls(), 1)
+		assert.Empty(t, fake.EmailTemplates.SetDefaultCalls())
+	})
+}
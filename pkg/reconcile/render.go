@@ -0,0 +1,270 @@
+package reconcile
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/diff"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/redirecturls"
+)
+
+// Format is an output format for Render.
+type Format string
+
+const (
+	// FormatText is a Terraform-style listing of the steps and their field-level changes, for
+	// terminals.
+	FormatText Format = "text"
+	// FormatMarkdown wraps the text listing in a diff code block, for pull request comments.
+	FormatMarkdown Format = "markdown"
+	// FormatJSON is a machine-readable document with one entry per step.
+	FormatJSON Format = "json"
+)
+
+// Formats returns the supported output formats.
+func Formats() []Format {
+	return []Format{FormatText, FormatMarkdown, FormatJSON}
+}
+
+type renderOptions struct {
+	color bool
+}
+
+// RenderOption configures Render.
+type RenderOption func(*renderOptions)
+
+// WithColor colors text output with ANSI escape codes. It has no effect on the other formats.
+func WithColor() RenderOption {
+	return func(o *renderOptions) {
+		o.color = true
+	}
+}
+
+const (
+	ansiReset  = "\x1b[0m"
+	ansiBold   = "\x1b[1m"
+	ansiRed    = "\x1b[31m"
+	ansiGreen  = "\x1b[32m"
+	ansiYellow = "\x1b[33m"
+)
+
+var actionSymbols = map[Action]string{
+	ActionCreate: "+",
+	ActionUpdate: "~",
+	ActionDelete: "-",
+}
+
+var actionColors = map[Action]string{
+	ActionCreate: ansiGreen,
+	ActionUpdate: ansiYellow,
+	ActionDelete: ansiRed,
+}
+
+// Changes returns the field-level differences between the step's Before and After values. The
+// changes of a create have no Before values and those of a delete no After values.
+func (s *Step) Changes() []diff.Change {
+	changes := diff.Compare(s.Before, s.After)
+	for i := range changes {
+		switch s.Action {
+		case ActionCreate:
+			changes[i].Before = nil
+		case ActionDelete:
+			changes[i].After = nil
+		}
+	}
+	return changes
+}
+
+// summary returns a one-line description of the resource a step creates or deletes, for resources
+// that are better described that way than by listing their fields.
+func (s *Step) summary() string {
+	v := s.After
+	if s.Action == ActionDelete {
+		v = s.Before
+	}
+	switch v := v.(type) {
+	case redirecturls.RedirectURL:
+		types := make([]string, len(v.ValidTypes))
+		for i, t := range v.ValidTypes {
+			types[i] = string(t.Type)
+			if t.IsDefault {
+				types[i] += " default"
+			}
+		}
+		return "[" + strings.Join(types, ", ") + "]"
+	case string:
+		return strconv.Quote(v)
+	}
+	return ""
+}
+
+// Count returns the number of steps with the given action.
+func (p *Plan) Count(action Action) int {
+	n := 0
+	for _, s := range p.Steps {
+		if s.Action == action {
+			n++
+		}
+	}
+	return n
+}
+
+// Render writes the plan to w in the given format.
+func (p *Plan) Render(w io.Writer, format Format, opts ...RenderOption) error {
+	var o renderOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	var buf bytes.Buffer
+	switch format {
+	case FormatText:
+		p.renderText(&buf, o.color)
+	case FormatMarkdown:
+		p.renderMarkdown(&buf)
+	case FormatJSON:
+		if err := p.renderJSON(&buf); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown plan format %q", format)
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+func (p *Plan) summaryLine() string {
+	return fmt.Sprintf("%d to create, %d to update, %d to delete.",
+		p.Count(ActionCreate), p.Count(ActionUpdate), p.Count(ActionDelete))
+}
+
+func (p *Plan) renderText(buf *bytes.Buffer, color bool) {
+	paint := func(code, s string) string {
+		if !color {
+			return s
+		}
+		return code + s + ansiReset
+	}
+
+	if p.Empty() {
+		buf.WriteString("No changes. The project matches the desired state.\n")
+	} else {
+		p.writeSteps(buf, paint)
+		fmt.Fprintf(buf, "\n%s %s\n", paint(ansiBold, "Plan:"), p.summaryLine())
+	}
+	if len(p.Warnings) > 0 {
+		fmt.Fprintf(buf, "\n%s\n", paint(ansiBold, "Warnings:"))
+		for _, warning := range p.Warnings {
+			fmt.Fprintf(buf, "  %s %s\n", paint(ansiYellow, "!"), warning)
+		}
+	}
+}
+
+// writeSteps writes one line per step and, below steps that are not summarized, one line per
+// changed field.
+func (p *Plan) writeSteps(buf *bytes.Buffer, paint func(code, s string) string) {
+	for _, s := range p.Steps {
+		symbol := paint(actionColors[s.Action], actionSymbols[s.Action])
+		line := symbol + " " + s.ID
+		summary := s.summary()
+		if summary != "" && s.Action != ActionUpdate {
+			line += " " + summary
+		}
+		buf.WriteString(line + "\n")
+		if s.Action == ActionDelete || (summary != "" && s.Action == ActionCreate) {
+			continue
+		}
+		for _, c := range s.Changes() {
+			symbol := paint(actionColors[ActionUpdate], actionSymbols[ActionUpdate])
+			value := diff.FormatValue(c.Before) + " -> " + diff.FormatValue(c.After)
+			switch {
+			case s.Action == ActionCreate || c.Before == nil:
+				symbol = paint(actionColors[ActionCreate], actionSymbols[ActionCreate])
+				value = diff.FormatValue(c.After)
+			case c.After == nil:
+				symbol = paint(actionColors[ActionDelete], actionSymbols[ActionDelete])
+				value = diff.FormatValue(c.Before)
+			}
+			if c.Path != "" {
+				value = c.Path + ": " + value
+			}
+			fmt.Fprintf(buf, "    %s %s\n", symbol, value)
+		}
+	}
+}
+
+func (p *Plan) renderMarkdown(buf *bytes.Buffer) {
+	fmt.Fprintf(buf, "#### Plan for `%s`\n\n", p.ProjectSlug)
+	if p.Empty() {
+		buf.WriteString("No changes. The project matches the desired state.\n")
+	} else {
+		fmt.Fprintf(buf, "%s\n\n```diff\n", p.summaryLine())
+		p.writeSteps(buf, func(_, s string) string { return s })
+		buf.WriteString("```\n")
+	}
+	if len(p.Warnings) > 0 {
+		buf.WriteString("\n**Warnings**\n\n")
+		for _, warning := range p.Warnings {
+			fmt.Fprintf(buf, "- %s\n", warning)
+		}
+	}
+}
+
+type jsonPlan struct {
+	ProjectSlug string     `json:"project_slug"`
+	Summary     jsonCounts `json:"summary"`
+	Steps       []jsonStep `json:"steps"`
+	Warnings    []string   `json:"warnings"`
+}
+
+type jsonCounts struct {
+	Create int `json:"create"`
+	Update int `json:"update"`
+	Delete int `json:"delete"`
+}
+
+type jsonStep struct {
+	ID          string        `json:"id"`
+	Action      Action        `json:"action"`
+	Resource    Resource      `json:"resource"`
+	Environment string        `json:"environment,omitempty"`
+	Key         string        `json:"key,omitempty"`
+	DependsOn   []string      `json:"depends_on,omitempty"`
+	Changes     []diff.Change `json:"changes"`
+}
+
+func (p *Plan) renderJSON(buf *bytes.Buffer) error {
+	doc := jsonPlan{
+		ProjectSlug: p.ProjectSlug,
+		Summary: jsonCounts{
+			Create: p.Count(ActionCreate),
+			Update: p.Count(ActionUpdate),
+			Delete: p.Count(ActionDelete),
+		},
+		Steps:    []jsonStep{},
+		Warnings: []string{},
+	}
+	doc.Warnings = append(doc.Warnings, p.Warnings...)
+	for _, s := range p.Steps {
+		changes := s.Changes()
+		if changes == nil {
+			changes = []diff.Change{}
+		}
+		doc.Steps = append(doc.Steps, jsonStep{
+			ID:          s.ID,
+			Action:      s.Action,
+			Resource:    s.Resource,
+			Environment: s.Environment,
+			Key:         s.Key,
+			DependsOn:   s.DependsOn,
+			Changes:     changes,
+		})
+	}
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	return enc.Encode(doc)
+}
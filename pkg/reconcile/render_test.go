@@ -0,0 +1,118 @@
+package reconcile_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/redirecturls"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/sdk"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/reconcile"
+)
+
+func TestRender(t *testing.T) {
+	desired := current()
+	desired.Environments[0].RedirectURLs = append(desired.Environments[0].RedirectURLs, redirecturls.RedirectURL{
+		URL: "https://app.example.com/callback",
+		ValidTypes: []redirecturls.URLType{
+			{Type: redirecturls.RedirectURLTypeLogin, IsDefault: true},
+			{Type: redirecturls.RedirectURLTypeSignup},
+		},
+	})
+	desired.Environments[0].B2BSDKConfig = &sdk.B2BConfig{OAuth: &sdk.B2BOAuthConfig{PKCERequired: true}}
+	desired.Environments[0].EventLogStreaming = nil
+	plan := reconcile.Compute(current(), desired)
+
+	t.Run("text", func(t *testing.T) {
+		// Arrange
+		var buf bytes.Buffer
+
+		// Act
+		err := plan.Render(&buf, reconcile.FormatText)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, `+ environment[test].redirect_url[https://app.example.com/callback] [LOGIN default, SIGNUP]
+~ environment[test].sdk_b2b
+    ~ oauth.pkce_required: false -> true
+- environment[test].event_log_streaming[DATADOG]
+
+Plan: 1 to create, 1 to update, 1 to delete.
+`, buf.String())
+	})
+
+	t.Run("text with color", func(t *testing.T) {
+		// Arrange
+		var buf bytes.Buffer
+
+		// Act
+		err := plan.Render(&buf, reconcile.FormatText, reconcile.WithColor())
+
+		// Assert
+		require.NoError(t, err)
+		assert.Contains(t, buf.String(), "\x1b[32m+\x1b[0m environment[test].redirect_url")
+		assert.Contains(t, buf.String(), "\x1b[31m-\x1b[0m environment[test].event_log_streaming")
+	})
+
+	t.Run("markdown", func(t *testing.T) {
+		// Arrange
+		var buf bytes.Buffer
+
+		// Act
+		err := plan.Render(&buf, reconcile.FormatMarkdown)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Contains(t, buf.String(), "#### Plan for `my-project`\n\n1 to create, 1 to update, 1 to delete.\n\n```diff\n+ ")
+		assert.NotContains(t, buf.String(), "\x1b[")
+	})
+
+	t.Run("json", func(t *testing.T) {
+		// Arrange
+		var buf bytes.Buffer
+		var doc struct {
+			Steps []struct {
+				ID      string `json:"id"`
+				Changes []struct {
+					Path   string `json:"path"`
+					Before any    `json:"before"`
+					After  any    `json:"after"`
+				} `json:"changes"`
+			} `json:"steps"`
+		}
+
+		// Act
+		err := plan.Render(&buf, reconcile.FormatJSON)
+
+		// Assert
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+		require.Len(t, doc.Steps, 3)
+		assert.Equal(t, "environment[test].sdk_b2b", doc.Steps[1].ID)
+		require.Len(t, doc.Steps[1].Changes, 1)
+		assert.Equal(t, "oauth.pkce_required", doc.Steps[1].Changes[0].Path)
+		assert.Equal(t, true, doc.Steps[1].Changes[0].After)
+	})
+
+	t.Run("empty plan", func(t *testing.T) {
+		// Arrange
+		var buf bytes.Buffer
+
+		// Act
+		err := reconcile.Compute(current(), current()).Render(&buf, reconcile.FormatText)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "No changes. The project matches the desired state.\n", buf.String())
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		// Act
+		err := plan.Render(&bytes.Buffer{}, "html")
+
+		// Assert
+		assert.ErrorContains(t, err, `unknown plan format "html"`)
+	})
+}
@@ -0,0 +1,104 @@
+// Package schema provides the JSON Schema of the snapshot document format read by pkg/snapshot
+// and pkg/reconcile, so that editors can validate and complete configuration files as they are
+// written, and CI can reject malformed ones before they reach the reconciler.
+//
+// The schema is generated from the pkg/models types, including the allowed values of enumerated
+// fields such as redirect URL types, and is also available as the file snapshot.schema.json in
+// this directory. To have an editor that uses yaml-language-server validate a YAML snapshot, add a
+// comment to the top of the file:
+//
+//	# yaml-language-server: $schema=path/to/snapshot.schema.json
+//
+// Validate checks a document against the schema:
+//
+//	data, err := os.ReadFile("stytch.yaml")
+//	if err := schema.Validate(data); err != nil {
+//		log.Fatal(err) // environments[0].redirect_urls[0].valid_types[0].type: "LOGN" is not one of ...
+//	}
+package schema
+
+import (
+	_ "embed"
+	"encoding/json"
+	"sync"
+)
+
+//go:generate go run ../../internal/cmd/schemagen -root ../..
+
+// Draft is the JSON Schema dialect of the schemas in this package.
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+// Schema is the subset of JSON Schema used to describe the snapshot document format.
+type Schema struct {
+	Schema      string `json:"$schema,omitempty"`
+	Ref         string `json:"$ref,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	// Type is one of "object", "array", "string", "integer", "number" and "boolean", or empty if
+	// any value is allowed.
+	Type    string   `json:"type,omitempty"`
+	Format  string   `json:"format,omitempty"`
+	Enum    []any    `json:"enum,omitempty"`
+	Const   any      `json:"const,omitempty"`
+	Minimum *float64 `json:"minimum,omitempty"`
+	// Properties and Required describe the fields of an object. AdditionalProperties describes
+	// the values of fields not listed in Properties; False rejects them.
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	// PropertyNames restricts the names of an object's fields, such as to the values of an enum.
+	PropertyNames *Schema `json:"propertyNames,omitempty"`
+	// Items describes the elements of an array.
+	Items *Schema `json:"items,omitempty"`
+	// Defs holds the schemas referenced by Ref, such as "#/$defs/sdk.B2BConfig".
+	Defs map[string]*Schema `json:"$defs,omitempty"`
+
+	// boolean is set for the boolean schemas true and false.
+	boolean *bool
+}
+
+// False is the schema that rejects every value. It is used as AdditionalProperties of objects that
+// do not allow unknown fields.
+var False = &Schema{boolean: new(bool)}
+
+// MarshalJSON encodes boolean schemas as true or false.
+func (s *Schema) MarshalJSON() ([]byte, error) {
+	if s.boolean != nil {
+		return json.Marshal(*s.boolean)
+	}
+	type plain Schema
+	return json.Marshal((*plain)(s))
+}
+
+// UnmarshalJSON decodes boolean schemas as well as objects.
+func (s *Schema) UnmarshalJSON(data []byte) error {
+	var b bool
+	if err := json.Unmarshal(data, &b); err == nil {
+		*s = Schema{boolean: &b}
+		return nil
+	}
+	type plain Schema
+	return json.Unmarshal(data, (*plain)(s))
+}
+
+//go:embed snapshot.schema.json
+var snapshotJSON []byte
+
+var snapshotSchema = sync.OnceValue(func() *Schema {
+	var s Schema
+	if err := json.Unmarshal(snapshotJSON, &s); err != nil {
+		panic("schema: decoding snapshot.schema.json: " + err.Error())
+	}
+	return &s
+})
+
+// SnapshotJSON returns the schema of the snapshot document as indented JSON.
+func SnapshotJSON() []byte {
+	return append([]byte(nil), snapshotJSON...)
+}
+
+// Snapshot returns the schema of the snapshot document. The returned schema is shared and must not
+// be modified.
+func Snapshot() *Schema {
+	return snapshotSchema()
+}
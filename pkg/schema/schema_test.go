@@ -0,0 +1,177 @@
+package schema_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/emailtemplates"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/environments"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/rbacpolicy"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/redirecturls"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/sdk"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/trustedtokenprofiles"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/schema"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/snapshot"
+)
+
+func TestSnapshot(t *testing.T) {
+	// Act
+	s := schema.Snapshot()
+
+	// Assert
+	assert.Equal(t, schema.Draft, s.Schema)
+	def := s.Defs["redirecturls.URLType"]
+	require.NotNil(t, def)
+	var values []string
+	for _, v := range def.Properties["type"].Enum {
+		values = append(values, v.(string))
+	}
+	var want []string
+	for _, v := range redirecturls.RedirectURLTypes() {
+		want = append(want, string(v))
+	}
+	assert.Equal(t, want, values)
+	assert.Len(t, s.Defs["sdk.B2BDFPPAConfig"].Properties["enabled"].Enum, len(sdk.DFPPASettings()))
+}
+
+func TestValidate(t *testing.T) {
+	t.Run("snapshots are valid", func(t *testing.T) {
+		// Arrange
+		snap := &snapshot.Snapshot{
+			Version: snapshot.FormatVersion,
+			Project: projects.Project{ProjectSlug: "my-project", Vertical: projects.VerticalB2B},
+			EmailTemplates: []emailtemplates.EmailTemplate{{
+				TemplateID: "welcome",
+			}},
+			DefaultEmailTemplates: map[emailtemplates.TemplateType]string{emailtemplates.TemplateTypeLogin: "welcome"},
+			Environments: []snapshot.Environment{{
+				Settings: environments.Environment{
+					EnvironmentSlug:   "test",
+					Type:              environments.EnvironmentTypeTest,
+					UserLockThreshold: 5,
+					CreatedAt:         time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC),
+				},
+				RedirectURLs: []redirecturls.RedirectURL{{
+					URL:        "https://example.com/callback",
+					ValidTypes: []redirecturls.URLType{{Type: redirecturls.RedirectURLTypeLogin, IsDefault: true}},
+				}},
+				B2BSDKConfig: &sdk.B2BConfig{
+					DFPPA: &sdk.B2BDFPPAConfig{Enabled: sdk.DFPPASettingPassive},
+				},
+				RBACPolicy: rbacpolicy.Policy{CustomRoles: []rbacpolicy.Role{{RoleID: "editor"}}},
+				TrustedTokenProfiles: []trustedtokenprofiles.TrustedTokenProfile{{
+					Name:             "Okta",
+					AttributeMapping: &map[string]any{"email": "email", "nested": map[string]any{"a": 1}},
+				}},
+			}},
+		}
+		data, err := snap.YAML()
+		require.NoError(t, err)
+
+		// Act
+		err = schema.Validate(data)
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("optional fields can be null", func(t *testing.T) {
+		// Arrange
+		data := []byte(`
+version: 1
+project: {project_slug: my-project}
+environments:
+  - settings: {environment_slug: test}
+    rbac_policy:
+    trusted_token_profiles:
+      - name: Okta
+        jwks_url:
+`)
+		_, err := snapshot.Parse(data)
+		require.NoError(t, err)
+
+		// Act
+		err = schema.Validate(data)
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	for name, tc := range map[string]struct {
+		doc  string
+		want []string
+	}{
+		"enum values": {
+			doc: `
+version: 1
+project: {project_slug: my-project}
+environments:
+  - settings: {environment_slug: test}
+    redirect_urls:
+      - url: https://example.com/callback
+        valid_types: [{type: LOGN}]
+`,
+			want: []string{
+				`environments[0].redirect_urls[0].valid_types[0].type: "LOGN" is not one of "LOGIN", "INVITE", "SIGNUP", "RESET_PASSWORD", "DISCOVERY"`,
+			},
+		},
+		"unknown fields and wrong types": {
+			doc: `
+version: 1
+project: {project_slug: my-project, vertikal: B2B}
+environments:
+  - settings: {environment_slug: test, user_lock_threshold: five}
+`,
+			want: []string{
+				"environments[0].settings.user_lock_threshold: expected an integer, got a string",
+				"project.vertikal: unknown field",
+			},
+		},
+		"version": {
+			doc:  "project: {project_slug: my-project}\n",
+			want: []string{"missing required field version"},
+		},
+		"null required fields": {
+			doc:  "version:\n",
+			want: []string{"version: expected an integer, got null"},
+		},
+		"unsupported version": {
+			doc:  "version: 2\n",
+			want: []string{"version: must be 1"},
+		},
+		"map keys": {
+			doc: `
+version: 1
+default_email_templates: {LOGIN: welcome, WELCOME: welcome}
+`,
+			want: []string{
+				`default_email_templates[WELCOME]: "WELCOME" is not one of "LOGIN", "SIGNUP", "INVITE", "RESET_PASSWORD", "ONE_TIME_PASSCODE", "ONE_TIME_PASSCODE_SIGNUP", "VERIFY_EMAIL_PASSWORD_RESET", "UNLOCK", "PREBUILT"`,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// Act
+			err := schema.Validate([]byte(tc.doc))
+
+			// Assert
+			var verr *schema.ValidationError
+			require.ErrorAs(t, err, &verr)
+			var got []string
+			for _, p := range verr.Problems {
+				got = append(got, p.String())
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+
+	t.Run("syntax errors", func(t *testing.T) {
+		// Act
+		err := schema.Validate([]byte("version: [1"))
+
+		// Assert
+		assert.ErrorContains(t, err, "parsing document")
+	})
+}
@@ -0,0 +1,234 @@
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Problem is a single way in which a document does not match the schema.
+type Problem struct {
+	// Path locates the value in the document, such as "environments[0].redirect_urls[0].url". It is
+	// empty for the document itself.
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+// String formats the problem as "path: message".
+func (p Problem) String() string {
+	if p.Path == "" {
+		return p.Message
+	}
+	return p.Path + ": " + p.Message
+}
+
+// ValidationError lists the problems found in a document.
+type ValidationError struct {
+	Problems []Problem
+}
+
+// Error returns the problems, one per line.
+func (e *ValidationError) Error() string {
+	lines := make([]string, len(e.Problems))
+	for i, p := range e.Problems {
+		lines[i] = p.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Validate checks a JSON or YAML snapshot document against the schema returned by Snapshot. It
+// returns a *ValidationError listing every problem found, or an error if data cannot be parsed.
+// Like snapshot.Parse, it accepts null as the value of any field that is not required.
+func Validate(data []byte) error {
+	var doc any
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("parsing document: %w", err)
+	}
+	// Round-trip through JSON so that values have the types a JSON document decodes to.
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return fmt.Errorf("parsing document: %w", err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(&doc); err != nil {
+		return fmt.Errorf("parsing document: %w", err)
+	}
+
+	root := Snapshot()
+	v := &validator{root: root}
+	v.validate("", doc, root)
+	if len(v.problems) > 0 {
+		return &ValidationError{Problems: v.problems}
+	}
+	return nil
+}
+
+type validator struct {
+	root     *Schema
+	problems []Problem
+}
+
+func (v *validator) problem(path, format string, args ...any) {
+	v.problems = append(v.problems, Problem{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) validate(path string, value any, s *Schema) {
+	if s.boolean != nil {
+		if !*s.boolean {
+			v.problem(path, "no value is allowed here")
+		}
+		return
+	}
+	if s.Ref != "" {
+		def, ok := v.root.Defs[strings.TrimPrefix(s.Ref, "#/$defs/")]
+		if !ok {
+			v.problem(path, "schema reference %s not found", s.Ref)
+			return
+		}
+		v.validate(path, value, def)
+	}
+	if s.Type != "" && !hasType(value, s.Type) {
+		v.problem(path, "expected %s, got %s", article(s.Type), describe(value))
+		return
+	}
+	if s.Const != nil && !equal(value, s.Const) {
+		v.problem(path, "must be %s", format(s.Const))
+	}
+	if len(s.Enum) > 0 && !slices.ContainsFunc(s.Enum, func(e any) bool { return equal(value, e) }) {
+		allowed := make([]string, len(s.Enum))
+		for i, e := range s.Enum {
+			allowed[i] = format(e)
+		}
+		v.problem(path, "%s is not one of %s", format(value), strings.Join(allowed, ", "))
+	}
+	if s.Minimum != nil {
+		if n, ok := value.(json.Number); ok {
+			if f, err := n.Float64(); err == nil && f < *s.Minimum {
+				v.problem(path, "must be at least %v", *s.Minimum)
+			}
+		}
+	}
+	if s.Format == "date-time" {
+		if str, ok := value.(string); ok {
+			if _, err := time.Parse(time.RFC3339, str); err != nil {
+				v.problem(path, "%q is not an RFC 3339 date-time", str)
+			}
+		}
+	}
+
+	switch value := value.(type) {
+	case map[string]any:
+		v.validateObject(path, value, s)
+	case []any:
+		if s.Items != nil {
+			for i, item := range value {
+				v.validate(fmt.Sprintf("%s[%d]", path, i), item, s.Items)
+			}
+		}
+	}
+}
+
+func (v *validator) validateObject(path string, value map[string]any, s *Schema) {
+	for _, name := range s.Required {
+		if _, ok := value[name]; !ok {
+			v.problem(path, "missing required field %s", name)
+		}
+	}
+	keys := make([]string, 0, len(value))
+	for k := range value {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		child := k
+		if path != "" {
+			child = path + "." + k
+		}
+		if s.PropertyNames != nil {
+			v.validate(fmt.Sprintf("%s[%s]", path, k), k, s.PropertyNames)
+		}
+		if prop, ok := s.Properties[k]; ok {
+			// snapshot.Parse leaves optional fields that are null unset, as in "jwks_url:" in YAML.
+			if value[k] != nil || slices.Contains(s.Required, k) {
+				v.validate(child, value[k], prop)
+			}
+			continue
+		}
+		switch {
+		case s.AdditionalProperties == nil:
+		case s.AdditionalProperties.boolean != nil && !*s.AdditionalProperties.boolean:
+			v.problem(child, "unknown field")
+		default:
+			v.validate(child, value[k], s.AdditionalProperties)
+		}
+	}
+}
+
+func hasType(value any, typ string) bool {
+	switch value := value.(type) {
+	case map[string]any:
+		return typ == "object"
+	case []any:
+		return typ == "array"
+	case string:
+		return typ == "string"
+	case bool:
+		return typ == "boolean"
+	case json.Number:
+		if typ == "number" {
+			return true
+		}
+		_, err := value.Int64()
+		return typ == "integer" && err == nil
+	}
+	return false
+}
+
+func article(typ string) string {
+	switch typ {
+	case "object", "array", "integer":
+		return "an " + typ
+	}
+	return "a " + typ
+}
+
+func describe(value any) string {
+	switch value.(type) {
+	case map[string]any:
+		return "an object"
+	case []any:
+		return "an array"
+	case string:
+		return "a string"
+	case bool:
+		return "a boolean"
+	case json.Number:
+		return "a number"
+	case nil:
+		return "null"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+// equal compares a document value with a value from the schema. Numbers are compared by their
+// decimal representation.
+func equal(value, want any) bool {
+	return format(value) == format(want)
+}
+
+func format(v any) string {
+	switch v := v.(type) {
+	case string:
+		return fmt.Sprintf("%q", v)
+	case json.Number:
+		return v.String()
+	}
+	return fmt.Sprint(v)
+}
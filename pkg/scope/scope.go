@@ -0,0 +1,175 @@
+// Package scope restricts an api.Interface to a set of projects, and optionally to environments of
+// some types, so that automation sharing a workspace key with other teams can only touch its own
+// projects:
+//
+//	client, err := scope.New(api.NewClient(keyID, keySecret), scope.Scope{
+//		Projects:         []string{"payments-*"},
+//		EnvironmentTypes: []environments.EnvironmentType{environments.EnvironmentTypeTest},
+//	})
+//
+// Calls outside the scope fail with an error wrapping ErrOutOfScope, without sending a request.
+// Projects.GetAll and Environments.GetAll return only the projects and environments in scope.
+package scope
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/internal/envtype"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/internal/request"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/environments"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
+)
+
+// ErrOutOfScope is returned, wrapped, for calls outside the scope of an API.
+var ErrOutOfScope = errors.New("out of scope")
+
+// Scope selects the projects and environments an API may touch.
+type Scope struct {
+	// Projects are the slugs of the projects in scope. They may be patterns as accepted by
+	// path.Match, such as "payments-*".
+	Projects []string
+	// EnvironmentTypes are the types of the environments in scope. If empty, environments of every
+	// type are in scope.
+	//
+	// Operations on a project rather than an environment, such as Projects.Update, are only
+	// restricted by Projects, except for Projects.Delete: it deletes every environment of the
+	// project, including its LIVE environment, so it is only in scope if LIVE environments are.
+	EnvironmentTypes []environments.EnvironmentType
+}
+
+// Contains reports whether a project is in scope.
+func (s Scope) Contains(projectSlug string) bool {
+	for _, pattern := range s.Projects {
+		if ok, _ := path.Match(pattern, projectSlug); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsType reports whether environments of a type are in scope.
+func (s Scope) ContainsType(envType environments.EnvironmentType) bool {
+	return len(s.EnvironmentTypes) == 0 || slices.Contains(s.EnvironmentTypes, envType)
+}
+
+// API is an api.Interface that checks that every call is in scope before passing it to the client
+// it wraps. Create one with New.
+type API struct {
+	scope  Scope
+	client api.Interface
+	// clients holds the wrapped resource clients, built by newClients.
+	clients
+
+	// types caches the types of the environments the calls are on.
+	types envtype.Cache
+}
+
+// New returns an API that passes calls in scope to client, and fails the others.
+func New(client api.Interface, scope Scope) (*API, error) {
+	if len(scope.Projects) == 0 {
+		return nil, errors.New("scope: no projects")
+	}
+	for _, pattern := range scope.Projects {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("scope: project pattern %q: %w", pattern, err)
+		}
+	}
+	a := &API{scope: scope, client: client}
+	a.clients = newClients(a, client)
+	return a, nil
+}
+
+// Scope returns the scope of the API.
+func (a *API) Scope() Scope {
+	return a.scope
+}
+
+// check returns an error wrapping ErrOutOfScope if a call to operation with body is out of scope.
+// The project and environment are read from the ProjectSlug and EnvironmentSlug fields of body.
+func (a *API) check(ctx context.Context, operation string, body any) error {
+	projectSlug, hasProject := request.StringField(body, "ProjectSlug")
+	envSlug, _ := request.StringField(body, "EnvironmentSlug")
+	switch {
+	case operation == "Projects.GetAll":
+		// The response is filtered instead.
+		return nil
+	case !hasProject || projectSlug == "":
+		return fmt.Errorf("%w: %s does not name a project", ErrOutOfScope, operation)
+	case !a.scope.Contains(projectSlug):
+		return fmt.Errorf("%w: %s on project %s", ErrOutOfScope, operation, projectSlug)
+	case len(a.scope.EnvironmentTypes) == 0:
+		return nil
+	}
+
+	switch {
+	case operation == "Projects.Delete":
+		if !a.scope.ContainsType(environments.EnvironmentTypeLive) {
+			return fmt.Errorf("%w: %s deletes the LIVE environment of project %s", ErrOutOfScope, operation, projectSlug)
+		}
+	case operation == "Environments.Create":
+		envType := body.(environments.CreateRequest).Type
+		if !a.scope.ContainsType(envType) {
+			return fmt.Errorf("%w: %s of a %s environment in project %s", ErrOutOfScope, operation, envType, projectSlug)
+		}
+	case envSlug != "":
+		envType, err := a.environmentType(ctx, projectSlug, envSlug)
+		if err != nil {
+			return fmt.Errorf("scope: resolving the type of environment %s: %w", envSlug, err)
+		}
+		if !a.scope.ContainsType(envType) {
+			return fmt.Errorf("%w: %s on %s environment %s of project %s", ErrOutOfScope, operation, envType, envSlug, projectSlug)
+		}
+	}
+	return nil
+}
+
+// filter removes the projects and environments out of scope from a response.
+func (a *API) filter(resp any) {
+	switch r := resp.(type) {
+	case *projects.GetAllResponse:
+		if r == nil {
+			return
+		}
+		r.Projects = slices.DeleteFunc(r.Projects, func(p projects.Project) bool {
+			return !a.scope.Contains(p.ProjectSlug)
+		})
+	case *environments.GetAllResponse:
+		if r == nil {
+			return
+		}
+		r.Environments = slices.DeleteFunc(r.Environments, func(e environments.Environment) bool {
+			return !a.scope.ContainsType(e.Type)
+		})
+	}
+}
+
+// done is called with the result of every call passed to the wrapped client.
+func (a *API) done(operation string, body, resp any, err error) {
+	if err != nil {
+		return
+	}
+	a.filter(resp)
+	projectSlug, envSlug := request.Slugs(body)
+	a.types.Done(operation, projectSlug, envSlug)
+}
+
+// environmentType returns the type of an environment, looking it up unless it is cached.
+func (a *API) environmentType(ctx context.Context, projectSlug, envSlug string) (environments.EnvironmentType, error) {
+	return a.types.Get(ctx, projectSlug, envSlug, func(
+		ctx context.Context, projectSlug, envSlug string,
+	) (environments.EnvironmentType, error) {
+		resp, err := a.client.EnvironmentsAPI().Get(ctx, environments.GetRequest{
+			ProjectSlug:     projectSlug,
+			EnvironmentSlug: envSlug,
+		})
+		if err != nil {
+			return "", err
+		}
+		return resp.Environment.Type, nil
+	})
+}
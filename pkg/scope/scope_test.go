@@ -0,0 +1,226 @@
+package scope_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/apifake"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/environments"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/secrets"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/scope"
+)
+
+// newFake returns a fake whose environments "production" are LIVE and whose other environments
+// are TEST.
+func newFake() *apifake.API {
+	fake := apifake.New()
+	fake.Environments.GetFunc = func(_ context.Context, body environments.GetRequest) (*environments.GetResponse, error) {
+		envType := environments.EnvironmentTypeTest
+		if body.EnvironmentSlug == "production" {
+			envType = environments.EnvironmentTypeLive
+		}
+		return &environments.GetResponse{Environment: environments.Environment{
+			ProjectSlug:     body.ProjectSlug,
+			EnvironmentSlug: body.EnvironmentSlug,
+			Type:            envType,
+		}}, nil
+	}
+	return fake
+}
+
+func deleteSecret(project, env string) secrets.DeleteRequest {
+	return secrets.DeleteRequest{ProjectSlug: project, EnvironmentSlug: env, SecretID: "secret-1"}
+}
+
+func TestNew(t *testing.T) {
+	t.Run("no projects", func(t *testing.T) {
+		// Act
+		_, err := scope.New(apifake.New(), scope.Scope{})
+
+		// Assert
+		assert.EqualError(t, err, "scope: no projects")
+	})
+
+	t.Run("bad pattern", func(t *testing.T) {
+		// Act
+		_, err := scope.New(apifake.New(), scope.Scope{Projects: []string{"team-["}})
+
+		// Assert
+		assert.ErrorContains(t, err, `scope: project pattern "team-["`)
+	})
+}
+
+func TestAPI(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("passes calls in scope", func(t *testing.T) {
+		// Arrange
+		fake := newFake()
+		client, err := scope.New(fake, scope.Scope{Projects: []string{"payments", "team-*"}})
+		require.NoError(t, err)
+
+		// Act
+		_, err1 := client.SecretsAPI().Delete(ctx, deleteSecret("payments", "production"))
+		_, err2 := client.ProjectsAPI().Update(ctx, projects.UpdateRequest{ProjectSlug: "team-a"})
+
+		// Assert
+		require.NoError(t, err1)
+		require.NoError(t, err2)
+		assert.Len(t, fake.Secrets.DeleteCalls(), 1)
+		assert.Len(t, fake.Projects.UpdateCalls(), 1)
+	})
+
+	t.Run("fails calls on other projects", func(t *testing.T) {
+		// Arrange
+		fake := newFake()
+		client, err := scope.New(fake, scope.Scope{Projects: []string{"team-*"}})
+		require.NoError(t, err)
+
+		// Act
+		_, err = client.SecretsAPI().Delete(ctx, deleteSecret("payments", "test"))
+
+		// Assert
+		require.ErrorIs(t, err, scope.ErrOutOfScope)
+		assert.EqualError(t, err, "out of scope: Secrets.Delete on project payments")
+		assert.Empty(t, fake.Calls())
+	})
+
+	t.Run("fails calls that do not name a project", func(t *testing.T) {
+		// Arrange
+		fake := newFake()
+		client, err := scope.New(fake, scope.Scope{Projects: []string{"*"}})
+		require.NoError(t, err)
+
+		// Act
+		_, err = client.ProjectsAPI().Create(ctx, projects.CreateRequest{Name: "New project"})
+
+		// Assert
+		assert.ErrorIs(t, err, scope.ErrOutOfScope)
+		assert.Empty(t, fake.Calls())
+	})
+
+	t.Run("passes project creation with a slug in scope", func(t *testing.T) {
+		// Arrange
+		fake := newFake()
+		client, err := scope.New(fake, scope.Scope{Projects: []string{"team-*"}})
+		require.NoError(t, err)
+		slug := "team-b"
+
+		// Act
+		_, err = client.ProjectsAPI().Create(ctx, projects.CreateRequest{Name: "Team B", ProjectSlug: &slug})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Len(t, fake.Projects.CreateCalls(), 1)
+	})
+
+	t.Run("fails calls on environments of other types", func(t *testing.T) {
+		// Arrange
+		fake := newFake()
+		client, err := scope.New(fake, scope.Scope{
+			Projects:         []string{"payments"},
+			EnvironmentTypes: []environments.EnvironmentType{environments.EnvironmentTypeTest},
+		})
+		require.NoError(t, err)
+
+		// Act
+		_, errLive := client.SecretsAPI().Delete(ctx, deleteSecret("payments", "production"))
+		_, errTest := client.SecretsAPI().Delete(ctx, deleteSecret("payments", "test"))
+		_, errCreate := client.EnvironmentsAPI().Create(ctx, environments.CreateRequest{
+			ProjectSlug: "payments",
+			Type:        environments.EnvironmentTypeLive,
+		})
+		_, errDelete := client.ProjectsAPI().Delete(ctx, projects.DeleteRequest{ProjectSlug: "payments"})
+
+		// Assert
+		assert.EqualError(t, errLive, "out of scope: Secrets.Delete on LIVE environment production of project payments")
+		assert.NoError(t, errTest)
+		assert.ErrorIs(t, errCreate, scope.ErrOutOfScope)
+		assert.EqualError(t, errDelete, "out of scope: Projects.Delete deletes the LIVE environment of project payments")
+		assert.Len(t, fake.Secrets.DeleteCalls(), 1)
+		assert.Empty(t, fake.Environments.CreateCalls())
+		assert.Empty(t, fake.Projects.DeleteCalls())
+	})
+
+	t.Run("caches environment types", func(t *testing.T) {
+		// Arrange
+		fake := newFake()
+		client, err := scope.New(fake, scope.Scope{
+			Projects:         []string{"payments"},
+			EnvironmentTypes: []environments.EnvironmentType{environments.EnvironmentTypeTest},
+		})
+		require.NoError(t, err)
+
+		// Act
+		_, err1 := client.SecretsAPI().Delete(ctx, deleteSecret("payments", "test"))
+		_, err2 := client.SecretsAPI().Delete(ctx, deleteSecret("payments", "test"))
+
+		// Assert
+		require.NoError(t, err1)
+		require.NoError(t, err2)
+		assert.Len(t, fake.Environments.GetCalls(), 1)
+	})
+
+	t.Run("fails calls when the type cannot be resolved", func(t *testing.T) {
+		// Arrange
+		fake := newFake()
+		fake.Environments.GetFunc = nil
+		fake.Environments.GetReturns(nil, errors.New("boom"))
+		client, err := scope.New(fake, scope.Scope{
+			Projects:         []string{"payments"},
+			EnvironmentTypes: []environments.EnvironmentType{environments.EnvironmentTypeTest},
+		})
+		require.NoError(t, err)
+
+		// Act
+		_, err = client.SecretsAPI().Delete(ctx, deleteSecret("payments", "test"))
+
+		// Assert
+		assert.EqualError(t, err, "scope: resolving the type of environment test: boom")
+		assert.Empty(t, fake.Secrets.DeleteCalls())
+	})
+
+	t.Run("filters projects", func(t *testing.T) {
+		// Arrange
+		fake := newFake()
+		fake.Projects.GetAllReturns(&projects.GetAllResponse{Projects: []projects.Project{
+			{ProjectSlug: "payments"},
+			{ProjectSlug: "team-a"},
+			{ProjectSlug: "team-b"},
+		}}, nil)
+		client, err := scope.New(fake, scope.Scope{Projects: []string{"team-*"}})
+		require.NoError(t, err)
+
+		// Act
+		resp, err := client.ProjectsAPI().GetAll(ctx, projects.GetAllRequest{})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []projects.Project{{ProjectSlug: "team-a"}, {ProjectSlug: "team-b"}}, resp.Projects)
+	})
+
+	t.Run("filters environments", func(t *testing.T) {
+		// Arrange
+		fake := newFake()
+		fake.Environments.GetAllReturns(&environments.GetAllResponse{Environments: []environments.Environment{
+			{EnvironmentSlug: "production", Type: environments.EnvironmentTypeLive},
+			{EnvironmentSlug: "test", Type: environments.EnvironmentTypeTest},
+		}}, nil)
+		client, err := scope.New(fake, scope.Scope{
+			Projects:         []string{"payments"},
+			EnvironmentTypes: []environments.EnvironmentType{environments.EnvironmentTypeTest},
+		})
+		require.NoError(t, err)
+
+		// Act
+		resp, err := client.EnvironmentsAPI().GetAll(ctx, environments.GetAllRequest{ProjectSlug: "payments"})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []environments.Environment{{EnvironmentSlug: "test", Type: environments.EnvironmentTypeTest}}, resp.Environments)
+	})
+}
@@ -0,0 +1,760 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package scope
+
+import (
+	"context"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/countrycodeallowlist"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/emailtemplates"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/environments"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/eventlogstreaming"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/jwttemplates"
+	migrationprojects "github.com/stytchauth/stytch-management-go/v3/pkg/models/migration/projects"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/passwordstrengthconfig"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/publictokens"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/rbacpolicy"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/redirecturls"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/sdk"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/secrets"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/trustedtokenprofiles"
+)
+
+// clients holds the resource clients of an API, which check every call before passing it on.
+type clients struct {
+	countryCodeAllowlist   *countryCodeAllowlistClient
+	emailTemplates         *emailTemplatesClient
+	environments           *environmentsClient
+	eventLogStreaming      *eventLogStreamingClient
+	jwtTemplates           *jwtTemplatesClient
+	passwordStrengthConfig *passwordStrengthConfigClient
+	projects               *projectsClient
+	publicTokens           *publicTokensClient
+	rbacPolicy             *rbacPolicyClient
+	redirectURLs           *redirectURLsClient
+	sdk                    *sdkClient
+	secrets                *secretsClient
+	trustedTokenProfiles   *trustedTokenProfilesClient
+	v1ToV3MigrationClient  *v1ToV3MigrationClient
+}
+
+func newClients(a *API, client api.Interface) clients {
+	return clients{
+		countryCodeAllowlist:   &countryCodeAllowlistClient{api: a, client: client.CountryCodeAllowlistAPI()},
+		emailTemplates:         &emailTemplatesClient{api: a, client: client.EmailTemplatesAPI()},
+		environments:           &environmentsClient{api: a, client: client.EnvironmentsAPI()},
+		eventLogStreaming:      &eventLogStreamingClient{api: a, client: client.EventLogStreamingAPI()},
+		jwtTemplates:           &jwtTemplatesClient{api: a, client: client.JWTTemplatesAPI()},
+		passwordStrengthConfig: &passwordStrengthConfigClient{api: a, client: client.PasswordStrengthConfigAPI()},
+		projects:               &projectsClient{api: a, client: client.ProjectsAPI()},
+		publicTokens:           &publicTokensClient{api: a, client: client.PublicTokensAPI()},
+		rbacPolicy:             &rbacPolicyClient{api: a, client: client.RBACPolicyAPI()},
+		redirectURLs:           &redirectURLsClient{api: a, client: client.RedirectURLsAPI()},
+		sdk:                    &sdkClient{api: a, client: client.SDKAPI()},
+		secrets:                &secretsClient{api: a, client: client.SecretsAPI()},
+		trustedTokenProfiles:   &trustedTokenProfilesClient{api: a, client: client.TrustedTokenProfilesAPI()},
+		v1ToV3MigrationClient:  &v1ToV3MigrationClient{api: a, client: client.V1ToV3MigrationAPI()},
+	}
+}
+
+var _ api.Interface = (*API)(nil)
+
+// CountryCodeAllowlistAPI implements api.Interface.
+func (a *API) CountryCodeAllowlistAPI() api.CountryCodeAllowlistAPI {
+	return a.countryCodeAllowlist
+}
+
+// EmailTemplatesAPI implements api.Interface.
+func (a *API) EmailTemplatesAPI() api.EmailTemplatesAPI {
+	return a.emailTemplates
+}
+
+// EnvironmentsAPI implements api.Interface.
+func (a *API) EnvironmentsAPI() api.EnvironmentsAPI {
+	return a.environments
+}
+
+// EventLogStreamingAPI implements api.Interface.
+func (a *API) EventLogStreamingAPI() api.EventLogStreamingAPI {
+	return a.eventLogStreaming
+}
+
+// JWTTemplatesAPI implements api.Interface.
+func (a *API) JWTTemplatesAPI() api.JWTTemplatesAPI {
+	return a.jwtTemplates
+}
+
+// PasswordStrengthConfigAPI implements api.Interface.
+func (a *API) PasswordStrengthConfigAPI() api.PasswordStrengthConfigAPI {
+	return a.passwordStrengthConfig
+}
+
+// ProjectsAPI implements api.Interface.
+func (a *API) ProjectsAPI() api.ProjectsAPI {
+	return a.projects
+}
+
+// PublicTokensAPI implements api.Interface.
+func (a *API) PublicTokensAPI() api.PublicTokensAPI {
+	return a.publicTokens
+}
+
+// RBACPolicyAPI implements api.Interface.
+func (a *API) RBACPolicyAPI() api.RBACPolicyAPI {
+	return a.rbacPolicy
+}
+
+// RedirectURLsAPI implements api.Interface.
+func (a *API) RedirectURLsAPI() api.RedirectURLsAPI {
+	return a.redirectURLs
+}
+
+// SDKAPI implements api.Interface.
+func (a *API) SDKAPI() api.SDKAPI {
+	return a.sdk
+}
+
+// SecretsAPI implements api.Interface.
+func (a *API) SecretsAPI() api.SecretsAPI {
+	return a.secrets
+}
+
+// TrustedTokenProfilesAPI implements api.Interface.
+func (a *API) TrustedTokenProfilesAPI() api.TrustedTokenProfilesAPI {
+	return a.trustedTokenProfiles
+}
+
+// V1ToV3MigrationAPI implements api.Interface.
+func (a *API) V1ToV3MigrationAPI() api.V1ToV3MigrationAPI {
+	return a.v1ToV3MigrationClient
+}
+
+type countryCodeAllowlistClient struct {
+	api    *API
+	client api.CountryCodeAllowlistAPI
+}
+
+func (c *countryCodeAllowlistClient) GetAllowedSMSCountryCodes(ctx context.Context, body countrycodeallowlist.GetAllowedSMSCountryCodesRequest) (*countrycodeallowlist.GetAllowedSMSCountryCodesResponse, error) {
+	if err := c.api.check(ctx, "CountryCodeAllowlist.GetAllowedSMSCountryCodes", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.GetAllowedSMSCountryCodes(ctx, body)
+	c.api.done("CountryCodeAllowlist.GetAllowedSMSCountryCodes", body, resp, err)
+	return resp, err
+}
+
+func (c *countryCodeAllowlistClient) GetAllowedWhatsAppCountryCodes(ctx context.Context, body countrycodeallowlist.GetAllowedWhatsAppCountryCodesRequest) (*countrycodeallowlist.GetAllowedWhatsAppCountryCodesResponse, error) {
+	if err := c.api.check(ctx, "CountryCodeAllowlist.GetAllowedWhatsAppCountryCodes", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.GetAllowedWhatsAppCountryCodes(ctx, body)
+	c.api.done("CountryCodeAllowlist.GetAllowedWhatsAppCountryCodes", body, resp, err)
+	return resp, err
+}
+
+func (c *countryCodeAllowlistClient) SetAllowedSMSCountryCodes(ctx context.Context, body countrycodeallowlist.SetAllowedSMSCountryCodesRequest) (*countrycodeallowlist.SetAllowedSMSCountryCodesResponse, error) {
+	if err := c.api.check(ctx, "CountryCodeAllowlist.SetAllowedSMSCountryCodes", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.SetAllowedSMSCountryCodes(ctx, body)
+	c.api.done("CountryCodeAllowlist.SetAllowedSMSCountryCodes", body, resp, err)
+	return resp, err
+}
+
+func (c *countryCodeAllowlistClient) SetAllowedWhatsAppCountryCodes(ctx context.Context, body countrycodeallowlist.SetAllowedWhatsAppCountryCodesRequest) (*countrycodeallowlist.SetAllowedWhatsAppCountryCodesResponse, error) {
+	if err := c.api.check(ctx, "CountryCodeAllowlist.SetAllowedWhatsAppCountryCodes", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.SetAllowedWhatsAppCountryCodes(ctx, body)
+	c.api.done("CountryCodeAllowlist.SetAllowedWhatsAppCountryCodes", body, resp, err)
+	return resp, err
+}
+
+type emailTemplatesClient struct {
+	api    *API
+	client api.EmailTemplatesAPI
+}
+
+func (c *emailTemplatesClient) Create(ctx context.Context, body emailtemplates.CreateRequest) (*emailtemplates.CreateResponse, error) {
+	if err := c.api.check(ctx, "EmailTemplates.Create", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Create(ctx, body)
+	c.api.done("EmailTemplates.Create", body, resp, err)
+	return resp, err
+}
+
+func (c *emailTemplatesClient) Delete(ctx context.Context, body emailtemplates.DeleteRequest) (*emailtemplates.DeleteResponse, error) {
+	if err := c.api.check(ctx, "EmailTemplates.Delete", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Delete(ctx, body)
+	c.api.done("EmailTemplates.Delete", body, resp, err)
+	return resp, err
+}
+
+func (c *emailTemplatesClient) Get(ctx context.Context, body emailtemplates.GetRequest) (*emailtemplates.GetResponse, error) {
+	if err := c.api.check(ctx, "EmailTemplates.Get", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Get(ctx, body)
+	c.api.done("EmailTemplates.Get", body, resp, err)
+	return resp, err
+}
+
+func (c *emailTemplatesClient) GetAll(ctx context.Context, body emailtemplates.GetAllRequest) (*emailtemplates.GetAllResponse, error) {
+	if err := c.api.check(ctx, "EmailTemplates.GetAll", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.GetAll(ctx, body)
+	c.api.done("EmailTemplates.GetAll", body, resp, err)
+	return resp, err
+}
+
+func (c *emailTemplatesClient) GetDefault(ctx context.Context, body emailtemplates.GetDefaultRequest) (*emailtemplates.GetDefaultResponse, error) {
+	if err := c.api.check(ctx, "EmailTemplates.GetDefault", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.GetDefault(ctx, body)
+	c.api.done("EmailTemplates.GetDefault", body, resp, err)
+	return resp, err
+}
+
+func (c *emailTemplatesClient) SetDefault(ctx context.Context, body emailtemplates.SetDefaultRequest) (*emailtemplates.SetDefaultResponse, error) {
+	if err := c.api.check(ctx, "EmailTemplates.SetDefault", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.SetDefault(ctx, body)
+	c.api.done("EmailTemplates.SetDefault", body, resp, err)
+	return resp, err
+}
+
+func (c *emailTemplatesClient) UnsetDefault(ctx context.Context, body emailtemplates.UnsetDefaultRequest) (*emailtemplates.UnsetDefaultResponse, error) {
+	if err := c.api.check(ctx, "EmailTemplates.UnsetDefault", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.UnsetDefault(ctx, body)
+	c.api.done("EmailTemplates.UnsetDefault", body, resp, err)
+	return resp, err
+}
+
+func (c *emailTemplatesClient) Update(ctx context.Context, body emailtemplates.UpdateRequest) (*emailtemplates.UpdateResponse, error) {
+	if err := c.api.check(ctx, "EmailTemplates.Update", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Update(ctx, body)
+	c.api.done("EmailTemplates.Update", body, resp, err)
+	return resp, err
+}
+
+type environmentsClient struct {
+	api    *API
+	client api.EnvironmentsAPI
+}
+
+func (c *environmentsClient) Create(ctx context.Context, body environments.CreateRequest) (*environments.CreateResponse, error) {
+	if err := c.api.check(ctx, "Environments.Create", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Create(ctx, body)
+	c.api.done("Environments.Create", body, resp, err)
+	return resp, err
+}
+
+func (c *environmentsClient) Delete(ctx context.Context, body environments.DeleteRequest) (*environments.DeleteResponse, error) {
+	if err := c.api.check(ctx, "Environments.Delete", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Delete(ctx, body)
+	c.api.done("Environments.Delete", body, resp, err)
+	return resp, err
+}
+
+func (c *environmentsClient) Get(ctx context.Context, body environments.GetRequest) (*environments.GetResponse, error) {
+	if err := c.api.check(ctx, "Environments.Get", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Get(ctx, body)
+	c.api.done("Environments.Get", body, resp, err)
+	return resp, err
+}
+
+func (c *environmentsClient) GetAll(ctx context.Context, body environments.GetAllRequest) (*environments.GetAllResponse, error) {
+	if err := c.api.check(ctx, "Environments.GetAll", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.GetAll(ctx, body)
+	c.api.done("Environments.GetAll", body, resp, err)
+	return resp, err
+}
+
+func (c *environmentsClient) GetMetrics(ctx context.Context, body environments.GetMetricsRequest) (*environments.GetMetricsResponse, error) {
+	if err := c.api.check(ctx, "Environments.GetMetrics", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.GetMetrics(ctx, body)
+	c.api.done("Environments.GetMetrics", body, resp, err)
+	return resp, err
+}
+
+func (c *environmentsClient) Update(ctx context.Context, body environments.UpdateRequest) (*environments.UpdateResponse, error) {
+	if err := c.api.check(ctx, "Environments.Update", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Update(ctx, body)
+	c.api.done("Environments.Update", body, resp, err)
+	return resp, err
+}
+
+type eventLogStreamingClient struct {
+	api    *API
+	client api.EventLogStreamingAPI
+}
+
+func (c *eventLogStreamingClient) Create(ctx context.Context, body eventlogstreaming.CreateRequest) (*eventlogstreaming.CreateResponse, error) {
+	if err := c.api.check(ctx, "EventLogStreaming.Create", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Create(ctx, body)
+	c.api.done("EventLogStreaming.Create", body, resp, err)
+	return resp, err
+}
+
+func (c *eventLogStreamingClient) Delete(ctx context.Context, body eventlogstreaming.DeleteRequest) (*eventlogstreaming.DeleteResponse, error) {
+	if err := c.api.check(ctx, "EventLogStreaming.Delete", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Delete(ctx, body)
+	c.api.done("EventLogStreaming.Delete", body, resp, err)
+	return resp, err
+}
+
+func (c *eventLogStreamingClient) Disable(ctx context.Context, body eventlogstreaming.DisableRequest) (*eventlogstreaming.DisableResponse, error) {
+	if err := c.api.check(ctx, "EventLogStreaming.Disable", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Disable(ctx, body)
+	c.api.done("EventLogStreaming.Disable", body, resp, err)
+	return resp, err
+}
+
+func (c *eventLogStreamingClient) Enable(ctx context.Context, body eventlogstreaming.EnableRequest) (*eventlogstreaming.EnableResponse, error) {
+	if err := c.api.check(ctx, "EventLogStreaming.Enable", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Enable(ctx, body)
+	c.api.done("EventLogStreaming.Enable", body, resp, err)
+	return resp, err
+}
+
+func (c *eventLogStreamingClient) Get(ctx context.Context, body eventlogstreaming.GetRequest) (*eventlogstreaming.GetResponse, error) {
+	if err := c.api.check(ctx, "EventLogStreaming.Get", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Get(ctx, body)
+	c.api.done("EventLogStreaming.Get", body, resp, err)
+	return resp, err
+}
+
+func (c *eventLogStreamingClient) Update(ctx context.Context, body eventlogstreaming.UpdateRequest) (*eventlogstreaming.UpdateResponse, error) {
+	if err := c.api.check(ctx, "EventLogStreaming.Update", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Update(ctx, body)
+	c.api.done("EventLogStreaming.Update", body, resp, err)
+	return resp, err
+}
+
+type jwtTemplatesClient struct {
+	api    *API
+	client api.JWTTemplatesAPI
+}
+
+func (c *jwtTemplatesClient) Get(ctx context.Context, body jwttemplates.GetRequest) (*jwttemplates.GetResponse, error) {
+	if err := c.api.check(ctx, "JWTTemplates.Get", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Get(ctx, body)
+	c.api.done("JWTTemplates.Get", body, resp, err)
+	return resp, err
+}
+
+func (c *jwtTemplatesClient) Set(ctx context.Context, body jwttemplates.SetRequest) (*jwttemplates.SetResponse, error) {
+	if err := c.api.check(ctx, "JWTTemplates.Set", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Set(ctx, body)
+	c.api.done("JWTTemplates.Set", body, resp, err)
+	return resp, err
+}
+
+type passwordStrengthConfigClient struct {
+	api    *API
+	client api.PasswordStrengthConfigAPI
+}
+
+func (c *passwordStrengthConfigClient) Get(ctx context.Context, body passwordstrengthconfig.GetRequest) (*passwordstrengthconfig.GetResponse, error) {
+	if err := c.api.check(ctx, "PasswordStrengthConfig.Get", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Get(ctx, body)
+	c.api.done("PasswordStrengthConfig.Get", body, resp, err)
+	return resp, err
+}
+
+func (c *passwordStrengthConfigClient) Set(ctx context.Context, body passwordstrengthconfig.SetRequest) (*passwordstrengthconfig.SetResponse, error) {
+	if err := c.api.check(ctx, "PasswordStrengthConfig.Set", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Set(ctx, body)
+	c.api.done("PasswordStrengthConfig.Set", body, resp, err)
+	return resp, err
+}
+
+type projectsClient struct {
+	api    *API
+	client api.ProjectsAPI
+}
+
+func (c *projectsClient) Create(ctx context.Context, body projects.CreateRequest) (*projects.CreateResponse, error) {
+	if err := c.api.check(ctx, "Projects.Create", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Create(ctx, body)
+	c.api.done("Projects.Create", body, resp, err)
+	return resp, err
+}
+
+func (c *projectsClient) Delete(ctx context.Context, body projects.DeleteRequest) (*projects.DeleteResponse, error) {
+	if err := c.api.check(ctx, "Projects.Delete", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Delete(ctx, body)
+	c.api.done("Projects.Delete", body, resp, err)
+	return resp, err
+}
+
+func (c *projectsClient) Get(ctx context.Context, body projects.GetRequest) (*projects.GetResponse, error) {
+	if err := c.api.check(ctx, "Projects.Get", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Get(ctx, body)
+	c.api.done("Projects.Get", body, resp, err)
+	return resp, err
+}
+
+func (c *projectsClient) GetAll(ctx context.Context, body projects.GetAllRequest) (*projects.GetAllResponse, error) {
+	if err := c.api.check(ctx, "Projects.GetAll", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.GetAll(ctx, body)
+	c.api.done("Projects.GetAll", body, resp, err)
+	return resp, err
+}
+
+func (c *projectsClient) Update(ctx context.Context, body projects.UpdateRequest) (*projects.UpdateResponse, error) {
+	if err := c.api.check(ctx, "Projects.Update", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Update(ctx, body)
+	c.api.done("Projects.Update", body, resp, err)
+	return resp, err
+}
+
+type publicTokensClient struct {
+	api    *API
+	client api.PublicTokensAPI
+}
+
+func (c *publicTokensClient) Create(ctx context.Context, body publictokens.CreateRequest) (*publictokens.CreateResponse, error) {
+	if err := c.api.check(ctx, "PublicTokens.Create", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Create(ctx, body)
+	c.api.done("PublicTokens.Create", body, resp, err)
+	return resp, err
+}
+
+func (c *publicTokensClient) Delete(ctx context.Context, body publictokens.DeleteRequest) (*publictokens.DeleteResponse, error) {
+	if err := c.api.check(ctx, "PublicTokens.Delete", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Delete(ctx, body)
+	c.api.done("PublicTokens.Delete", body, resp, err)
+	return resp, err
+}
+
+func (c *publicTokensClient) Get(ctx context.Context, body publictokens.GetRequest) (*publictokens.GetResponse, error) {
+	if err := c.api.check(ctx, "PublicTokens.Get", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Get(ctx, body)
+	c.api.done("PublicTokens.Get", body, resp, err)
+	return resp, err
+}
+
+func (c *publicTokensClient) GetAll(ctx context.Context, body publictokens.GetAllRequest) (*publictokens.GetAllResponse, error) {
+	if err := c.api.check(ctx, "PublicTokens.GetAll", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.GetAll(ctx, body)
+	c.api.done("PublicTokens.GetAll", body, resp, err)
+	return resp, err
+}
+
+type rbacPolicyClient struct {
+	api    *API
+	client api.RBACPolicyAPI
+}
+
+func (c *rbacPolicyClient) Get(ctx context.Context, body rbacpolicy.GetRequest) (*rbacpolicy.GetResponse, error) {
+	if err := c.api.check(ctx, "RBACPolicy.Get", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Get(ctx, body)
+	c.api.done("RBACPolicy.Get", body, resp, err)
+	return resp, err
+}
+
+func (c *rbacPolicyClient) Set(ctx context.Context, body rbacpolicy.SetRequest) (*rbacpolicy.SetResponse, error) {
+	if err := c.api.check(ctx, "RBACPolicy.Set", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Set(ctx, body)
+	c.api.done("RBACPolicy.Set", body, resp, err)
+	return resp, err
+}
+
+type redirectURLsClient struct {
+	api    *API
+	client api.RedirectURLsAPI
+}
+
+func (c *redirectURLsClient) Create(ctx context.Context, body redirecturls.CreateRequest) (*redirecturls.CreateResponse, error) {
+	if err := c.api.check(ctx, "RedirectURLs.Create", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Create(ctx, body)
+	c.api.done("RedirectURLs.Create", body, resp, err)
+	return resp, err
+}
+
+func (c *redirectURLsClient) Delete(ctx context.Context, body redirecturls.DeleteRequest) (*redirecturls.DeleteResponse, error) {
+	if err := c.api.check(ctx, "RedirectURLs.Delete", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Delete(ctx, body)
+	c.api.done("RedirectURLs.Delete", body, resp, err)
+	return resp, err
+}
+
+func (c *redirectURLsClient) Get(ctx context.Context, body redirecturls.GetRequest) (*redirecturls.GetResponse, error) {
+	if err := c.api.check(ctx, "RedirectURLs.Get", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Get(ctx, body)
+	c.api.done("RedirectURLs.Get", body, resp, err)
+	return resp, err
+}
+
+func (c *redirectURLsClient) GetAll(ctx context.Context, body redirecturls.GetAllRequest) (*redirecturls.GetAllResponse, error) {
+	if err := c.api.check(ctx, "RedirectURLs.GetAll", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.GetAll(ctx, body)
+	c.api.done("RedirectURLs.GetAll", body, resp, err)
+	return resp, err
+}
+
+func (c *redirectURLsClient) Update(ctx context.Context, body redirecturls.UpdateRequest) (*redirecturls.UpdateResponse, error) {
+	if err := c.api.check(ctx, "RedirectURLs.Update", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Update(ctx, body)
+	c.api.done("RedirectURLs.Update", body, resp, err)
+	return resp, err
+}
+
+type sdkClient struct {
+	api    *API
+	client api.SDKAPI
+}
+
+func (c *sdkClient) GetB2BConfig(ctx context.Context, body sdk.GetB2BConfigRequest) (*sdk.GetB2BConfigResponse, error) {
+	if err := c.api.check(ctx, "SDK.GetB2BConfig", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.GetB2BConfig(ctx, body)
+	c.api.done("SDK.GetB2BConfig", body, resp, err)
+	return resp, err
+}
+
+func (c *sdkClient) GetConsumerConfig(ctx context.Context, body sdk.GetConsumerConfigRequest) (*sdk.GetConsumerConfigResponse, error) {
+	if err := c.api.check(ctx, "SDK.GetConsumerConfig", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.GetConsumerConfig(ctx, body)
+	c.api.done("SDK.GetConsumerConfig", body, resp, err)
+	return resp, err
+}
+
+func (c *sdkClient) SetB2BConfig(ctx context.Context, body sdk.SetB2BConfigRequest) (*sdk.SetB2BConfigResponse, error) {
+	if err := c.api.check(ctx, "SDK.SetB2BConfig", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.SetB2BConfig(ctx, body)
+	c.api.done("SDK.SetB2BConfig", body, resp, err)
+	return resp, err
+}
+
+func (c *sdkClient) SetConsumerConfig(ctx context.Context, body sdk.SetConsumerConfigRequest) (*sdk.SetConsumerConfigResponse, error) {
+	if err := c.api.check(ctx, "SDK.SetConsumerConfig", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.SetConsumerConfig(ctx, body)
+	c.api.done("SDK.SetConsumerConfig", body, resp, err)
+	return resp, err
+}
+
+type secretsClient struct {
+	api    *API
+	client api.SecretsAPI
+}
+
+func (c *secretsClient) Create(ctx context.Context, body secrets.CreateRequest) (*secrets.CreateResponse, error) {
+	if err := c.api.check(ctx, "Secrets.Create", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Create(ctx, body)
+	c.api.done("Secrets.Create", body, resp, err)
+	return resp, err
+}
+
+func (c *secretsClient) Delete(ctx context.Context, body secrets.DeleteRequest) (*secrets.DeleteResponse, error) {
+	if err := c.api.check(ctx, "Secrets.Delete", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Delete(ctx, body)
+	c.api.done("Secrets.Delete", body, resp, err)
+	return resp, err
+}
+
+func (c *secretsClient) Get(ctx context.Context, body secrets.GetRequest) (*secrets.GetResponse, error) {
+	if err := c.api.check(ctx, "Secrets.Get", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Get(ctx, body)
+	c.api.done("Secrets.Get", body, resp, err)
+	return resp, err
+}
+
+func (c *secretsClient) GetAll(ctx context.Context, body secrets.GetAllRequest) (*secrets.GetAllResponse, error) {
+	if err := c.api.check(ctx, "Secrets.GetAll", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.GetAll(ctx, body)
+	c.api.done("Secrets.GetAll", body, resp, err)
+	return resp, err
+}
+
+type trustedTokenProfilesClient struct {
+	api    *API
+	client api.TrustedTokenProfilesAPI
+}
+
+func (c *trustedTokenProfilesClient) Create(ctx context.Context, body trustedtokenprofiles.CreateRequest) (*trustedtokenprofiles.CreateResponse, error) {
+	if err := c.api.check(ctx, "TrustedTokenProfiles.Create", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Create(ctx, body)
+	c.api.done("TrustedTokenProfiles.Create", body, resp, err)
+	return resp, err
+}
+
+func (c *trustedTokenProfilesClient) CreatePEMFile(ctx context.Context, body trustedtokenprofiles.CreatePEMFileRequest) (*trustedtokenprofiles.CreatePEMFileResponse, error) {
+	if err := c.api.check(ctx, "TrustedTokenProfiles.CreatePEMFile", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.CreatePEMFile(ctx, body)
+	c.api.done("TrustedTokenProfiles.CreatePEMFile", body, resp, err)
+	return resp, err
+}
+
+func (c *trustedTokenProfilesClient) Delete(ctx context.Context, body trustedtokenprofiles.DeleteRequest) (*trustedtokenprofiles.DeleteResponse, error) {
+	if err := c.api.check(ctx, "TrustedTokenProfiles.Delete", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Delete(ctx, body)
+	c.api.done("TrustedTokenProfiles.Delete", body, resp, err)
+	return resp, err
+}
+
+func (c *trustedTokenProfilesClient) DeletePEMFile(ctx context.Context, body trustedtokenprofiles.DeletePEMFileRequest) (*trustedtokenprofiles.DeletePEMFileResponse, error) {
+	if err := c.api.check(ctx, "TrustedTokenProfiles.DeletePEMFile", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.DeletePEMFile(ctx, body)
+	c.api.done("TrustedTokenProfiles.DeletePEMFile", body, resp, err)
+	return resp, err
+}
+
+func (c *trustedTokenProfilesClient) Get(ctx context.Context, body trustedtokenprofiles.GetRequest) (*trustedtokenprofiles.GetResponse, error) {
+	if err := c.api.check(ctx, "TrustedTokenProfiles.Get", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Get(ctx, body)
+	c.api.done("TrustedTokenProfiles.Get", body, resp, err)
+	return resp, err
+}
+
+func (c *trustedTokenProfilesClient) GetAll(ctx context.Context, body trustedtokenprofiles.GetAllRequest) (*trustedtokenprofiles.GetAllResponse, error) {
+	if err := c.api.check(ctx, "TrustedTokenProfiles.GetAll", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.GetAll(ctx, body)
+	c.api.done("TrustedTokenProfiles.GetAll", body, resp, err)
+	return resp, err
+}
+
+func (c *trustedTokenProfilesClient) GetPEMFile(ctx context.Context, body trustedtokenprofiles.GetPEMFileRequest) (*trustedtokenprofiles.GetPEMFileResponse, error) {
+	if err := c.api.check(ctx, "TrustedTokenProfiles.GetPEMFile", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.GetPEMFile(ctx, body)
+	c.api.done("TrustedTokenProfiles.GetPEMFile", body, resp, err)
+	return resp, err
+}
+
+func (c *trustedTokenProfilesClient) Update(ctx context.Context, body trustedtokenprofiles.UpdateRequest) (*trustedtokenprofiles.UpdateResponse, error) {
+	if err := c.api.check(ctx, "TrustedTokenProfiles.Update", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Update(ctx, body)
+	c.api.done("TrustedTokenProfiles.Update", body, resp, err)
+	return resp, err
+}
+
+type v1ToV3MigrationClient struct {
+	api    *API
+	client api.V1ToV3MigrationAPI
+}
+
+func (c *v1ToV3MigrationClient) GetProject(ctx context.Context, body migrationprojects.GetProjectRequest) (*migrationprojects.GetProjectResponse, error) {
+	if err := c.api.check(ctx, "V1ToV3MigrationClient.GetProject", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.GetProject(ctx, body)
+	c.api.done("V1ToV3MigrationClient.GetProject", body, resp, err)
+	return resp, err
+}
+
+func (c *v1ToV3MigrationClient) GetProjects(ctx context.Context, body migrationprojects.GetProjectsRequest) (*migrationprojects.GetProjectsResponse, error) {
+	if err := c.api.check(ctx, "V1ToV3MigrationClient.GetProjects", body); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.GetProjects(ctx, body)
+	c.api.done("V1ToV3MigrationClient.GetProjects", body, resp, err)
+	return resp, err
+}
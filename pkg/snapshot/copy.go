@@ -0,0 +1,51 @@
+package snapshot
+
+import "maps"
+
+// DeepCopy returns a copy of the snapshot that shares no memory with it.
+func (s *Snapshot) DeepCopy() *Snapshot {
+	out := &Snapshot{
+		Version:               s.Version,
+		Project:               s.Project.DeepCopy(),
+		DefaultEmailTemplates: maps.Clone(s.DefaultEmailTemplates),
+	}
+	for _, t := range s.EmailTemplates {
+		out.EmailTemplates = append(out.EmailTemplates, t.DeepCopy())
+	}
+	for _, e := range s.Environments {
+		out.Environments = append(out.Environments, e.DeepCopy())
+	}
+	return out
+}
+
+// DeepCopy returns a copy of the environment that shares no memory with it.
+func (e Environment) DeepCopy() Environment {
+	out := Environment{
+		Settings:               e.Settings.DeepCopy(),
+		RBACPolicy:             e.RBACPolicy.DeepCopy(),
+		PasswordStrengthConfig: e.PasswordStrengthConfig.DeepCopy(),
+		SMSCountryCodes:        append([]string(nil), e.SMSCountryCodes...),
+		WhatsAppCountryCodes:   append([]string(nil), e.WhatsAppCountryCodes...),
+	}
+	for _, u := range e.RedirectURLs {
+		out.RedirectURLs = append(out.RedirectURLs, u.DeepCopy())
+	}
+	if e.B2BSDKConfig != nil {
+		config := e.B2BSDKConfig.DeepCopy()
+		out.B2BSDKConfig = &config
+	}
+	if e.ConsumerSDKConfig != nil {
+		config := e.ConsumerSDKConfig.DeepCopy()
+		out.ConsumerSDKConfig = &config
+	}
+	for _, t := range e.JWTTemplates {
+		out.JWTTemplates = append(out.JWTTemplates, t.DeepCopy())
+	}
+	for _, d := range e.EventLogStreaming {
+		out.EventLogStreaming = append(out.EventLogStreaming, d.DeepCopy())
+	}
+	for _, p := range e.TrustedTokenProfiles {
+		out.TrustedTokenProfiles = append(out.TrustedTokenProfiles, p.DeepCopy())
+	}
+	return out
+}
@@ -0,0 +1,144 @@
+package snapshot
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// JSON encodes the snapshot as indented JSON with object keys in sorted order.
+func (s *Snapshot) JSON() ([]byte, error) {
+	doc, err := s.document()
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// YAML encodes the snapshot as YAML with object keys in sorted order. Field names are the same as
+// in the JSON encoding.
+func (s *Snapshot) YAML() ([]byte, error) {
+	doc, err := s.document()
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(doc); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// document returns a normalized copy of the snapshot as generic maps and slices. Encoding the maps
+// rather than the structs sorts object keys in both formats, and routing YAML through the JSON
+// encoding keeps the field names and omitempty behavior of the model types.
+func (s *Snapshot) document() (any, error) {
+	normalized := s.DeepCopy()
+	normalized.Normalize()
+	b, err := json.Marshal(normalized)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var doc any
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+	return numbers(doc), nil
+}
+
+// numbers replaces json.Number values with int64 or float64, which the YAML encoder writes as
+// numbers rather than strings.
+func numbers(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			v[k] = numbers(e)
+		}
+	case []any:
+		for i, e := range v {
+			v[i] = numbers(e)
+		}
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	}
+	return v
+}
+
+// Parse decodes a snapshot from JSON or YAML. Unknown fields are rejected, so that typos in a
+// hand-edited document are not silently ignored.
+func Parse(data []byte) (*Snapshot, error) {
+	var doc any
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("parsing snapshot: %w", err)
+	}
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("parsing snapshot: %w", err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	var s Snapshot
+	if err := dec.Decode(&s); err != nil {
+		return nil, fmt.Errorf("parsing snapshot: %w", err)
+	}
+	if s.Version != FormatVersion {
+		return nil, fmt.Errorf("unsupported snapshot version %d, expected %d", s.Version, FormatVersion)
+	}
+	s.Normalize()
+	return &s, nil
+}
+
+// ReadFile reads a snapshot from a JSON or YAML file.
+func ReadFile(path string) (*Snapshot, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	s, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return s, nil
+}
+
+// WriteFile writes the snapshot to path, as YAML if the path ends in .yaml or .yml and as JSON
+// otherwise.
+func (s *Snapshot) WriteFile(path string) error {
+	var (
+		data []byte
+		err  error
+	)
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		data, err = s.YAML()
+	default:
+		data, err = s.JSON()
+	}
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
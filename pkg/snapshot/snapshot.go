@@ -0,0 +1,430 @@
+// Package snapshot reads everything configurable in a project and its environments into a single
+// document that can be checked into version control.
+//
+//	snap, err := snapshot.Take(ctx, client, "my-project")
+//	if err != nil {
+//		return err
+//	}
+//	return snap.WriteFile("stytch.yaml")
+//
+// Snapshots are deterministic: lists are sorted, empty values are omitted and object keys are
+// written in sorted order, so taking a snapshot of an unchanged project produces identical bytes.
+// Secrets and public tokens are not included.
+package snapshot
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/stytchauth/stytch-management-go/v3/pkg/api"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/countrycodeallowlist"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/emailtemplates"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/environments"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/eventlogstreaming"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/jwttemplates"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/passwordstrengthconfig"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/rbacpolicy"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/redirecturls"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/sdk"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/trustedtokenprofiles"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/stytcherror"
+)
+
+// FormatVersion is the version of the snapshot document format written by this package.
+const FormatVersion = 1
+
+// Snapshot is the configuration of a project and its environments.
+type Snapshot struct {
+	// Version is the format version of the document. See FormatVersion.
+	Version int `json:"version"`
+	// Project is the project itself.
+	Project projects.Project `json:"project"`
+	// EmailTemplates are the project's custom email templates, sorted by template ID.
+	EmailTemplates []emailtemplates.EmailTemplate `json:"email_templates,omitempty"`
+	// DefaultEmailTemplates maps each email template type that has a default to the ID of the
+	// template used for it.
+	DefaultEmailTemplates map[emailtemplates.TemplateType]string `json:"default_email_templates,omitempty"`
+	// Environments are sorted by slug.
+	Environments []Environment `json:"environments,omitempty"`
+}
+
+// Environment is the configuration of a single environment.
+type Environment struct {
+	// Settings are the environment's own settings, such as its name and user lock policy.
+	Settings environments.Environment `json:"settings"`
+	// RedirectURLs are sorted by URL.
+	RedirectURLs []redirecturls.RedirectURL `json:"redirect_urls,omitempty"`
+	// B2BSDKConfig is set for B2B projects.
+	B2BSDKConfig *sdk.B2BConfig `json:"sdk_b2b,omitempty"`
+	// ConsumerSDKConfig is set for consumer projects.
+	ConsumerSDKConfig *sdk.ConsumerConfig `json:"sdk_consumer,omitempty"`
+	// RBACPolicy is the environment's RBAC policy.
+	RBACPolicy rbacpolicy.Policy `json:"rbac_policy"`
+	// JWTTemplates are sorted by type. Types that have no template are omitted.
+	JWTTemplates []jwttemplates.JWTTemplate `json:"jwt_templates,omitempty"`
+	// PasswordStrengthConfig is the environment's password strength configuration.
+	PasswordStrengthConfig passwordstrengthconfig.PasswordStrengthConfig `json:"password_strength_config"`
+	// SMSCountryCodes and WhatsAppCountryCodes are the allowlisted country codes, sorted.
+	SMSCountryCodes      []string `json:"sms_country_codes,omitempty"`
+	WhatsAppCountryCodes []string `json:"whatsapp_country_codes,omitempty"`
+	// EventLogStreaming holds the configured destinations, sorted by type. Credentials are masked
+	// by the API.
+	EventLogStreaming []eventlogstreaming.EventLogStreamingMasked `json:"event_log_streaming,omitempty"`
+	// TrustedTokenProfiles are sorted by profile ID.
+	TrustedTokenProfiles []trustedtokenprofiles.TrustedTokenProfile `json:"trusted_token_profiles,omitempty"`
+}
+
+// Environment returns the environment with the given slug, or nil if the snapshot does not have
+// one.
+func (s *Snapshot) Environment(slug string) *Environment {
+	for i := range s.Environments {
+		if s.Environments[i].Settings.EnvironmentSlug == slug {
+			return &s.Environments[i]
+		}
+	}
+	return nil
+}
+
+type options struct {
+	environments []string
+}
+
+// Option configures Take.
+type Option func(*options)
+
+// WithEnvironments limits the snapshot to the environments with the given slugs. By default every
+// environment in the project is included.
+func WithEnvironments(slugs ...string) Option {
+	return func(o *options) {
+		o.environments = append(o.environments, slugs...)
+	}
+}
+
+// Take reads the configuration of a project and its environments.
+func Take(ctx context.Context, client api.Interface, projectSlug string, opts ...Option) (*Snapshot, error) {
+	if projectSlug == "" {
+		return nil, fmt.Errorf("ProjectSlug cannot be empty")
+	}
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	projectResp, err := client.ProjectsAPI().Get(ctx, projects.GetRequest{ProjectSlug: projectSlug})
+	if err != nil {
+		return nil, fmt.Errorf("getting project: %w", err)
+	}
+	s := &Snapshot{
+		Version: FormatVersion,
+		Project: projectResp.Project,
+	}
+	s.Project.Normalize()
+
+	if err := s.takeEmailTemplates(ctx, client.EmailTemplatesAPI(), projectSlug); err != nil {
+		return nil, err
+	}
+
+	envsResp, err := client.EnvironmentsAPI().GetAll(ctx, environments.GetAllRequest{ProjectSlug: projectSlug})
+	if err != nil {
+		return nil, fmt.Errorf("getting environments: %w", err)
+	}
+	for _, env := range envsResp.Environments {
+		if len(o.environments) > 0 && !slices.Contains(o.environments, env.EnvironmentSlug) {
+			continue
+		}
+		e, err := takeEnvironment(ctx, client, projectSlug, s.Project.Vertical, env)
+		if err != nil {
+			return nil, fmt.Errorf("environment %s: %w", env.EnvironmentSlug, err)
+		}
+		s.Environments = append(s.Environments, *e)
+	}
+	for _, slug := range o.environments {
+		if s.Environment(slug) == nil {
+			return nil, fmt.Errorf("environment %s not found in project %s", slug, projectSlug)
+		}
+	}
+	s.Normalize()
+	return s, nil
+}
+
+// TakeEnvironment reads the configuration of a single environment, without the project-level
+// configuration that Take also reads.
+func TakeEnvironment(ctx context.Context, client api.Interface, projectSlug, envSlug string) (*Environment, error) {
+	if projectSlug == "" {
+		return nil, fmt.Errorf("ProjectSlug cannot be empty")
+	}
+	if envSlug == "" {
+		return nil, fmt.Errorf("EnvironmentSlug cannot be empty")
+	}
+
+	projectResp, err := client.ProjectsAPI().Get(ctx, projects.GetRequest{ProjectSlug: projectSlug})
+	if err != nil {
+		return nil, fmt.Errorf("getting project: %w", err)
+	}
+	envResp, err := client.EnvironmentsAPI().Get(ctx, environments.GetRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getting environment: %w", err)
+	}
+	e, err := takeEnvironment(ctx, client, projectSlug, projectResp.Project.Vertical, envResp.Environment)
+	if err != nil {
+		return nil, fmt.Errorf("environment %s: %w", envSlug, err)
+	}
+	e.Normalize()
+	return e, nil
+}
+
+func (s *Snapshot) takeEmailTemplates(ctx context.Context, client api.EmailTemplatesAPI, projectSlug string) error {
+	templatesResp, err := client.GetAll(ctx, emailtemplates.GetAllRequest{ProjectSlug: projectSlug})
+	if err != nil {
+		return fmt.Errorf("getting email templates: %w", err)
+	}
+	s.EmailTemplates = templatesResp.EmailTemplates
+
+	for _, typ := range emailtemplates.TemplateTypes() {
+		resp, err := client.GetDefault(ctx, emailtemplates.GetDefaultRequest{
+			ProjectSlug:       projectSlug,
+			EmailTemplateType: typ,
+		})
+		if isNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("getting default %s email template: %w", typ, err)
+		}
+		if resp.TemplateID == "" {
+			continue
+		}
+		if s.DefaultEmailTemplates == nil {
+			s.DefaultEmailTemplates = map[emailtemplates.TemplateType]string{}
+		}
+		s.DefaultEmailTemplates[typ] = resp.TemplateID
+	}
+	return nil
+}
+
+func takeEnvironment(
+	ctx context.Context,
+	client api.Interface,
+	projectSlug string,
+	vertical projects.Vertical,
+	env environments.Environment,
+) (*Environment, error) {
+	e := &Environment{Settings: env}
+	envSlug := env.EnvironmentSlug
+
+	redirectURLs, err := client.RedirectURLsAPI().GetAll(ctx, redirecturls.GetAllRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getting redirect URLs: %w", err)
+	}
+	e.RedirectURLs = redirectURLs.RedirectURLs
+
+	if vertical != projects.VerticalConsumer {
+		resp, err := client.SDKAPI().GetB2BConfig(ctx, sdk.GetB2BConfigRequest{
+			ProjectSlug:     projectSlug,
+			EnvironmentSlug: envSlug,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("getting B2B SDK config: %w", err)
+		}
+		e.B2BSDKConfig = &resp.Config
+	}
+	if vertical != projects.VerticalB2B {
+		resp, err := client.SDKAPI().GetConsumerConfig(ctx, sdk.GetConsumerConfigRequest{
+			ProjectSlug:     projectSlug,
+			EnvironmentSlug: envSlug,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("getting consumer SDK config: %w", err)
+		}
+		e.ConsumerSDKConfig = &resp.Config
+	}
+
+	policy, err := client.RBACPolicyAPI().Get(ctx, rbacpolicy.GetRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getting RBAC policy: %w", err)
+	}
+	e.RBACPolicy = policy.Policy
+
+	for _, typ := range jwttemplates.JWTTemplateTypes() {
+		resp, err := client.JWTTemplatesAPI().Get(ctx, jwttemplates.GetRequest{
+			ProjectSlug:     projectSlug,
+			EnvironmentSlug: envSlug,
+			JWTTemplateType: typ,
+		})
+		if isNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("getting %s JWT template: %w", typ, err)
+		}
+		if resp.JWTTemplate.TemplateContent == "" && resp.JWTTemplate.CustomAudience == "" {
+			continue
+		}
+		template := resp.JWTTemplate
+		template.JWTTemplateType = typ
+		e.JWTTemplates = append(e.JWTTemplates, template)
+	}
+
+	passwordConfig, err := client.PasswordStrengthConfigAPI().Get(ctx, passwordstrengthconfig.GetRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getting password strength config: %w", err)
+	}
+	e.PasswordStrengthConfig = passwordConfig.PasswordStrengthConfig
+
+	sms, err := client.CountryCodeAllowlistAPI().GetAllowedSMSCountryCodes(ctx,
+		countrycodeallowlist.GetAllowedSMSCountryCodesRequest{
+			ProjectSlug:     projectSlug,
+			EnvironmentSlug: envSlug,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("getting SMS country codes: %w", err)
+	}
+	e.SMSCountryCodes = sms.CountryCodes
+
+	whatsApp, err := client.CountryCodeAllowlistAPI().GetAllowedWhatsAppCountryCodes(ctx,
+		countrycodeallowlist.GetAllowedWhatsAppCountryCodesRequest{
+			ProjectSlug:     projectSlug,
+			EnvironmentSlug: envSlug,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("getting WhatsApp country codes: %w", err)
+	}
+	e.WhatsAppCountryCodes = whatsApp.CountryCodes
+
+	for _, typ := range eventlogstreaming.DestinationTypes() {
+		resp, err := client.EventLogStreamingAPI().Get(ctx, eventlogstreaming.GetRequest{
+			ProjectSlug:     projectSlug,
+			EnvironmentSlug: envSlug,
+			DestinationType: typ,
+		})
+		if isNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("getting %s event log streaming: %w", typ, err)
+		}
+		if resp.EventLogStreamingConfig.DestinationType == "" {
+			continue
+		}
+		e.EventLogStreaming = append(e.EventLogStreaming, resp.EventLogStreamingConfig)
+	}
+
+	profiles, err := client.TrustedTokenProfilesAPI().GetAll(ctx, trustedtokenprofiles.GetAllRequest{
+		ProjectSlug:     projectSlug,
+		EnvironmentSlug: envSlug,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getting trusted token profiles: %w", err)
+	}
+	e.TrustedTokenProfiles = profiles.Profiles
+
+	return e, nil
+}
+
+// Normalize sorts every list in the snapshot and clears empty ones, so that two snapshots of the
+// same configuration are equal and encode identically. Take normalizes the snapshots it returns;
+// call Normalize after editing or decoding one by hand.
+func (s *Snapshot) Normalize() {
+	s.Project.Normalize()
+	for i := range s.EmailTemplates {
+		s.EmailTemplates[i].Normalize()
+	}
+	slices.SortStableFunc(s.EmailTemplates, func(a, b emailtemplates.EmailTemplate) int {
+		return cmp.Compare(a.TemplateID, b.TemplateID)
+	})
+	if len(s.EmailTemplates) == 0 {
+		s.EmailTemplates = nil
+	}
+	if len(s.DefaultEmailTemplates) == 0 {
+		s.DefaultEmailTemplates = nil
+	}
+	for i := range s.Environments {
+		s.Environments[i].Normalize()
+	}
+	slices.SortStableFunc(s.Environments, func(a, b Environment) int {
+		return cmp.Compare(a.Settings.EnvironmentSlug, b.Settings.EnvironmentSlug)
+	})
+	if len(s.Environments) == 0 {
+		s.Environments = nil
+	}
+}
+
+// Normalize sorts every list in the environment and clears empty ones.
+func (e *Environment) Normalize() {
+	e.Settings.Normalize()
+	for i := range e.RedirectURLs {
+		e.RedirectURLs[i].Normalize()
+	}
+	slices.SortStableFunc(e.RedirectURLs, func(a, b redirecturls.RedirectURL) int {
+		return cmp.Compare(a.URL, b.URL)
+	})
+	if e.B2BSDKConfig != nil {
+		e.B2BSDKConfig.Normalize()
+	}
+	if e.ConsumerSDKConfig != nil {
+		e.ConsumerSDKConfig.Normalize()
+	}
+	e.RBACPolicy.Normalize()
+	for i := range e.JWTTemplates {
+		e.JWTTemplates[i].Normalize()
+	}
+	slices.SortStableFunc(e.JWTTemplates, func(a, b jwttemplates.JWTTemplate) int {
+		return cmp.Compare(a.JWTTemplateType, b.JWTTemplateType)
+	})
+	e.PasswordStrengthConfig.Normalize()
+	slices.Sort(e.SMSCountryCodes)
+	slices.Sort(e.WhatsAppCountryCodes)
+	for i := range e.EventLogStreaming {
+		e.EventLogStreaming[i].Normalize()
+	}
+	slices.SortStableFunc(e.EventLogStreaming, func(a, b eventlogstreaming.EventLogStreamingMasked) int {
+		return cmp.Compare(a.DestinationType, b.DestinationType)
+	})
+	for i := range e.TrustedTokenProfiles {
+		e.TrustedTokenProfiles[i].Normalize()
+	}
+	slices.SortStableFunc(e.TrustedTokenProfiles, func(a, b trustedtokenprofiles.TrustedTokenProfile) int {
+		return cmp.Compare(a.ProfileID, b.ProfileID)
+	})
+
+	if len(e.RedirectURLs) == 0 {
+		e.RedirectURLs = nil
+	}
+	if len(e.JWTTemplates) == 0 {
+		e.JWTTemplates = nil
+	}
+	if len(e.SMSCountryCodes) == 0 {
+		e.SMSCountryCodes = nil
+	}
+	if len(e.WhatsAppCountryCodes) == 0 {
+		e.WhatsAppCountryCodes = nil
+	}
+	if len(e.EventLogStreaming) == 0 {
+		e.EventLogStreaming = nil
+	}
+	if len(e.TrustedTokenProfiles) == 0 {
+		e.TrustedTokenProfiles = nil
+	}
+}
+
+func isNotFound(err error) bool {
+	var stytchErr stytcherror.Error
+	return errors.As(err, &stytchErr) && stytchErr.StatusCode == http.StatusNotFound
+}
@@ -0,0 +1,215 @@
+package snapshot_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/apifake"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/countrycodeallowlist"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/emailtemplates"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/environments"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/eventlogstreaming"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/jwttemplates"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/projects"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/rbacpolicy"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/redirecturls"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/models/sdk"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/snapshot"
+	"github.com/stytchauth/stytch-management-go/v3/pkg/stytcherror"
+)
+
+var notFound = stytcherror.Error{StatusCode: 404, ErrorType: "not_found"}
+
+// newFake returns a fake B2B project with a test and a live environment. Lists are returned in the
+// given order, so tests can check that snapshots do not depend on it.
+func newFake(redirectURLs []redirecturls.RedirectURL, countryCodes []string) *apifake.API {
+	fake := apifake.New()
+	fake.Projects.GetReturns(&projects.GetResponse{Project: projects.Project{
+		ProjectSlug: "my-project",
+		Name:        "My project",
+		Vertical:    projects.VerticalB2B,
+		CreatedAt:   time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC),
+	}}, nil)
+	fake.Environments.GetAllReturns(&environments.GetAllResponse{Environments: []environments.Environment{
+		{ProjectSlug: "my-project", EnvironmentSlug: "test", Type: environments.EnvironmentTypeTest, UserLockThreshold: 10},
+		{ProjectSlug: "my-project", EnvironmentSlug: "production", Type: environments.EnvironmentTypeLive},
+	}}, nil)
+	fake.EmailTemplates.GetAllReturns(&emailtemplates.GetAllResponse{EmailTemplates: []emailtemplates.EmailTemplate{
+		{TemplateID: "welcome"},
+		{TemplateID: "login"},
+	}}, nil)
+	fake.EmailTemplates.GetDefaultFunc = func(
+		_ context.Context, body emailtemplates.GetDefaultRequest,
+	) (*emailtemplates.GetDefaultResponse, error) {
+		if body.EmailTemplateType == emailtemplates.TemplateTypeLogin {
+			return &emailtemplates.GetDefaultResponse{TemplateID: "login"}, nil
+		}
+		return nil, notFound
+	}
+	fake.RedirectURLs.GetAllReturns(&redirecturls.GetAllResponse{RedirectURLs: redirectURLs}, nil)
+	fake.SDK.GetB2BConfigReturns(&sdk.GetB2BConfigResponse{Config: sdk.B2BConfig{
+		Basic: &sdk.B2BBasicConfig{Enabled: true, Domains: []sdk.AuthorizedB2BDomain{{Domain: "b.example.com"}, {Domain: "a.example.com"}}},
+	}}, nil)
+	fake.RBACPolicy.GetReturns(&rbacpolicy.GetResponse{Policy: rbacpolicy.Policy{
+		CustomRoles: []rbacpolicy.Role{{RoleID: "viewer"}, {RoleID: "editor"}},
+	}}, nil)
+	fake.JWTTemplates.GetFunc = func(_ context.Context, body jwttemplates.GetRequest) (*jwttemplates.GetResponse, error) {
+		if body.JWTTemplateType == jwttemplates.JWTTemplateTypeSession {
+			return &jwttemplates.GetResponse{JWTTemplate: jwttemplates.JWTTemplate{TemplateContent: `{"role": "{{ user.role }}"}`}}, nil
+		}
+		return &jwttemplates.GetResponse{}, nil
+	}
+	fake.CountryCodeAllowlist.GetAllowedSMSCountryCodesReturns(
+		&countrycodeallowlist.GetAllowedSMSCountryCodesResponse{CountryCodes: countryCodes}, nil)
+	fake.EventLogStreaming.GetFunc = func(
+		_ context.Context, body eventlogstreaming.GetRequest,
+	) (*eventlogstreaming.GetResponse, error) {
+		if body.DestinationType == eventlogstreaming.DestinationTypeDatadog {
+			return &eventlogstreaming.GetResponse{EventLogStreamingConfig: eventlogstreaming.EventLogStreamingMasked{
+				DestinationType: eventlogstreaming.DestinationTypeDatadog,
+				StreamingStatus: eventlogstreaming.StreamingStatusActive,
+			}}, nil
+		}
+		return nil, notFound
+	}
+	return fake
+}
+
+func TestTake(t *testing.T) {
+	ctx := context.Background()
+	urls := []redirecturls.RedirectURL{
+		{URL: "https://b.example.com/callback", ValidTypes: []redirecturls.URLType{{Type: redirecturls.RedirectURLTypeLogin}}},
+		{URL: "https://a.example.com/callback", ValidTypes: []redirecturls.URLType{{Type: redirecturls.RedirectURLTypeSignup}}},
+	}
+
+	t.Run("reads every resource", func(t *testing.T) {
+		// Arrange
+		fake := newFake(urls, []string{"US", "CA"})
+
+		// Act
+		snap, err := snapshot.Take(ctx, fake, "my-project")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, snapshot.FormatVersion, snap.Version)
+		assert.Equal(t, "my-project", snap.Project.ProjectSlug)
+		assert.Equal(t, map[emailtemplates.TemplateType]string{emailtemplates.TemplateTypeLogin: "login"}, snap.DefaultEmailTemplates)
+		require.Len(t, snap.Environments, 2)
+		assert.Equal(t, "production", snap.Environments[0].Settings.EnvironmentSlug)
+		assert.Equal(t, "test", snap.Environments[1].Settings.EnvironmentSlug)
+
+		env := snap.Environment("test")
+		require.NotNil(t, env)
+		assert.Equal(t, "https://a.example.com/callback", env.RedirectURLs[0].URL)
+		require.NotNil(t, env.B2BSDKConfig)
+		assert.Nil(t, env.ConsumerSDKConfig)
+		assert.Equal(t, "a.example.com", env.B2BSDKConfig.Basic.Domains[0].Domain)
+		assert.Equal(t, "editor", env.RBACPolicy.CustomRoles[0].RoleID)
+		require.Len(t, env.JWTTemplates, 1)
+		assert.Equal(t, jwttemplates.JWTTemplateTypeSession, env.JWTTemplates[0].JWTTemplateType)
+		assert.Equal(t, []string{"CA", "US"}, env.SMSCountryCodes)
+		assert.Nil(t, env.WhatsAppCountryCodes)
+		require.Len(t, env.EventLogStreaming, 1)
+		assert.Equal(t, eventlogstreaming.DestinationTypeDatadog, env.EventLogStreaming[0].DestinationType)
+
+		assert.Empty(t, fake.SDK.GetConsumerConfigCalls())
+	})
+
+	t.Run("limits environments", func(t *testing.T) {
+		// Arrange
+		fake := newFake(urls, nil)
+
+		// Act
+		snap, err := snapshot.Take(ctx, fake, "my-project", snapshot.WithEnvironments("production"))
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, snap.Environments, 1)
+		assert.Equal(t, "production", snap.Environments[0].Settings.EnvironmentSlug)
+		assert.Len(t, fake.RedirectURLs.GetAllCalls(), 1)
+	})
+
+	t.Run("fails for unknown environments", func(t *testing.T) {
+		// Arrange
+		fake := newFake(urls, nil)
+
+		// Act
+		_, err := snapshot.Take(ctx, fake, "my-project", snapshot.WithEnvironments("staging"))
+
+		// Assert
+		assert.ErrorContains(t, err, "environment staging not found")
+	})
+
+	t.Run("is deterministic", func(t *testing.T) {
+		// Arrange
+		reversed := []redirecturls.RedirectURL{urls[1], urls[0]}
+		first, err := snapshot.Take(ctx, newFake(urls, []string{"US", "CA"}), "my-project")
+		require.NoError(t, err)
+		second, err := snapshot.Take(ctx, newFake(reversed, []string{"CA", "US"}), "my-project")
+		require.NoError(t, err)
+
+		// Act
+		firstJSON, err := first.JSON()
+		require.NoError(t, err)
+		secondJSON, err := second.JSON()
+		require.NoError(t, err)
+		firstYAML, err := first.YAML()
+		require.NoError(t, err)
+		secondYAML, err := second.YAML()
+		require.NoError(t, err)
+
+		// Assert
+		assert.Equal(t, string(firstJSON), string(secondJSON))
+		assert.Equal(t, string(firstYAML), string(secondYAML))
+	})
+}
+
+func TestEncoding(t *testing.T) {
+	snap, err := snapshot.Take(context.Background(), newFake(nil, []string{"US"}), "my-project")
+	require.NoError(t, err)
+
+	for _, name := range []string{"stytch.json", "stytch.yaml"} {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			path := filepath.Join(t.TempDir(), name)
+
+			// Act
+			require.NoError(t, snap.WriteFile(path))
+			got, err := snapshot.ReadFile(path)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, snap, got)
+		})
+	}
+
+	t.Run("sorts keys", func(t *testing.T) {
+		// Act
+		b, err := snap.YAML()
+
+		// Assert
+		require.NoError(t, err)
+		assert.Regexp(t, `^default_email_templates:\n`, string(b))
+		assert.Contains(t, string(b), "\nversion: 1\n")
+	})
+
+	t.Run("rejects unknown fields", func(t *testing.T) {
+		// Act
+		_, err := snapshot.Parse([]byte("version: 1\nproject:\n  project_slgu: typo\n"))
+
+		// Assert
+		assert.ErrorContains(t, err, "project_slgu")
+	})
+
+	t.Run("rejects other versions", func(t *testing.T) {
+		// Act
+		_, err := snapshot.Parse([]byte(`{"version": 2}`))
+
+		// Assert
+		assert.ErrorContains(t, err, "unsupported snapshot version 2")
+	})
+}